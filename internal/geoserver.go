@@ -1,6 +1,9 @@
 package internal
 
-import "net/http"
+import (
+	"github.com/canghel3/go-geoserver/pkg/auth"
+	"net/http"
+)
 
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
@@ -15,6 +18,8 @@ type GeoserverData struct {
 type GeoserverConnection struct {
 	URL         string
 	Credentials GeoserverCredentials
+	// Authenticator replaces the basic authentication with Credentials when set
+	Authenticator auth.Authenticator
 }
 
 type GeoserverCredentials struct {
@@ -29,3 +34,13 @@ func (gi GeoserverData) Clone() GeoserverData {
 		Workspace:  gi.Workspace,
 	}
 }
+
+// Authenticate adds the credentials of the connection to the request
+func (gi GeoserverData) Authenticate(request *http.Request) error {
+	if gi.Connection.Authenticator != nil {
+		return gi.Connection.Authenticator.Authenticate(request)
+	}
+
+	request.SetBasicAuth(gi.Connection.Credentials.Username, gi.Connection.Credentials.Password)
+	return nil
+}
@@ -0,0 +1,29 @@
+// Package jsonutil decodes the lists GeoServer responds with, which it does not always wrap in an array.
+package jsonutil
+
+import (
+	"encoding/json"
+)
+
+// OneOrMany decodes data into target whether data holds a list or a single element, since GeoServer responds with
+// the element alone when the list has only one. target is reset to nil when data is missing, null or the empty
+// string GeoServer responds with for empty lists.
+func OneOrMany[T any](data json.RawMessage, target *[]T) error {
+	*target = nil
+
+	if len(data) == 0 || string(data) == "null" || string(data) == `""` {
+		return nil
+	}
+
+	if data[0] == '[' {
+		return json.Unmarshal(data, target)
+	}
+
+	var single T
+	if err := json.Unmarshal(data, &single); err != nil {
+		return err
+	}
+
+	*target = []T{single}
+	return nil
+}
@@ -0,0 +1,62 @@
+package jsonutil
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOneOrMany(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "List",
+			data:     `["EPSG:4326", "EPSG:3857"]`,
+			expected: []string{"EPSG:4326", "EPSG:3857"},
+		},
+		{
+			name:     "Single element",
+			data:     `"EPSG:4326"`,
+			expected: []string{"EPSG:4326"},
+		},
+		{
+			name: "Missing",
+			data: ``,
+		},
+		{
+			name: "Null",
+			data: `null`,
+		},
+		{
+			name: "Empty string",
+			data: `""`,
+		},
+		{
+			name:    "Invalid element",
+			data:    `{"code": 4326}`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid list",
+			data:    `["EPSG:4326", 3857]`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := []string{"stale"}
+			err := OneOrMany([]byte(tt.data), &target)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, target)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package models
+
+type BackupWrapper struct {
+	Backup BackupExecution `json:"backup"`
+}
+
+type RestoreWrapper struct {
+	Restore BackupExecution `json:"restore"`
+}
+
+// BackupExecution is used when starting a backup or a restore.
+type BackupExecution struct {
+	ArchiveFile     string        `json:"archiveFile"`
+	Overwrite       bool          `json:"overwrite,omitempty"`
+	Options         BackupOptions `json:"options"`
+	WorkspaceFilter string        `json:"wsFilter,omitempty"`
+}
+
+type BackupOptions struct {
+	Option []string `json:"option"`
+}
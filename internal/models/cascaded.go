@@ -0,0 +1,32 @@
+package models
+
+import (
+	"github.com/canghel3/go-geoserver/pkg/shared"
+)
+
+// CascadedStore holds the details of a remote WMS or WMTS server when creating a store in GeoServer.
+type CascadedStore struct {
+	Name            string  `json:"name"`
+	Description     string  `json:"description,omitempty"`
+	Type            string  `json:"type"`
+	Enabled         bool    `json:"enabled"`
+	CapabilitiesURL string  `json:"capabilitiesURL"`
+	User            *string `json:"user,omitempty"`
+	Password        *string `json:"password,omitempty"`
+	MaxConnections  *uint   `json:"maxConnections,omitempty"`
+	ReadTimeout     *uint   `json:"readTimeout,omitempty"`
+	ConnectTimeout  *uint   `json:"connectTimeout,omitempty"`
+}
+
+// CascadedLayer holds the details of a layer published from a WMS or WMTS store.
+type CascadedLayer struct {
+	Name              string              `json:"name"`
+	NativeName        string              `json:"nativeName"`
+	Title             *string             `json:"title,omitempty"`
+	Namespace         Namespace           `json:"namespace"`
+	Srs               *string             `json:"srs,omitempty"`
+	NativeBoundingBox *shared.BoundingBox `json:"nativeBoundingBox,omitempty"`
+	ProjectionPolicy  *string             `json:"projectionPolicy,omitempty"`
+	Enabled           bool                `json:"enabled"`
+	Store             Store               `json:"store"`
+}
@@ -0,0 +1,9 @@
+package models
+
+import "time"
+
+type CatalogOptions struct {
+	Wait     bool
+	Timeout  time.Duration
+	Interval time.Duration
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"encoding/json"
+	"math"
+
 	"github.com/canghel3/go-geoserver/pkg/shared"
 )
 
@@ -13,13 +16,15 @@ type Coverage struct {
 	DefaultInterpolationMethod *string               `json:"defaultInterpolationMethod,omitempty"`
 	Description                *string               `json:"description,omitempty"`
 	Dimensions                 *CoverageDimensions   `json:"dimensions,omitempty"`
-	Enabled                    bool                  `json:"enabled"`
+	Enabled                    *bool                 `json:"enabled,omitempty"`
 	Grid                       *GridDetails          `json:"grid,omitempty"`
 	InterpolationMethods       *InterpolationMethods `json:"interpolationMethods,omitempty"`
 	ProjectionPolicy           *string               `json:"projectionPolicy,omitempty"`
 	Keywords                   *shared.Keywords      `json:"keywords,omitempty"`
 	LatLonBoundingBox          *shared.BoundingBox   `json:"latLonBoundingBox,omitempty"`
 	Metadata                   *Metadata             `json:"metadata,omitempty"`
+	MetadataLinks              *shared.MetadataLinks `json:"metadataLinks,omitempty"`
+	DataLinks                  *shared.DataLinks     `json:"dataLinks,omitempty"`
 	Name                       string                `json:"name"`
 	Namespace                  NamespaceDetails      `json:"namespace"`
 	NativeBoundingBox          *shared.BoundingBox   `json:"nativeBoundingBox,omitempty"`
@@ -35,15 +40,17 @@ type Coverage struct {
 }
 
 type CoverageDimensions struct {
-	CoverageDimension CoverageDimension `json:"coverageDimension"`
+	CoverageDimension []CoverageDimension `json:"coverageDimension"`
 }
 
+// CoverageDimension describes a band of the coverage.
 type CoverageDimension struct {
-	Description string     `json:"description"`
-	Name        string     `json:"name"`
-	DataType    DataType   `json:"dimensionType"`
-	NullValues  NullValues `json:"nullValues"`
-	Range       Range      `json:"range"`
+	Description string      `json:"description,omitempty"`
+	Name        string      `json:"name"`
+	DataType    *DataType   `json:"dimensionType,omitempty"`
+	NullValues  *NullValues `json:"nullValues,omitempty"`
+	Range       *Range      `json:"range,omitempty"`
+	Unit        string      `json:"unit,omitempty"`
 }
 
 type DataType struct {
@@ -51,7 +58,7 @@ type DataType struct {
 }
 
 type NullValues struct {
-	Double float64 `json:"double"`
+	Double []float64 `json:"double"`
 }
 
 type Range struct {
@@ -59,6 +66,25 @@ type Range struct {
 	Min float64 `json:"min"`
 }
 
+// MarshalJSON writes infinite bounds the same way GeoServer does, as "-inf" and "inf".
+func (r Range) MarshalJSON() ([]byte, error) {
+	bound := func(v float64) any {
+		switch {
+		case math.IsInf(v, -1):
+			return "-inf"
+		case math.IsInf(v, 1):
+			return "inf"
+		default:
+			return v
+		}
+	}
+
+	return json.Marshal(map[string]any{
+		"min": bound(r.Min),
+		"max": bound(r.Max),
+	})
+}
+
 type GridDetails struct {
 	Dimension int    `json:"@dimension"`
 	Crs       string `json:"crs"`
@@ -97,20 +123,9 @@ type Metadata struct {
 }
 
 type MetadataEntry struct {
-	Key           string        `json:"@key"`
-	Text          string        `json:"$"`
-	DimensionInfo DimensionInfo `json:"dimensionInfo,omitempty"`
-}
-
-type DimensionInfo struct {
-	Enabled                bool   `json:"enabled"`
-	Presentation           string `json:"presentation"`
-	Units                  string `json:"units"`
-	DefaultValue           string `json:"defaultValue"`
-	NearestMatchEnabled    bool   `json:"nearestMatchEnabled"`
-	RawNearestMatchEnabled bool   `json:"rawNearestMatchEnabled"`
-	StartValue             int    `json:"startValue"`
-	EndValue               string `json:"endValue"`
+	Key           string                `json:"@key"`
+	Text          string                `json:"$,omitempty"`
+	DimensionInfo *shared.DimensionInfo `json:"dimensionInfo,omitempty"`
 }
 
 type NamespaceDetails struct {
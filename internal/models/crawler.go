@@ -0,0 +1,11 @@
+package models
+
+type CrawlerOptions struct {
+	Concurrency int
+	// RateLimit is the maximum number of requests per second
+	RateLimit  int
+	Workspaces []string
+	StoreTypes []string
+	Pattern    string
+	Checkpoint []string
+}
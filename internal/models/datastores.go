@@ -4,3 +4,22 @@ type DataStoreOptions struct {
 	Description                string
 	DisableOnConnectionFailure bool
 }
+
+type DataStoreConnectionUpdateWrapper struct {
+	DataStore DataStoreConnectionUpdate `json:"dataStore"`
+}
+
+// DataStoreConnectionUpdate only carries the connection parameters to change, GeoServer keeps the others.
+type DataStoreConnectionUpdate struct {
+	Name                 string               `json:"name"`
+	ConnectionParameters ConnectionParameters `json:"connectionParameters"`
+}
+
+type ConnectionParameters struct {
+	Entry []ConnectionParameter `json:"entry"`
+}
+
+type ConnectionParameter struct {
+	Key   string `json:"@key"`
+	Value string `json:"$"`
+}
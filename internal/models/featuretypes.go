@@ -11,14 +11,20 @@ type CreateFeatureTypeWrapper struct {
 type FeatureType struct {
 	Name string `json:"name"`
 	//The native Name of the resource. This Name corresponds to the physical resource that feature type is derived from -- a shapefile Name, a database table, etc...
-	NativeName        string              `json:"nativeName"`
-	Namespace         Namespace           `json:"namespace"`
-	Srs               *string             `json:"srs,omitempty"`
-	NativeBoundingBox *shared.BoundingBox `json:"nativeBoundingBox,omitempty"`
-	ProjectionPolicy  *string             `json:"projectionPolicy,omitempty"`
-	Keywords          *shared.Keywords    `json:"keywords,omitempty"`
-	Title             *string             `json:"title,omitempty"`
-	Store             Store               `json:"store"`
+	NativeName        string                `json:"nativeName"`
+	Namespace         Namespace             `json:"namespace"`
+	Srs               *string               `json:"srs,omitempty"`
+	NativeBoundingBox *shared.BoundingBox   `json:"nativeBoundingBox,omitempty"`
+	ProjectionPolicy  *string               `json:"projectionPolicy,omitempty"`
+	Keywords          *shared.Keywords      `json:"keywords,omitempty"`
+	Title             *string               `json:"title,omitempty"`
+	Abstract          *string               `json:"abstract,omitempty"`
+	MetadataLinks     *shared.MetadataLinks `json:"metadataLinks,omitempty"`
+	DataLinks         *shared.DataLinks     `json:"dataLinks,omitempty"`
+	ResponseSRS       *shared.SRSList       `json:"responseSRS,omitempty"`
+	Metadata          *FeatureTypeMetadata  `json:"metadata,omitempty"`
+	Attributes        *Attributes           `json:"attributes,omitempty"`
+	Store             Store                 `json:"store"`
 }
 
 // Namespace holds workspace configuration details when creating a layer in GeoServer.
@@ -33,3 +39,31 @@ type Store struct {
 	Name  string `json:"name"`
 	Href  string `json:"href"`
 }
+
+// Attributes holds the schema of a feature type. When the native table does not exist yet,
+// GeoServer creates it in the store using these definitions.
+type Attributes struct {
+	Attribute []Attribute `json:"attribute"`
+}
+
+type Attribute struct {
+	Name      string `json:"name"`
+	MinOccurs int    `json:"minOccurs"`
+	MaxOccurs int    `json:"maxOccurs"`
+	Nillable  bool   `json:"nillable"`
+	Binding   string `json:"binding"`
+	Length    *int   `json:"length,omitempty"`
+}
+
+type FeatureTypeMetadata struct {
+	Entry []FeatureTypeMetadataEntry `json:"entry"`
+}
+
+type FeatureTypeMetadataEntry struct {
+	Key           string                `json:"@key"`
+	Value         string                `json:"$,omitempty"`
+	DimensionInfo *shared.DimensionInfo `json:"dimensionInfo,omitempty"`
+	VirtualTable  *shared.VirtualTable  `json:"virtualTable,omitempty"`
+}
+
+const VirtualTableMetadataKey = "JDBC_VIRTUAL_TABLE"
@@ -0,0 +1,36 @@
+package models
+
+import "github.com/canghel3/go-geoserver/pkg/importer"
+
+type ImportWrapper struct {
+	Import Import `json:"import"`
+}
+
+// Import is used when creating an import context.
+type Import struct {
+	TargetWorkspace *importer.TargetWorkspace `json:"targetWorkspace,omitempty"`
+	TargetStore     *importer.TargetStore     `json:"targetStore,omitempty"`
+	Data            *importer.Data            `json:"data,omitempty"`
+}
+
+type ImportTaskWrapper struct {
+	Task ImportTask `json:"task"`
+}
+
+// ImportTask is used when updating a task, only the set fields are changed.
+type ImportTask struct {
+	UpdateMode importer.UpdateMode   `json:"updateMode,omitempty"`
+	Target     *importer.TargetStore `json:"target,omitempty"`
+}
+
+type ImportLayerWrapper struct {
+	Layer ImportLayer `json:"layer"`
+}
+
+// ImportLayer is used when updating the layer of a task, only the set fields are changed.
+type ImportLayer struct {
+	Name     string `json:"name,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Abstract string `json:"abstract,omitempty"`
+	SRS      string `json:"srs,omitempty"`
+}
@@ -11,14 +11,15 @@ type GroupWrapper struct {
 
 // Group TODO: implement custom unmarshaller in case geoserver responds with numbers for name and title
 type Group struct {
-	Name         string              `json:"name"`
-	Mode         string              `json:"mode"`
-	Title        *string             `json:"title,omitempty"`
-	Workspace    *workspace.Creation `json:"workspace,omitempty"`
-	Publishables Publishables        `json:"publishables"`
-	Bounds       *shared.BoundingBox `json:"bounds,omitempty"`
-	Keywords     *shared.Keywords    `json:"keywords,omitempty"`
-	Styles       *GroupStyles        `json:"styles,omitempty"`
+	Name          string                `json:"name"`
+	Mode          string                `json:"mode"`
+	Title         *string               `json:"title,omitempty"`
+	Workspace     *workspace.Creation   `json:"workspace,omitempty"`
+	Publishables  Publishables          `json:"publishables"`
+	Bounds        *shared.BoundingBox   `json:"bounds,omitempty"`
+	Keywords      *shared.Keywords      `json:"keywords,omitempty"`
+	Styles        *GroupStyles          `json:"styles,omitempty"`
+	MetadataLinks *shared.MetadataLinks `json:"metadataLinks,omitempty"`
 }
 
 type GroupStyles struct {
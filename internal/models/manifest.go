@@ -0,0 +1,16 @@
+package models
+
+type ManifestOptions struct {
+	Prune bool
+}
+
+type ExportOptions struct {
+	Workspaces []string
+}
+
+type MigrateOptions struct {
+	Name                 string
+	ConnectionParameters func(store string, params map[string]string)
+	URL                  func(store, url string) string
+	DryRun               bool
+}
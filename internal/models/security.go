@@ -0,0 +1,12 @@
+package models
+
+type UserWrapper struct {
+	User User `json:"user"`
+}
+
+// User is used when creating and updating users of a user/group service.
+type User struct {
+	UserName string  `json:"userName"`
+	Password *string `json:"password,omitempty"`
+	Enabled  *bool   `json:"enabled,omitempty"`
+}
@@ -0,0 +1,24 @@
+package models
+
+type WorkspaceWrapper struct {
+	Workspace Workspace `json:"workspace"`
+}
+
+type Workspace struct {
+	Name     string `json:"name"`
+	Isolated *bool  `json:"isolated,omitempty"`
+	// URI is not part of the workspace, it is set on the namespace with the same name
+	URI string `json:"-"`
+}
+
+type NamespaceDefinitionWrapper struct {
+	Namespace NamespaceDefinition `json:"namespace"`
+}
+
+// NamespaceDefinition is used when creating and updating namespaces.
+// Namespace is the reference to a namespace used by resources.
+type NamespaceDefinition struct {
+	Prefix   string `json:"prefix"`
+	URI      string `json:"uri,omitempty"`
+	Isolated *bool  `json:"isolated,omitempty"`
+}
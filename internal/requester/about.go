@@ -26,7 +26,11 @@ func (ar AboutRequester) Manifest() (*about.Manifest, error) {
 		return nil, err
 	}
 
-	request.SetBasicAuth(ar.data.Connection.Credentials.Username, ar.data.Connection.Credentials.Password)
+	err = ar.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := ar.data.Client.Do(request)
@@ -60,7 +64,11 @@ func (ar AboutRequester) Version() (*about.Version, error) {
 		return nil, err
 	}
 
-	request.SetBasicAuth(ar.data.Connection.Credentials.Username, ar.data.Connection.Credentials.Password)
+	err = ar.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := ar.data.Client.Do(request)
@@ -94,7 +102,11 @@ func (ar AboutRequester) Status() (*about.Status, error) {
 		return nil, err
 	}
 
-	request.SetBasicAuth(ar.data.Connection.Credentials.Username, ar.data.Connection.Credentials.Password)
+	err = ar.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := ar.data.Client.Do(request)
@@ -128,7 +140,11 @@ func (ar AboutRequester) SystemStatus() (*about.Metrics, error) {
 		return nil, err
 	}
 
-	request.SetBasicAuth(ar.data.Connection.Credentials.Username, ar.data.Connection.Credentials.Password)
+	err = ar.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := ar.data.Client.Do(request)
@@ -0,0 +1,75 @@
+package requester
+
+import (
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/pkg/security"
+	"net/http"
+	"net/url"
+)
+
+// Access rule categories, as used in the REST paths.
+const (
+	ACLLayers   = "layers"
+	ACLServices = "services"
+	ACLREST     = "rest"
+)
+
+// ACLRequester manages the access rules of one category
+type ACLRequester struct {
+	data     internal.GeoserverData
+	category string
+}
+
+func NewACLRequester(data internal.GeoserverData, category string) ACLRequester {
+	return ACLRequester{
+		data:     data,
+		category: category,
+	}
+}
+
+func (ar ACLRequester) target() string {
+	return fmt.Sprintf("%s/geoserver/rest/security/acl/%s", ar.data.Connection.URL, ar.category)
+}
+
+func (ar ACLRequester) GetAll() (map[string]string, error) {
+	var rules map[string]string
+	err := securityRead(ar.data, ar.target(), &rules, fmt.Errorf("%s access rules not found", ar.category))
+	if err != nil {
+		return nil, err
+	}
+
+	if rules == nil {
+		rules = map[string]string{}
+	}
+
+	return rules, nil
+}
+
+// Add fails with a conflict when one of the rules already exists
+func (ar ACLRequester) Add(content []byte) error {
+	return securityWrite(ar.data, http.MethodPost, ar.target(), content, fmt.Errorf("%s access rules not found", ar.category))
+}
+
+// Update fails with a conflict when one of the rules does not exist
+func (ar ACLRequester) Update(content []byte) error {
+	return securityWrite(ar.data, http.MethodPut, ar.target(), content, fmt.Errorf("%s access rules not found", ar.category))
+}
+
+func (ar ACLRequester) Delete(key string) error {
+	return securityWrite(ar.data, http.MethodDelete, fmt.Sprintf("%s/%s", ar.target(), url.PathEscape(key)), nil, fmt.Errorf("access rule %s not found", key))
+}
+
+func (ar ACLRequester) GetCatalogMode() (*security.CatalogMode, error) {
+	var mode security.CatalogModeWrapper
+	err := securityRead(ar.data, fmt.Sprintf("%s/geoserver/rest/security/acl/catalog", ar.data.Connection.URL), &mode, fmt.Errorf("catalog mode not found"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &mode.Mode, nil
+}
+
+func (ar ACLRequester) SetCatalogMode(content []byte) error {
+	return securityWrite(ar.data, http.MethodPut, fmt.Sprintf("%s/geoserver/rest/security/acl/catalog", ar.data.Connection.URL), content, fmt.Errorf("catalog mode not found"))
+}
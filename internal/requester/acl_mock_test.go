@@ -0,0 +1,450 @@
+package requester
+
+import (
+	"bytes"
+	"errors"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/security"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	getLayerRulesResponse  = "../testdata/security/acl_layers.json"
+	getCatalogModeResponse = "../testdata/security/catalog.json"
+)
+
+func TestACLRequester_GetAll(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getLayerRulesResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		rules, err := aclRequester.GetAll()
+		assert.NoError(t, err)
+		assert.Len(t, rules, 3)
+		assert.Equal(t, "GROUP_ADMIN,ADMIN", rules["*.*.w"])
+	})
+
+	t.Run("200 Ok No Rules", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{}")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		rules, err := aclRequester.GetAll()
+		assert.NoError(t, err)
+		assert.NotNil(t, rules)
+		assert.Empty(t, rules)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		rules, err := aclRequester.GetAll()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, rules)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		rules, err := aclRequester.GetAll()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, rules)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		rules, err := aclRequester.GetAll()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, rules)
+	})
+}
+
+func TestACLRequester_Add(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.Add([]byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("201 Created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.Add([]byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("409 Conflict", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusConflict,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("already exists")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.Add([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 409 from geoserver: already exists")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.Add([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.Add([]byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestACLRequester_Update(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.Update([]byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.Update([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.Update([]byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestACLRequester_Delete(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodDelete, request.Method)
+			assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/security/acl/rest/%2F%2A%2A:GET", request.URL.String())
+			return mockResponse, nil
+		})
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLREST)
+
+		err := aclRequester.Delete("/**:GET")
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.Delete("*.*.r")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "access rule *.*.r not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.Delete("*.*.r")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.Delete("*.*.r")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestACLRequester_GetCatalogMode(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getCatalogModeResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		mode, err := aclRequester.GetCatalogMode()
+		assert.NoError(t, err)
+		assert.Equal(t, security.Hide, *mode)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		mode, err := aclRequester.GetCatalogMode()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, mode)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		mode, err := aclRequester.GetCatalogMode()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, mode)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		mode, err := aclRequester.GetCatalogMode()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, mode)
+	})
+}
+
+func TestACLRequester_SetCatalogMode(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.SetCatalogMode([]byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.SetCatalogMode([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		aclRequester := NewACLRequester(testdata.GeoserverInfo(mockClient), ACLLayers)
+
+		err := aclRequester.SetCatalogMode([]byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
@@ -0,0 +1,172 @@
+package requester
+
+import (
+	"bytes"
+	"errors"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/auth"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// expectAuthenticatedRequest expects a single request and passes it to check before replying with the version fixture
+func expectAuthenticatedRequest(t *testing.T, mockClient *mocks.MockHTTPClient, check func(request *http.Request)) {
+	content, err := testdata.Read(versionFile)
+	assert.NoError(t, err)
+
+	mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+		check(request)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}, nil
+	})
+}
+
+func TestAuthentication(t *testing.T) {
+	t.Run("Default Basic", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		expectAuthenticatedRequest(t, mockClient, func(request *http.Request) {
+			username, password, ok := request.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, testdata.GeoserverUsername, username)
+			assert.Equal(t, testdata.GeoserverPassword, password)
+		})
+
+		aboutRequester := NewAboutRequester(testdata.GeoserverInfo(mockClient))
+
+		_, err := aboutRequester.Version()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Bearer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		expectAuthenticatedRequest(t, mockClient, func(request *http.Request) {
+			_, _, ok := request.BasicAuth()
+			assert.False(t, ok)
+			assert.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+		})
+
+		data := testdata.GeoserverInfo(mockClient)
+		data.Connection.Authenticator = auth.Bearer("token")
+		aboutRequester := NewAboutRequester(data)
+
+		_, err := aboutRequester.Version()
+		assert.NoError(t, err)
+	})
+
+	t.Run("AuthKey", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		expectAuthenticatedRequest(t, mockClient, func(request *http.Request) {
+			assert.Equal(t, "key", request.URL.Query().Get("authkey"))
+			assert.Empty(t, request.Header.Get("Authorization"))
+		})
+
+		data := testdata.GeoserverInfo(mockClient)
+		data.Connection.Authenticator = auth.AuthKey("key")
+		aboutRequester := NewAboutRequester(data)
+
+		_, err := aboutRequester.Version()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Header", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		expectAuthenticatedRequest(t, mockClient, func(request *http.Request) {
+			assert.Equal(t, "admin", request.Header.Get("X-Forwarded-User"))
+		})
+
+		data := testdata.GeoserverInfo(mockClient)
+		data.Connection.Authenticator = auth.Header("X-Forwarded-User", "admin")
+		aboutRequester := NewAboutRequester(data)
+
+		_, err := aboutRequester.Version()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Refreshing Bearer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		expectAuthenticatedRequest(t, mockClient, func(request *http.Request) {
+			assert.Equal(t, "Bearer token-1", request.Header.Get("Authorization"))
+		})
+		expectAuthenticatedRequest(t, mockClient, func(request *http.Request) {
+			assert.Equal(t, "Bearer token-1", request.Header.Get("Authorization"))
+		})
+
+		var calls int
+		data := testdata.GeoserverInfo(mockClient)
+		data.Connection.Authenticator = auth.RefreshingBearer(func() (string, time.Time, error) {
+			calls++
+			return "token-" + string(rune('0'+calls)), time.Now().Add(time.Hour), nil
+		})
+		aboutRequester := NewAboutRequester(data)
+
+		_, err := aboutRequester.Version()
+		assert.NoError(t, err)
+
+		_, err = aboutRequester.Version()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Refreshing Bearer Expired", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		expectAuthenticatedRequest(t, mockClient, func(request *http.Request) {
+			assert.Equal(t, "Bearer token-1", request.Header.Get("Authorization"))
+		})
+		expectAuthenticatedRequest(t, mockClient, func(request *http.Request) {
+			assert.Equal(t, "Bearer token-2", request.Header.Get("Authorization"))
+		})
+
+		var calls int
+		data := testdata.GeoserverInfo(mockClient)
+		data.Connection.Authenticator = auth.RefreshingBearer(func() (string, time.Time, error) {
+			calls++
+			// expires within the refresh leeway, so every request fetches a new token
+			return "token-" + string(rune('0'+calls)), time.Now().Add(time.Second), nil
+		})
+		aboutRequester := NewAboutRequester(data)
+
+		_, err := aboutRequester.Version()
+		assert.NoError(t, err)
+
+		_, err = aboutRequester.Version()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Refreshing Bearer Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+
+		data := testdata.GeoserverInfo(mockClient)
+		data.Connection.Authenticator = auth.RefreshingBearer(func() (string, time.Time, error) {
+			return "", time.Time{}, errors.New("token endpoint unavailable")
+		})
+		aboutRequester := NewAboutRequester(data)
+
+		version, err := aboutRequester.Version()
+		assert.Error(t, err)
+		assert.Nil(t, version)
+		assert.EqualError(t, err, "token endpoint unavailable")
+	})
+}
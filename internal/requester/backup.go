@@ -0,0 +1,215 @@
+package requester
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/pkg/backup"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	BackupKind  = "backup"
+	RestoreKind = "restore"
+)
+
+// BackupRequester talks to the /rest/br endpoints of the backup-restore extension,
+// kind selects between the backup and the restore executions
+type BackupRequester struct {
+	data internal.GeoserverData
+	kind string
+}
+
+func NewBackupRequester(data internal.GeoserverData, kind string) BackupRequester {
+	return BackupRequester{data: data, kind: kind}
+}
+
+// Start launches a new execution, GeoServer runs it asynchronously and replies right away
+func (br BackupRequester) Start(content []byte) (*backup.Execution, error) {
+	request, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/geoserver/rest/br/%s/", br.data.Connection.URL, br.kind), bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	err = br.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Content-Type", "application/json")
+	request.Header.Add("Accept", "application/json")
+
+	response, err := br.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return br.decode(body)
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("backup-restore extension not installed"))
+	default:
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (br BackupRequester) Get(id int64) (*backup.Execution, error) {
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/geoserver/rest/br/%s/%d.json", br.data.Connection.URL, br.kind, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = br.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := br.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return br.decode(body)
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("%s %d not found", br.kind, id))
+	default:
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+// Abort stops a running execution
+func (br BackupRequester) Abort(id int64) error {
+	request, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/geoserver/rest/br/%s/%d", br.data.Connection.URL, br.kind, id), nil)
+	if err != nil {
+		return err
+	}
+
+	err = br.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	response, err := br.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(fmt.Errorf("%s %d not found", br.kind, id))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+// Download retrieves the zip archive written by a completed backup
+func (br BackupRequester) Download(id int64) ([]byte, error) {
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/geoserver/rest/br/%s/%d.zip", br.data.Connection.URL, br.kind, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = br.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := br.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("archive of %s %d not found", br.kind, id))
+	default:
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+// Upload stores the archive in the data directory through the resource API, so that it can be restored.
+// The extension itself only restores archives that are already on the GeoServer host.
+func (br BackupRequester) Upload(path string, content []byte) error {
+	request, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/geoserver/rest/resource/%s", br.data.Connection.URL, strings.TrimPrefix(path, "/")), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	err = br.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Content-Type", "application/zip")
+
+	response, err := br.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return nil
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+// decode extracts the execution from the backup or restore wrapper
+func (br BackupRequester) decode(body []byte) (*backup.Execution, error) {
+	var wrapper map[string]backup.Execution
+	err := json.Unmarshal(body, &wrapper)
+	if err != nil {
+		return nil, err
+	}
+
+	execution, ok := wrapper[br.kind]
+	if !ok {
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("response does not contain a %s execution", br.kind))
+	}
+
+	return &execution, nil
+}
@@ -0,0 +1,525 @@
+package requester
+
+import (
+	"bytes"
+	"errors"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/backup"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	backupResponse        = "../testdata/backup/backup.json"
+	restoreFailedResponse = "../testdata/backup/restore_failed.json"
+)
+
+func TestBackupRequester_Start(t *testing.T) {
+	t.Run("201 Created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(backupResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		execution, err := backupRequester.Start([]byte(`{}`))
+		assert.NoError(t, err)
+		assert.NotNil(t, execution)
+		assert.Equal(t, int64(2), execution.Execution.ID)
+		assert.Equal(t, backup.StatusStarted, execution.Execution.Status)
+		assert.False(t, execution.Execution.Status.Done())
+		assert.Equal(t, backup.Progress{Completed: 1, Total: 9}, execution.Execution.Progress)
+		assert.Len(t, execution.Execution.Steps.Step, 2)
+		assert.Equal(t, "backupNamespaceInfos", execution.Execution.Steps.Step[0].Name)
+		assert.Equal(t, 2, execution.Execution.Steps.Step[0].WriteCount)
+		assert.Equal(t, backup.Messages{"BK_BEST_EFFORT=true", "BK_CLEANUP_TEMP=true"}, execution.Options)
+		assert.Empty(t, execution.Warnings)
+		assert.Equal(t, "/tmp/backup.zip", execution.ArchiveFile.File)
+		assert.Equal(t, "name IN ('PLAYGROUND')", execution.WorkspaceFilter)
+		assert.NoError(t, execution.Err())
+	})
+
+	t.Run("Wrong Kind", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(backupResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		restoreRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), RestoreKind)
+
+		execution, err := restoreRequester.Start([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "response does not contain a restore execution")
+		assert.Nil(t, execution)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		execution, err := backupRequester.Start([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "backup-restore extension not installed")
+		assert.Nil(t, execution)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		execution, err := backupRequester.Start([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, execution)
+	})
+
+	t.Run("Invalid JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		execution, err := backupRequester.Start([]byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected end of JSON input")
+		assert.Nil(t, execution)
+	})
+
+	t.Run("Invalid Body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(&testdata.ErrorReader{}),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		execution, err := backupRequester.Start([]byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "reader error")
+		assert.Nil(t, execution)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		execution, err := backupRequester.Start([]byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, execution)
+	})
+}
+
+func TestBackupRequester_Get(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(restoreFailedResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		restoreRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), RestoreKind)
+
+		execution, err := restoreRequester.Get(3)
+		assert.NoError(t, err)
+		assert.NotNil(t, execution)
+		assert.Equal(t, backup.StatusFailed, execution.Execution.Status)
+		assert.True(t, execution.Execution.Status.Done())
+		assert.Equal(t, backup.Progress{Completed: 0, Total: 9}, execution.Execution.Progress)
+		assert.Len(t, execution.Execution.Steps.Step, 1)
+		assert.Equal(t, backup.Messages{"BK_DRY_RUN=true"}, execution.Options)
+		assert.Equal(t, backup.Messages{"style generic skipped"}, execution.Warnings)
+		assert.Equal(t, "/tmp/backup.zip", execution.ArchiveFile.File)
+
+		var executionError *backup.ExecutionError
+		assert.ErrorAs(t, execution.Err(), &executionError)
+		assert.Equal(t, int64(3), executionError.ID)
+		assert.Equal(t, []string{"java.lang.IllegalStateException: namespace already exists"}, executionError.Failures)
+		assert.EqualError(t, execution.Err(), "execution 3 ended with status FAILED: restore failed (java.lang.IllegalStateException: namespace already exists)")
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		restoreRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), RestoreKind)
+
+		execution, err := restoreRequester.Get(3)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "restore 3 not found")
+		assert.Nil(t, execution)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		restoreRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), RestoreKind)
+
+		execution, err := restoreRequester.Get(3)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, execution)
+	})
+
+	t.Run("Invalid JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		restoreRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), RestoreKind)
+
+		execution, err := restoreRequester.Get(3)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected end of JSON input")
+		assert.Nil(t, execution)
+	})
+
+	t.Run("Invalid Progress", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(`{"restore": {"execution": {"progress": "half"}}}`)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		restoreRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), RestoreKind)
+
+		execution, err := restoreRequester.Get(3)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "invalid progress \"half\"")
+		assert.Nil(t, execution)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		restoreRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), RestoreKind)
+
+		execution, err := restoreRequester.Get(3)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, execution)
+	})
+}
+
+func TestBackupRequester_Abort(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		err := backupRequester.Abort(2)
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		err := backupRequester.Abort(2)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "backup 2 not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		err := backupRequester.Abort(2)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		err := backupRequester.Abort(2)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestBackupRequester_Download(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("PK")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		archive, err := backupRequester.Download(2)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("PK"), archive)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		archive, err := backupRequester.Download(2)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "archive of backup 2 not found")
+		assert.Nil(t, archive)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		archive, err := backupRequester.Download(2)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, archive)
+	})
+
+	t.Run("Invalid Body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(&testdata.ErrorReader{}),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		archive, err := backupRequester.Download(2)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "reader error")
+		assert.Nil(t, archive)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		backupRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), BackupKind)
+
+		archive, err := backupRequester.Download(2)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, archive)
+	})
+}
+
+func TestBackupRequester_Upload(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		restoreRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), RestoreKind)
+
+		err := restoreRequester.Upload("br/backup.zip", []byte("PK"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("201 Created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		restoreRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), RestoreKind)
+
+		err := restoreRequester.Upload("br/backup.zip", []byte("PK"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		restoreRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), RestoreKind)
+
+		err := restoreRequester.Upload("br/backup.zip", []byte("PK"))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		restoreRequester := NewBackupRequester(testdata.GeoserverInfo(mockClient), RestoreKind)
+
+		err := restoreRequester.Upload("br/backup.zip", []byte("PK"))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
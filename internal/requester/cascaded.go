@@ -0,0 +1,435 @@
+package requester
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/pkg/cascaded"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"io"
+	"net/http"
+)
+
+// CascadedKind is the kind of remote server cascaded by a store. The WMS and WMTS stores share their REST API, only
+// the paths and the keys wrapping the JSON are named after the kind.
+type CascadedKind string
+
+const (
+	CascadedWMS  CascadedKind = "wms"
+	CascadedWMTS CascadedKind = "wmts"
+)
+
+// StoreKey is the key wrapping a store, wmsStore or wmtsStore, the lists of stores being wrapped in its plural
+func (ck CascadedKind) StoreKey() string {
+	return string(ck) + "Store"
+}
+
+// LayerKey is the key wrapping a layer, wmsLayer or wmtsLayer, the lists of layers being wrapped in its plural
+func (ck CascadedKind) LayerKey() string {
+	return string(ck) + "Layer"
+}
+
+// StoresPath is the path of the stores inside a workspace
+func (ck CascadedKind) StoresPath() string {
+	return string(ck) + "stores"
+}
+
+// layersPath is the path of the layers inside a store, which GeoServer names wmslayers for WMS but layers for WMTS
+func (ck CascadedKind) layersPath() string {
+	if ck == CascadedWMS {
+		return "wmslayers"
+	}
+
+	return "layers"
+}
+
+type CascadedStoreRequester struct {
+	data internal.GeoserverData
+	kind CascadedKind
+}
+
+func NewCascadedStoreRequester(data internal.GeoserverData, kind CascadedKind) CascadedStoreRequester {
+	return CascadedStoreRequester{
+		data: data,
+		kind: kind,
+	}
+}
+
+func (csr CascadedStoreRequester) Create(content []byte) error {
+	request, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/geoserver/rest/workspaces/%s/%s", csr.data.Connection.URL, csr.data.Workspace, csr.kind.StoresPath()), bytes.NewBuffer(content))
+	if err != nil {
+		return err
+	}
+
+	err = csr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Content-Type", "application/json")
+
+	response, err := csr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return nil
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (csr CascadedStoreRequester) GetAll() (*cascaded.Stores, error) {
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/geoserver/rest/workspaces/%s/%s", csr.data.Connection.URL, csr.data.Workspace, csr.kind.StoresPath()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = csr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := csr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var wrapper map[string]cascaded.Stores
+		err = json.NewDecoder(response.Body).Decode(&wrapper)
+		if err != nil {
+			return nil, err
+		}
+
+		stores := wrapper[csr.kind.StoreKey()+"s"]
+		return &stores, nil
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (csr CascadedStoreRequester) Get(name string) (*cascaded.Store, error) {
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/geoserver/rest/workspaces/%s/%s/%s", csr.data.Connection.URL, csr.data.Workspace, csr.kind.StoresPath(), name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = csr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := csr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var wrapper map[string]cascaded.Store
+		err = json.NewDecoder(response.Body).Decode(&wrapper)
+		if err != nil {
+			return nil, err
+		}
+
+		store := wrapper[csr.kind.StoreKey()]
+		return &store, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("%sstore %s not found", csr.kind, name))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (csr CascadedStoreRequester) Update(name string, content []byte) error {
+	request, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/geoserver/rest/workspaces/%s/%s/%s", csr.data.Connection.URL, csr.data.Workspace, csr.kind.StoresPath(), name), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	err = csr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Content-Type", "application/json")
+
+	response, err := csr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(fmt.Errorf("%sstore %s not found", csr.kind, name))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (csr CascadedStoreRequester) Delete(name string, recurse bool) error {
+	request, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/geoserver/rest/workspaces/%s/%s/%s?recurse=%v", csr.data.Connection.URL, csr.data.Workspace, csr.kind.StoresPath(), name, recurse), nil)
+	if err != nil {
+		return err
+	}
+
+	err = csr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	response, err := csr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(fmt.Errorf("%sstore %s not found", csr.kind, name))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+type CascadedLayerRequester struct {
+	data internal.GeoserverData
+	kind CascadedKind
+}
+
+func NewCascadedLayerRequester(data internal.GeoserverData, kind CascadedKind) CascadedLayerRequester {
+	return CascadedLayerRequester{
+		data: data,
+		kind: kind,
+	}
+}
+
+func (clr CascadedLayerRequester) Create(store string, content []byte) error {
+	var target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/%s/%s/%s", clr.data.Connection.URL, clr.data.Workspace, clr.kind.StoresPath(), store, clr.kind.layersPath())
+
+	request, err := http.NewRequest(http.MethodPost, target, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	err = clr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Content-Type", "application/json")
+
+	response, err := clr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusCreated, http.StatusOK:
+		return nil
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (clr CascadedLayerRequester) Get(store, layer string) (*cascaded.Layer, error) {
+	var target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/%s/%s/%s/%s", clr.data.Connection.URL, clr.data.Workspace, clr.kind.StoresPath(), store, clr.kind.layersPath(), layer)
+
+	request, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = clr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := clr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var wrapper map[string]cascaded.Layer
+		err = json.NewDecoder(response.Body).Decode(&wrapper)
+		if err != nil {
+			return nil, err
+		}
+
+		l := wrapper[clr.kind.LayerKey()]
+		return &l, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("%slayer %s not found", clr.kind, layer))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (clr CascadedLayerRequester) GetAll(store string) (*cascaded.Layers, error) {
+	var target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/%s/%s/%s", clr.data.Connection.URL, clr.data.Workspace, clr.kind.StoresPath(), store, clr.kind.layersPath())
+
+	request, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = clr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := clr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var wrapper map[string]cascaded.Layers
+		err = json.NewDecoder(response.Body).Decode(&wrapper)
+		if err != nil {
+			return nil, err
+		}
+
+		layers := wrapper[clr.kind.LayerKey()+"s"]
+		return &layers, nil
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (clr CascadedLayerRequester) Update(store, layer string, content []byte) error {
+	var target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/%s/%s/%s/%s", clr.data.Connection.URL, clr.data.Workspace, clr.kind.StoresPath(), store, clr.kind.layersPath(), layer)
+
+	request, err := http.NewRequest(http.MethodPut, target, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	err = clr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Content-Type", "application/json")
+
+	response, err := clr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(fmt.Errorf("%slayer %s not found", clr.kind, layer))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (clr CascadedLayerRequester) Delete(store, layer string, recurse bool) error {
+	var target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/%s/%s/%s/%s?recurse=%v", clr.data.Connection.URL, clr.data.Workspace, clr.kind.StoresPath(), store, clr.kind.layersPath(), layer, recurse)
+
+	request, err := http.NewRequest(http.MethodDelete, target, nil)
+	if err != nil {
+		return err
+	}
+
+	err = clr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	response, err := clr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(fmt.Errorf("%slayer %s not found", clr.kind, layer))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
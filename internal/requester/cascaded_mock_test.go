@@ -0,0 +1,360 @@
+package requester
+
+import (
+	"errors"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/cascaded"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// cascadedKinds runs every test against the WMS and the WMTS stores, which only differ by their paths and JSON keys
+var cascadedKinds = []struct {
+	kind      CascadedKind
+	testdata  string
+	storeType string
+	// stores and layers are the paths expected for the stores of the workspace and the layers of the basemaps store
+	stores string
+	layers string
+}{
+	{
+		kind:      CascadedWMS,
+		testdata:  "../testdata/wmsstores",
+		storeType: "WMS",
+		stores:    "/geoserver/rest/workspaces/PLAYGROUND/wmsstores",
+		layers:    "/geoserver/rest/workspaces/PLAYGROUND/wmsstores/basemaps/wmslayers",
+	},
+	{
+		kind:      CascadedWMTS,
+		testdata:  "../testdata/wmtsstores",
+		storeType: "WMTS",
+		stores:    "/geoserver/rest/workspaces/PLAYGROUND/wmtsstores",
+		layers:    "/geoserver/rest/workspaces/PLAYGROUND/wmtsstores/basemaps/layers",
+	},
+}
+
+type cascadedResponse struct {
+	name   string
+	status int
+	body   string
+	// err is the error expected, none when empty
+	err string
+	// errType is the type of the error expected, when it is one of the customerrors
+	errType any
+}
+
+// cascadedClient answers a single request with the response after checking its method and path, or fails it with a
+// client error when the response has no status
+func cascadedClient(t *testing.T, method, path string, response cascadedResponse) *mocks.MockHTTPClient {
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockHTTPClient(ctrl)
+
+	if response.status == 0 {
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+		return mockClient
+	}
+
+	mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+		assert.Equal(t, method, request.Method)
+		assert.Equal(t, path, request.URL.Path)
+
+		return &http.Response{
+			StatusCode: response.status,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(response.body)),
+		}, nil
+	})
+
+	return mockClient
+}
+
+func checkCascadedError(t *testing.T, response cascadedResponse, err error) {
+	if response.err == "" {
+		assert.NoError(t, err)
+		return
+	}
+
+	assert.EqualError(t, err, response.err)
+	if response.errType != nil {
+		assert.IsType(t, response.errType, err)
+	}
+}
+
+func read(t *testing.T, file string) string {
+	content, err := testdata.Read(file)
+	assert.NoError(t, err)
+
+	return string(content)
+}
+
+func TestCascadedStoreRequester_Create(t *testing.T) {
+	for _, kind := range cascadedKinds {
+		responses := []cascadedResponse{
+			{name: "200 Ok", status: http.StatusOK},
+			{name: "201 Created", status: http.StatusCreated},
+			{name: "500 Internal Server Error", status: http.StatusInternalServerError, body: "some error", err: "received status code 500 from geoserver: some error", errType: &customerrors.GeoserverError{}},
+			{name: "Client Error", err: "client error"},
+		}
+
+		for _, response := range responses {
+			t.Run(kind.storeType+" "+response.name, func(t *testing.T) {
+				mockClient := cascadedClient(t, http.MethodPost, kind.stores, response)
+				requester := NewCascadedStoreRequester(testdata.GeoserverInfo(mockClient), kind.kind)
+
+				checkCascadedError(t, response, requester.Create(nil))
+			})
+		}
+	}
+}
+
+func TestCascadedStoreRequester_Get(t *testing.T) {
+	for _, kind := range cascadedKinds {
+		responses := []cascadedResponse{
+			{name: "200 Ok", status: http.StatusOK, body: read(t, kind.testdata+"/getsingle.json")},
+			{name: "404 Not Found", status: http.StatusNotFound, body: "not found", err: string(kind.kind) + "store basemaps not found", errType: &customerrors.NotFoundError{}},
+			{name: "500 Internal Server Error", status: http.StatusInternalServerError, body: "some error", err: "received status code 500 from geoserver: some error", errType: &customerrors.GeoserverError{}},
+			{name: "Invalid JSON", status: http.StatusOK, body: "{", err: "unexpected EOF"},
+			{name: "Client Error", err: "client error"},
+		}
+
+		for _, response := range responses {
+			t.Run(kind.storeType+" "+response.name, func(t *testing.T) {
+				mockClient := cascadedClient(t, http.MethodGet, kind.stores+"/basemaps", response)
+				requester := NewCascadedStoreRequester(testdata.GeoserverInfo(mockClient), kind.kind)
+
+				store, err := requester.Get("basemaps")
+				checkCascadedError(t, response, err)
+				if err != nil {
+					assert.Nil(t, store)
+					return
+				}
+
+				assert.Equal(t, "basemaps", store.Name)
+				assert.Equal(t, kind.storeType, store.Type)
+				assert.Equal(t, "init_test", store.Workspace.Name)
+				assert.Equal(t, "reader", store.User)
+				assert.Equal(t, 6, store.MaxConnections)
+				assert.Equal(t, 60, store.ReadTimeout)
+				assert.Equal(t, 30, store.ConnectTimeout)
+			})
+		}
+	}
+}
+
+func TestCascadedStoreRequester_GetAll(t *testing.T) {
+	for _, kind := range cascadedKinds {
+		responses := []cascadedResponse{
+			{name: "200 Ok", status: http.StatusOK, body: read(t, kind.testdata+"/getall.json")},
+			{name: "No Stores", status: http.StatusOK, body: `{"` + kind.kind.StoreKey() + `s": ""}`},
+			{name: "Single Store", status: http.StatusOK, body: `{"` + kind.kind.StoreKey() + `s": {"` + kind.kind.StoreKey() + `": {"name": "basemaps"}}}`},
+			{name: "500 Internal Server Error", status: http.StatusInternalServerError, body: "some error", err: "received status code 500 from geoserver: some error", errType: &customerrors.GeoserverError{}},
+			{name: "Invalid JSON", status: http.StatusOK, body: "{", err: "unexpected EOF"},
+			{name: "Client Error", err: "client error"},
+		}
+
+		for _, response := range responses {
+			t.Run(kind.storeType+" "+response.name, func(t *testing.T) {
+				mockClient := cascadedClient(t, http.MethodGet, kind.stores, response)
+				requester := NewCascadedStoreRequester(testdata.GeoserverInfo(mockClient), kind.kind)
+
+				stores, err := requester.GetAll()
+				checkCascadedError(t, response, err)
+				if err != nil {
+					assert.Nil(t, stores)
+					return
+				}
+
+				if response.name == "No Stores" {
+					assert.Empty(t, stores.Entries)
+					return
+				}
+
+				if response.name == "Single Store" {
+					assert.Equal(t, []cascaded.Entry{{Name: "basemaps"}}, stores.Entries)
+					return
+				}
+
+				assert.Len(t, stores.Entries, 2)
+				assert.Equal(t, "basemaps", stores.Entries[0].Name)
+				assert.Equal(t, "orthophoto", stores.Entries[1].Name)
+			})
+		}
+	}
+}
+
+func TestCascadedStoreRequester_Update(t *testing.T) {
+	for _, kind := range cascadedKinds {
+		responses := []cascadedResponse{
+			{name: "200 Ok", status: http.StatusOK},
+			{name: "404 Not Found", status: http.StatusNotFound, err: string(kind.kind) + "store basemaps not found", errType: &customerrors.NotFoundError{}},
+			{name: "500 Internal Server Error", status: http.StatusInternalServerError, body: "some error", err: "received status code 500 from geoserver: some error", errType: &customerrors.GeoserverError{}},
+			{name: "Client Error", err: "client error"},
+		}
+
+		for _, response := range responses {
+			t.Run(kind.storeType+" "+response.name, func(t *testing.T) {
+				mockClient := cascadedClient(t, http.MethodPut, kind.stores+"/basemaps", response)
+				requester := NewCascadedStoreRequester(testdata.GeoserverInfo(mockClient), kind.kind)
+
+				checkCascadedError(t, response, requester.Update("basemaps", nil))
+			})
+		}
+	}
+}
+
+func TestCascadedStoreRequester_Delete(t *testing.T) {
+	for _, kind := range cascadedKinds {
+		responses := []cascadedResponse{
+			{name: "200 Ok", status: http.StatusOK},
+			{name: "404 Not Found", status: http.StatusNotFound, err: string(kind.kind) + "store basemaps not found", errType: &customerrors.NotFoundError{}},
+			{name: "500 Internal Server Error", status: http.StatusInternalServerError, body: "some error", err: "received status code 500 from geoserver: some error", errType: &customerrors.GeoserverError{}},
+			{name: "Client Error", err: "client error"},
+		}
+
+		for _, response := range responses {
+			t.Run(kind.storeType+" "+response.name, func(t *testing.T) {
+				mockClient := cascadedClient(t, http.MethodDelete, kind.stores+"/basemaps", response)
+				requester := NewCascadedStoreRequester(testdata.GeoserverInfo(mockClient), kind.kind)
+
+				checkCascadedError(t, response, requester.Delete("basemaps", true))
+			})
+		}
+	}
+}
+
+func TestCascadedLayerRequester_Create(t *testing.T) {
+	for _, kind := range cascadedKinds {
+		responses := []cascadedResponse{
+			{name: "201 Created", status: http.StatusCreated},
+			{name: "500 Internal Server Error", status: http.StatusInternalServerError, body: "some error", err: "received status code 500 from geoserver: some error", errType: &customerrors.GeoserverError{}},
+			{name: "Client Error", err: "client error"},
+		}
+
+		for _, response := range responses {
+			t.Run(kind.storeType+" "+response.name, func(t *testing.T) {
+				mockClient := cascadedClient(t, http.MethodPost, kind.layers, response)
+				requester := NewCascadedLayerRequester(testdata.GeoserverInfo(mockClient), kind.kind)
+
+				checkCascadedError(t, response, requester.Create("basemaps", nil))
+			})
+		}
+	}
+}
+
+func TestCascadedLayerRequester_Get(t *testing.T) {
+	for _, kind := range cascadedKinds {
+		responses := []cascadedResponse{
+			{name: "200 Ok", status: http.StatusOK, body: read(t, kind.testdata+"/getlayer.json")},
+			{name: "404 Not Found", status: http.StatusNotFound, body: "not found", err: string(kind.kind) + "layer streets not found", errType: &customerrors.NotFoundError{}},
+			{name: "500 Internal Server Error", status: http.StatusInternalServerError, body: "some error", err: "received status code 500 from geoserver: some error", errType: &customerrors.GeoserverError{}},
+			{name: "Invalid JSON", status: http.StatusOK, body: "{", err: "unexpected EOF"},
+			{name: "Client Error", err: "client error"},
+		}
+
+		for _, response := range responses {
+			t.Run(kind.storeType+" "+response.name, func(t *testing.T) {
+				mockClient := cascadedClient(t, http.MethodGet, kind.layers+"/streets", response)
+				requester := NewCascadedLayerRequester(testdata.GeoserverInfo(mockClient), kind.kind)
+
+				layer, err := requester.Get("basemaps", "streets")
+				checkCascadedError(t, response, err)
+				if err != nil {
+					assert.Nil(t, layer)
+					return
+				}
+
+				assert.Equal(t, "streets", layer.Name)
+				assert.Equal(t, "osm:streets", layer.NativeName)
+				assert.Equal(t, "EPSG:3857", layer.Srs)
+				assert.Equal(t, "EPSG:3857", layer.NativeBoundingBox.CRS.Value)
+				assert.Equal(t, kind.kind.StoreKey(), layer.Store.Class)
+			})
+		}
+	}
+}
+
+func TestCascadedLayerRequester_GetAll(t *testing.T) {
+	for _, kind := range cascadedKinds {
+		responses := []cascadedResponse{
+			{name: "200 Ok", status: http.StatusOK, body: read(t, kind.testdata+"/getalllayers.json")},
+			{name: "No Layers", status: http.StatusOK, body: `{"` + kind.kind.LayerKey() + `s": ""}`},
+			{name: "Single Layer", status: http.StatusOK, body: `{"` + kind.kind.LayerKey() + `s": {"` + kind.kind.LayerKey() + `": {"name": "streets"}}}`},
+			{name: "500 Internal Server Error", status: http.StatusInternalServerError, body: "some error", err: "received status code 500 from geoserver: some error", errType: &customerrors.GeoserverError{}},
+			{name: "Invalid JSON", status: http.StatusOK, body: "{", err: "unexpected EOF"},
+			{name: "Client Error", err: "client error"},
+		}
+
+		for _, response := range responses {
+			t.Run(kind.storeType+" "+response.name, func(t *testing.T) {
+				mockClient := cascadedClient(t, http.MethodGet, kind.layers, response)
+				requester := NewCascadedLayerRequester(testdata.GeoserverInfo(mockClient), kind.kind)
+
+				layers, err := requester.GetAll("basemaps")
+				checkCascadedError(t, response, err)
+				if err != nil {
+					assert.Nil(t, layers)
+					return
+				}
+
+				if response.name == "No Layers" {
+					assert.Empty(t, layers.Entries)
+					return
+				}
+
+				if response.name == "Single Layer" {
+					assert.Equal(t, []cascaded.Entry{{Name: "streets"}}, layers.Entries)
+					return
+				}
+
+				assert.Len(t, layers.Entries, 1)
+				assert.Equal(t, "streets", layers.Entries[0].Name)
+			})
+		}
+	}
+}
+
+func TestCascadedLayerRequester_Update(t *testing.T) {
+	for _, kind := range cascadedKinds {
+		responses := []cascadedResponse{
+			{name: "200 Ok", status: http.StatusOK},
+			{name: "404 Not Found", status: http.StatusNotFound, err: string(kind.kind) + "layer streets not found", errType: &customerrors.NotFoundError{}},
+			{name: "500 Internal Server Error", status: http.StatusInternalServerError, body: "some error", err: "received status code 500 from geoserver: some error", errType: &customerrors.GeoserverError{}},
+			{name: "Client Error", err: "client error"},
+		}
+
+		for _, response := range responses {
+			t.Run(kind.storeType+" "+response.name, func(t *testing.T) {
+				mockClient := cascadedClient(t, http.MethodPut, kind.layers+"/streets", response)
+				requester := NewCascadedLayerRequester(testdata.GeoserverInfo(mockClient), kind.kind)
+
+				checkCascadedError(t, response, requester.Update("basemaps", "streets", nil))
+			})
+		}
+	}
+}
+
+func TestCascadedLayerRequester_Delete(t *testing.T) {
+	for _, kind := range cascadedKinds {
+		responses := []cascadedResponse{
+			{name: "200 Ok", status: http.StatusOK},
+			{name: "404 Not Found", status: http.StatusNotFound, err: string(kind.kind) + "layer streets not found", errType: &customerrors.NotFoundError{}},
+			{name: "500 Internal Server Error", status: http.StatusInternalServerError, body: "some error", err: "received status code 500 from geoserver: some error", errType: &customerrors.GeoserverError{}},
+			{name: "Client Error", err: "client error"},
+		}
+
+		for _, response := range responses {
+			t.Run(kind.storeType+" "+response.name, func(t *testing.T) {
+				mockClient := cascadedClient(t, http.MethodDelete, kind.layers+"/streets", response)
+				requester := NewCascadedLayerRequester(testdata.GeoserverInfo(mockClient), kind.kind)
+
+				checkCascadedError(t, response, requester.Delete("basemaps", "streets", true))
+			})
+		}
+	}
+}
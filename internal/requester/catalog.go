@@ -0,0 +1,57 @@
+package requester
+
+import (
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"io"
+	"net/http"
+)
+
+type CatalogRequester struct {
+	data internal.GeoserverData
+}
+
+func NewCatalogRequester(data internal.GeoserverData) CatalogRequester {
+	return CatalogRequester{data: data}
+}
+
+// Reload rereads the catalog and configuration from the data directory
+func (cr CatalogRequester) Reload() error {
+	return cr.post(fmt.Sprintf("%s/geoserver/rest/reload", cr.data.Connection.URL))
+}
+
+// Reset clears the resource caches (store connections, feature types, raster readers, ...)
+func (cr CatalogRequester) Reset() error {
+	return cr.post(fmt.Sprintf("%s/geoserver/rest/reset", cr.data.Connection.URL))
+}
+
+func (cr CatalogRequester) post(target string) error {
+	request, err := http.NewRequest(http.MethodPost, target, nil)
+	if err != nil {
+		return err
+	}
+
+	err = cr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	response, err := cr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return nil
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
@@ -0,0 +1,158 @@
+package requester
+
+import (
+	"errors"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCatalogRequester_Reload(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, request.Method)
+			assert.Equal(t, "/geoserver/rest/reload", request.URL.Path)
+			return mockResponse, nil
+		})
+
+		catalogRequester := NewCatalogRequester(testdata.GeoserverInfo(mockClient))
+
+		err := catalogRequester.Reload()
+		assert.NoError(t, err)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		catalogRequester := NewCatalogRequester(testdata.GeoserverInfo(mockClient))
+
+		err := catalogRequester.Reload()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Invalid Body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(&testdata.ErrorReader{}),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		catalogRequester := NewCatalogRequester(testdata.GeoserverInfo(mockClient))
+
+		err := catalogRequester.Reload()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "reader error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		catalogRequester := NewCatalogRequester(testdata.GeoserverInfo(mockClient))
+
+		err := catalogRequester.Reload()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestCatalogRequester_Reset(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, request.Method)
+			assert.Equal(t, "/geoserver/rest/reset", request.URL.Path)
+			return mockResponse, nil
+		})
+
+		catalogRequester := NewCatalogRequester(testdata.GeoserverInfo(mockClient))
+
+		err := catalogRequester.Reset()
+		assert.NoError(t, err)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		catalogRequester := NewCatalogRequester(testdata.GeoserverInfo(mockClient))
+
+		err := catalogRequester.Reset()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Invalid Body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(&testdata.ErrorReader{}),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		catalogRequester := NewCatalogRequester(testdata.GeoserverInfo(mockClient))
+
+		err := catalogRequester.Reset()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "reader error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		catalogRequester := NewCatalogRequester(testdata.GeoserverInfo(mockClient))
+
+		err := catalogRequester.Reset()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
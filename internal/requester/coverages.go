@@ -29,7 +29,11 @@ func (cr CoverageRequester) Create(store string, content []byte) error {
 		return err
 	}
 
-	request.SetBasicAuth(cr.data.Connection.Credentials.Username, cr.data.Connection.Credentials.Password)
+	err = cr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := cr.data.Client.Do(request)
@@ -59,7 +63,11 @@ func (cr CoverageRequester) GetAll(store string) (*coverages.Coverages, error) {
 		return nil, err
 	}
 
-	request.SetBasicAuth(cr.data.Connection.Credentials.Username, cr.data.Connection.Credentials.Password)
+	err = cr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := cr.data.Client.Do(request)
@@ -105,7 +113,11 @@ func (cr CoverageRequester) Get(store, coverage string) (*coverages.Coverage, er
 		return nil, err
 	}
 
-	request.SetBasicAuth(cr.data.Connection.Credentials.Username, cr.data.Connection.Credentials.Password)
+	err = cr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := cr.data.Client.Do(request)
@@ -143,7 +155,11 @@ func (cr CoverageRequester) Delete(store, coverage string, recurse bool) error {
 		return err
 	}
 
-	request.SetBasicAuth(cr.data.Connection.Credentials.Username, cr.data.Connection.Credentials.Password)
+	err = cr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := cr.data.Client.Do(request)
@@ -174,7 +190,11 @@ func (cr CoverageRequester) Update(store, coverage string, content []byte) error
 		return err
 	}
 
-	request.SetBasicAuth(cr.data.Connection.Credentials.Username, cr.data.Connection.Credentials.Password)
+	err = cr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := cr.data.Client.Do(request)
@@ -205,7 +225,10 @@ func (cr CoverageRequester) Reset(store, coverage string) error {
 		return err
 	}
 
-	request.SetBasicAuth(cr.data.Connection.Credentials.Username, cr.data.Connection.Credentials.Password)
+	err = cr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
 
 	response, err := cr.data.Client.Do(request)
 	if err != nil {
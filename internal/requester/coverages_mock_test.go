@@ -7,6 +7,8 @@ import (
 	mocks "github.com/canghel3/go-geoserver/internal/mock"
 	"github.com/canghel3/go-geoserver/internal/testdata"
 	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/shared"
+	"github.com/canghel3/go-geoserver/pkg/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"io"
@@ -17,6 +19,9 @@ import (
 
 const (
 	coverageFile  = "../testdata/coverages/coverage.json"
+	dimensionFile = "../testdata/coverages/dimensions.json"
+	demFile       = "../testdata/coverages/dem.json"
+	linksFile     = "../testdata/coverages/links.json"
 	coveragesFile = "../testdata/coverages/coverages.json"
 )
 
@@ -135,6 +140,87 @@ func TestCoverageRequester_Get(t *testing.T) {
 		assert.Equal(t, "EPSG:4326", cov.LatLonBoundingBox.CRS.Value)
 	})
 
+	t.Run("200 Ok Dimensions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(dimensionFile)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		coverageRequester := &CoverageRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		cov, err := coverageRequester.Get(testdata.CoverageStoreGeoTiff, testdata.CoverageGeoTiffName)
+		assert.NoError(t, err)
+		assert.Len(t, cov.Metadata.Entry, 2)
+
+		timeDimension := cov.Dimension(shared.TimeDimension)
+		assert.NotNil(t, timeDimension)
+		assert.Equal(t, types.StrategyFixed, timeDimension.DefaultValue.Strategy)
+		assert.Equal(t, "2024-01-01T00:00:00Z", timeDimension.DefaultValue.ReferenceValue)
+		assert.Nil(t, cov.Dimension(shared.ElevationDimension))
+	})
+
+	t.Run("200 Ok Single Band", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(demFile)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		coverageRequester := &CoverageRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		cov, err := coverageRequester.Get(testdata.CoverageStoreGeoTiff, testdata.CoverageGeoTiffName)
+		assert.NoError(t, err)
+		assert.Len(t, cov.Dimensions.CoverageDimension, 1)
+
+		band := cov.Dimensions.CoverageDimension[0]
+		assert.Equal(t, "ELEVATION", band.Name)
+		assert.Equal(t, []float64{-9999}, band.NullValues.Double)
+		assert.Equal(t, "m", band.Unit)
+		assert.Equal(t, "REAL_32BITS", band.DataType.Name)
+	})
+
+	t.Run("200 Ok Empty Links", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(linksFile)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		coverageRequester := &CoverageRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		cov, err := coverageRequester.Get(testdata.CoverageStoreGeoTiff, testdata.CoverageGeoTiffName)
+		assert.NoError(t, err)
+		assert.NotNil(t, cov.MetadataLinks)
+		assert.Nil(t, cov.MetadataLinks.MetadataLink)
+		assert.Nil(t, cov.DataLinks.DataLink)
+		assert.Equal(t, []string{"EPSG:32631"}, cov.ResponseSRS.SRS)
+	})
+
 	t.Run("404 Not Found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 
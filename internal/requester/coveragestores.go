@@ -27,7 +27,11 @@ func (cr CoverageStoreRequester) Create(content []byte) error {
 		return err
 	}
 
-	request.SetBasicAuth(cr.data.Connection.Credentials.Username, cr.data.Connection.Credentials.Password)
+	err = cr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := cr.data.Client.Do(request)
@@ -55,7 +59,11 @@ func (cr CoverageStoreRequester) GetAll() (*coveragestores.CoverageStores, error
 		return nil, err
 	}
 
-	request.SetBasicAuth(cr.data.Connection.Credentials.Username, cr.data.Connection.Credentials.Password)
+	err = cr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := cr.data.Client.Do(request)
@@ -100,7 +108,11 @@ func (cr CoverageStoreRequester) Get(name string) (*coveragestores.CoverageStore
 		return nil, err
 	}
 
-	request.SetBasicAuth(cr.data.Connection.Credentials.Username, cr.data.Connection.Credentials.Password)
+	err = cr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := cr.data.Client.Do(request)
@@ -136,7 +148,11 @@ func (cr CoverageStoreRequester) Update(name string, content []byte) error {
 		return err
 	}
 
-	request.SetBasicAuth(cr.data.Connection.Credentials.Username, cr.data.Connection.Credentials.Password)
+	err = cr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := cr.data.Client.Do(request)
@@ -166,7 +182,10 @@ func (cr CoverageStoreRequester) Delete(name string, recurse bool) error {
 		return err
 	}
 
-	request.SetBasicAuth(cr.data.Connection.Credentials.Username, cr.data.Connection.Credentials.Password)
+	err = cr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
 
 	response, err := cr.data.Client.Do(request)
 	if err != nil {
@@ -197,7 +216,10 @@ func (cr CoverageStoreRequester) Reset(name string) error {
 		return err
 	}
 
-	request.SetBasicAuth(cr.data.Connection.Credentials.Username, cr.data.Connection.Credentials.Password)
+	err = cr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
 
 	response, err := cr.data.Client.Do(request)
 	if err != nil {
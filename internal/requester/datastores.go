@@ -27,7 +27,11 @@ func (dr DataStoreRequester) Create(content []byte) error {
 		return err
 	}
 
-	request.SetBasicAuth(dr.data.Connection.Credentials.Username, dr.data.Connection.Credentials.Password)
+	err = dr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := dr.data.Client.Do(request)
@@ -55,7 +59,11 @@ func (dr DataStoreRequester) GetAll() (*datastores.DataStores, error) {
 		return nil, err
 	}
 
-	request.SetBasicAuth(dr.data.Connection.Credentials.Username, dr.data.Connection.Credentials.Password)
+	err = dr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := dr.data.Client.Do(request)
@@ -100,7 +108,11 @@ func (dr DataStoreRequester) Get(name string) (*datastores.DataStore, error) {
 		return nil, err
 	}
 
-	request.SetBasicAuth(dr.data.Connection.Credentials.Username, dr.data.Connection.Credentials.Password)
+	err = dr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := dr.data.Client.Do(request)
@@ -136,7 +148,11 @@ func (dr DataStoreRequester) Update(name string, content []byte) error {
 		return err
 	}
 
-	request.SetBasicAuth(dr.data.Connection.Credentials.Username, dr.data.Connection.Credentials.Password)
+	err = dr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := dr.data.Client.Do(request)
@@ -166,7 +182,10 @@ func (dr DataStoreRequester) Delete(name string, recurse bool) error {
 		return err
 	}
 
-	request.SetBasicAuth(dr.data.Connection.Credentials.Username, dr.data.Connection.Credentials.Password)
+	err = dr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
 
 	response, err := dr.data.Client.Do(request)
 	if err != nil {
@@ -197,7 +216,10 @@ func (dr DataStoreRequester) Reset(name string) error {
 		return err
 	}
 
-	request.SetBasicAuth(dr.data.Connection.Credentials.Username, dr.data.Connection.Credentials.Password)
+	err = dr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
 
 	response, err := dr.data.Client.Do(request)
 	if err != nil {
@@ -27,7 +27,11 @@ func (ftr FeatureTypeRequester) Create(store string, content []byte) error {
 		return err
 	}
 
-	request.SetBasicAuth(ftr.data.Connection.Credentials.Username, ftr.data.Connection.Credentials.Password)
+	err = ftr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := ftr.data.Client.Do(request)
@@ -56,7 +60,11 @@ func (ftr FeatureTypeRequester) Delete(store, feature string, recurse bool) erro
 		return err
 	}
 
-	request.SetBasicAuth(ftr.data.Connection.Credentials.Username, ftr.data.Connection.Credentials.Password)
+	err = ftr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := ftr.data.Client.Do(request)
@@ -87,7 +95,11 @@ func (ftr FeatureTypeRequester) Get(store, feature string) (*featuretypes.Featur
 		return nil, err
 	}
 
-	request.SetBasicAuth(ftr.data.Connection.Credentials.Username, ftr.data.Connection.Credentials.Password)
+	err = ftr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := ftr.data.Client.Do(request)
@@ -123,7 +135,11 @@ func (ftr FeatureTypeRequester) GetAll(store string) (*featuretypes.FeatureTypes
 		return nil, err
 	}
 
-	request.SetBasicAuth(ftr.data.Connection.Credentials.Username, ftr.data.Connection.Credentials.Password)
+	err = ftr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := ftr.data.Client.Do(request)
@@ -162,6 +178,47 @@ func (ftr FeatureTypeRequester) GetAll(store string) (*featuretypes.FeatureTypes
 	}
 }
 
+// GetAvailable lists the native names of the tables in the store which are not yet published as feature types.
+func (ftr FeatureTypeRequester) GetAvailable(store string) ([]string, error) {
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/geoserver/rest/workspaces/%s/datastores/%s/featuretypes?list=available", ftr.data.Connection.URL, ftr.data.Workspace, store), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ftr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := ftr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var available featuretypes.AvailableWrapper
+		err = json.Unmarshal(body, &available)
+		if err != nil {
+			return nil, err
+		}
+
+		return available.List.Names, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("datastore %s not found", store))
+	default:
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
 func (ftr FeatureTypeRequester) Update(store, feature string, content []byte) error {
 	var target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/datastores/%s/featuretypes/%s", ftr.data.Connection.URL, ftr.data.Workspace, store, feature)
 
@@ -170,7 +227,11 @@ func (ftr FeatureTypeRequester) Update(store, feature string, content []byte) er
 		return err
 	}
 
-	request.SetBasicAuth(ftr.data.Connection.Credentials.Username, ftr.data.Connection.Credentials.Password)
+	err = ftr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := ftr.data.Client.Do(request)
@@ -201,7 +262,10 @@ func (ftr FeatureTypeRequester) Reset(store, name string) error {
 		return err
 	}
 
-	request.SetBasicAuth(ftr.data.Connection.Credentials.Username, ftr.data.Connection.Credentials.Password)
+	err = ftr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
 
 	response, err := ftr.data.Client.Do(request)
 	if err != nil {
@@ -7,6 +7,8 @@ import (
 	mocks "github.com/canghel3/go-geoserver/internal/mock"
 	"github.com/canghel3/go-geoserver/internal/testdata"
 	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/shared"
+	"github.com/canghel3/go-geoserver/pkg/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"io"
@@ -16,8 +18,12 @@ import (
 )
 
 const (
-	getSingleFeatureTypeResponse = "../testdata/featuretypes/getsingle.json"
-	getAllFeatureTypesResponse   = "../testdata/featuretypes/getall.json"
+	getSingleFeatureTypeResponse  = "../testdata/featuretypes/getsingle.json"
+	getAllFeatureTypesResponse    = "../testdata/featuretypes/getall.json"
+	getSQLViewFeatureTypeResponse = "../testdata/featuretypes/sqlview.json"
+	getAvailableFeatureTypes      = "../testdata/featuretypes/available.json"
+	getDimensionsFeatureType      = "../testdata/featuretypes/dimensions.json"
+	getLinksFeatureType           = "../testdata/featuretypes/links.json"
 )
 
 func TestFeatureTypeRequester_Create(t *testing.T) {
@@ -133,6 +139,103 @@ func TestFeatureTypeRequester_Get(t *testing.T) {
 		assert.NotNil(t, ft)
 		assert.Equal(t, "EPSG:27700", ft.Srs)
 		assert.Equal(t, -4.253489380362922, ft.LatLonBoundingBox.MinX)
+		assert.Nil(t, ft.VirtualTable())
+	})
+
+	t.Run("200 Ok SQL View", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(getSQLViewFeatureTypeResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		featureTypeRequester := &FeatureTypeRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		ft, err := featureTypeRequester.Get(testdata.DatastorePostgis, "populated_places")
+		assert.NoError(t, err)
+		assert.NotNil(t, ft)
+
+		vt := ft.VirtualTable()
+		assert.NotNil(t, vt)
+		assert.Equal(t, "populated_places", vt.Name)
+		assert.Equal(t, []string{"gid"}, vt.KeyColumn)
+		assert.Len(t, vt.Geometry, 1)
+		assert.Equal(t, "Point", vt.Geometry[0].Type)
+		assert.Equal(t, 4326, vt.Geometry[0].SRID)
+		assert.Len(t, vt.Parameter, 1)
+		assert.Equal(t, "min_pop", vt.Parameter[0].Name)
+		assert.Equal(t, "1000", vt.Parameter[0].DefaultValue)
+		assert.Equal(t, `^[\d]+$`, vt.Parameter[0].RegexpValidator)
+	})
+
+	t.Run("200 Ok Dimensions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(getDimensionsFeatureType)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		featureTypeRequester := &FeatureTypeRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		ft, err := featureTypeRequester.Get(testdata.DatastorePostgis, testdata.FeatureTypePostgis)
+		assert.NoError(t, err)
+
+		timeDimension := ft.Dimension(shared.TimeDimension)
+		assert.NotNil(t, timeDimension)
+		assert.Equal(t, "observed_at", timeDimension.Attribute)
+		assert.Equal(t, "observed_until", timeDimension.EndAttribute)
+		assert.Equal(t, types.PresentationDiscreteInterval, timeDimension.Presentation)
+		assert.Equal(t, "86400000", timeDimension.Resolution.String())
+		assert.Equal(t, types.StrategyMaximum, timeDimension.DefaultValue.Strategy)
+
+		elevationDimension := ft.Dimension(shared.ElevationDimension)
+		assert.NotNil(t, elevationDimension)
+		assert.Equal(t, "m", elevationDimension.UnitSymbol)
+		assert.Equal(t, types.DefaultValueStrategy(""), elevationDimension.DefaultValue.Strategy)
+
+		assert.Nil(t, ft.Dimension(shared.CustomDimension("depth")))
+	})
+
+	t.Run("200 Ok Links", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(getLinksFeatureType)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		featureTypeRequester := &FeatureTypeRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		ft, err := featureTypeRequester.Get(testdata.DatastorePostgis, testdata.FeatureTypePostgis)
+		assert.NoError(t, err)
+		assert.Len(t, ft.MetadataLinks.MetadataLink, 1)
+		assert.Equal(t, "ISO19115:2003", ft.MetadataLinks.MetadataLink[0].MetadataType)
+		assert.Len(t, ft.DataLinks.DataLink, 2)
+		assert.Equal(t, "application/zip", ft.DataLinks.DataLink[1].Type)
+		assert.Equal(t, []string{"4326", "3857"}, ft.ResponseSRS.SRS)
 	})
 
 	t.Run("404 Not Found", func(t *testing.T) {
@@ -611,3 +714,148 @@ func TestFeatureTypeRequester_Reset(t *testing.T) {
 		assert.EqualError(t, err, "client error")
 	})
 }
+
+func TestFeatureTypeRequester_GetAvailable(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		t.Run("Multiple Tables", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			content, err := testdata.Read(getAvailableFeatureTypes)
+			assert.NoError(t, err)
+
+			mockClient := mocks.NewMockHTTPClient(ctrl)
+			mockResponse := &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(bytes.NewReader(content)),
+			}
+
+			mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+				assert.Equal(t, "available", request.URL.Query().Get("list"))
+				return mockResponse, nil
+			})
+
+			featureTypeRequester := &FeatureTypeRequester{data: testdata.GeoserverInfo(mockClient)}
+
+			available, err := featureTypeRequester.GetAvailable(testdata.DatastorePostgis)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"roads", "rivers", "buildings"}, available)
+		})
+
+		t.Run("Single Table", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockClient := mocks.NewMockHTTPClient(ctrl)
+			mockResponse := &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader(`{"list": {"string": "roads"}}`)),
+			}
+
+			mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+				assert.Equal(t, "available", request.URL.Query().Get("list"))
+				return mockResponse, nil
+			})
+
+			featureTypeRequester := &FeatureTypeRequester{data: testdata.GeoserverInfo(mockClient)}
+
+			available, err := featureTypeRequester.GetAvailable(testdata.DatastorePostgis)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"roads"}, available)
+		})
+
+		t.Run("No Tables", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockClient := mocks.NewMockHTTPClient(ctrl)
+			mockResponse := &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader(`{"list": ""}`)),
+			}
+
+			mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+				assert.Equal(t, "available", request.URL.Query().Get("list"))
+				return mockResponse, nil
+			})
+
+			featureTypeRequester := &FeatureTypeRequester{data: testdata.GeoserverInfo(mockClient)}
+
+			available, err := featureTypeRequester.GetAvailable(testdata.DatastorePostgis)
+			assert.NoError(t, err)
+			assert.Nil(t, available)
+		})
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("not found")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		featureTypeRequester := &FeatureTypeRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		_, err := featureTypeRequester.GetAvailable(testdata.DatastorePostgis)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, fmt.Sprintf("datastore %s not found", testdata.DatastorePostgis))
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		featureTypeRequester := &FeatureTypeRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		_, err := featureTypeRequester.GetAvailable(testdata.DatastorePostgis)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Invalid JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		featureTypeRequester := &FeatureTypeRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		_, err := featureTypeRequester.GetAvailable(testdata.DatastorePostgis)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected end of JSON input")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		featureTypeRequester := &FeatureTypeRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		_, err := featureTypeRequester.GetAvailable(testdata.DatastorePostgis)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
@@ -24,7 +24,11 @@ func (fr FontsRequester) Get() (*fonts.Fonts, error) {
 		return nil, err
 	}
 
-	request.SetBasicAuth(fr.data.Connection.Credentials.Username, fr.data.Connection.Credentials.Password)
+	err = fr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := fr.data.Client.Do(request)
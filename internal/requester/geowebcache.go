@@ -67,7 +67,10 @@ func (gwcr GeoWebCacheRequester) Status(name string) (*gwc.SeedStatus, error) {
 		return nil, err
 	}
 
-	request.SetBasicAuth(gwcr.data.Connection.Credentials.Username, gwcr.data.Connection.Credentials.Password)
+	err = gwcr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
 
 	response, err := gwcr.data.Client.Do(request)
 	if err != nil {
@@ -102,7 +105,10 @@ func (gwcr GeoWebCacheRequester) Seed(name string, content []byte) error {
 		return err
 	}
 
-	request.SetBasicAuth(gwcr.data.Connection.Credentials.Username, gwcr.data.Connection.Credentials.Password)
+	err = gwcr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
 
 	response, err := gwcr.data.Client.Do(request)
 	if err != nil {
@@ -0,0 +1,206 @@
+package requester
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/importer"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type ImporterRequester struct {
+	data internal.GeoserverData
+}
+
+func NewImporterRequester(data internal.GeoserverData) ImporterRequester {
+	return ImporterRequester{data: data}
+}
+
+func (ir ImporterRequester) Create(content []byte) (*importer.Import, error) {
+	var wrapper importer.ImportWrapper
+	err := ir.do(http.MethodPost, fmt.Sprintf("%s/geoserver/rest/imports", ir.data.Connection.URL), "application/json", content, &wrapper, fmt.Errorf("importer extension not installed"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &wrapper.Import, nil
+}
+
+func (ir ImporterRequester) GetAll() ([]importer.Import, error) {
+	var wrapper importer.ImportsWrapper
+	err := ir.do(http.MethodGet, fmt.Sprintf("%s/geoserver/rest/imports", ir.data.Connection.URL), "", nil, &wrapper, fmt.Errorf("importer extension not installed"))
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Imports, nil
+}
+
+func (ir ImporterRequester) Get(id int) (*importer.Import, error) {
+	var wrapper importer.ImportWrapper
+	err := ir.do(http.MethodGet, ir.target(id, ""), "", nil, &wrapper, fmt.Errorf("import %d not found", id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &wrapper.Import, nil
+}
+
+func (ir ImporterRequester) Delete(id int) error {
+	return ir.do(http.MethodDelete, ir.target(id, ""), "", nil, nil, fmt.Errorf("import %d not found", id))
+}
+
+// Run starts the import of all the ready tasks, GeoServer runs it asynchronously and replies right away
+func (ir ImporterRequester) Run(id int) error {
+	return ir.do(http.MethodPost, ir.target(id, "")+"?async=true", "", nil, nil, fmt.Errorf("import %d not found", id))
+}
+
+func (ir ImporterRequester) GetTasks(id int) ([]importer.Task, error) {
+	var wrapper importer.TasksWrapper
+	err := ir.do(http.MethodGet, ir.target(id, "/tasks"), "", nil, &wrapper, fmt.Errorf("import %d not found", id))
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Tasks, nil
+}
+
+// Upload adds the file to the import, an archive results in a task for each file it contains
+func (ir ImporterRequester) Upload(id int, filename string, content []byte) ([]importer.Task, error) {
+	var wrapper importer.TasksWrapper
+	err := ir.do(http.MethodPut, ir.target(id, "/tasks/"+url.PathEscape(filename)), "application/octet-stream", content, &wrapper, fmt.Errorf("import %d not found", id))
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Tasks, nil
+}
+
+// AddURL adds the data found at location, either a file:// url on the GeoServer host or a remote url to download
+func (ir ImporterRequester) AddURL(id int, location string) ([]importer.Task, error) {
+	content := []byte(url.Values{"url": {location}}.Encode())
+
+	var wrapper importer.TasksWrapper
+	err := ir.do(http.MethodPost, ir.target(id, "/tasks"), "application/x-www-form-urlencoded", content, &wrapper, fmt.Errorf("import %d not found", id))
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Tasks, nil
+}
+
+func (ir ImporterRequester) GetTask(id, task int) (*importer.Task, error) {
+	var wrapper importer.TaskWrapper
+	err := ir.do(http.MethodGet, ir.target(id, fmt.Sprintf("/tasks/%d", task)), "", nil, &wrapper, fmt.Errorf("task %d of import %d not found", task, id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &wrapper.Task, nil
+}
+
+func (ir ImporterRequester) UpdateTask(id, task int, content []byte) error {
+	return ir.do(http.MethodPut, ir.target(id, fmt.Sprintf("/tasks/%d", task)), "application/json", content, nil, fmt.Errorf("task %d of import %d not found", task, id))
+}
+
+func (ir ImporterRequester) DeleteTask(id, task int) error {
+	return ir.do(http.MethodDelete, ir.target(id, fmt.Sprintf("/tasks/%d", task)), "", nil, nil, fmt.Errorf("task %d of import %d not found", task, id))
+}
+
+func (ir ImporterRequester) GetLayer(id, task int) (*importer.Layer, error) {
+	var wrapper importer.LayerWrapper
+	err := ir.do(http.MethodGet, ir.target(id, fmt.Sprintf("/tasks/%d/layer", task)), "", nil, &wrapper, fmt.Errorf("task %d of import %d not found", task, id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &wrapper.Layer, nil
+}
+
+func (ir ImporterRequester) UpdateLayer(id, task int, content []byte) error {
+	return ir.do(http.MethodPut, ir.target(id, fmt.Sprintf("/tasks/%d/layer", task)), "application/json", content, nil, fmt.Errorf("task %d of import %d not found", task, id))
+}
+
+func (ir ImporterRequester) GetTransforms(id, task int) ([]importer.Transform, error) {
+	var wrapper importer.TransformsWrapper
+	err := ir.do(http.MethodGet, ir.target(id, fmt.Sprintf("/tasks/%d/transforms", task)), "", nil, &wrapper, fmt.Errorf("task %d of import %d not found", task, id))
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Transforms, nil
+}
+
+func (ir ImporterRequester) AddTransform(id, task int, content []byte) error {
+	return ir.do(http.MethodPost, ir.target(id, fmt.Sprintf("/tasks/%d/transforms", task)), "application/json", content, nil, fmt.Errorf("task %d of import %d not found", task, id))
+}
+
+func (ir ImporterRequester) DeleteTransform(id, task, index int) error {
+	return ir.do(http.MethodDelete, ir.target(id, fmt.Sprintf("/tasks/%d/transforms/%d", task, index)), "", nil, nil, fmt.Errorf("transform %d of task %d of import %d not found", index, task, id))
+}
+
+func (ir ImporterRequester) GetProgress(id, task int) (*importer.Progress, error) {
+	var wrapper importer.ProgressWrapper
+	err := ir.do(http.MethodGet, ir.target(id, fmt.Sprintf("/tasks/%d/progress", task)), "", nil, &wrapper, fmt.Errorf("task %d of import %d not found", task, id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &wrapper.Progress, nil
+}
+
+func (ir ImporterRequester) target(id int, path string) string {
+	return fmt.Sprintf("%s/geoserver/rest/imports/%d%s", ir.data.Connection.URL, id, path)
+}
+
+// do sends a request to the importer, decoding the response into v when it is not nil.
+// The importer replies with 200, 201 or 204 on success depending on the endpoint.
+func (ir ImporterRequester) do(method, target, contentType string, content []byte, v any, notFound error) error {
+	var body io.Reader
+	if content != nil {
+		body = bytes.NewReader(content)
+	}
+
+	request, err := http.NewRequest(method, target, body)
+	if err != nil {
+		return err
+	}
+
+	err = ir.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	if contentType != "" {
+		request.Header.Add("Content-Type", contentType)
+	}
+	request.Header.Add("Accept", "application/json")
+
+	response, err := ir.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		if v == nil || response.StatusCode == http.StatusNoContent {
+			return nil
+		}
+
+		return json.NewDecoder(response.Body).Decode(v)
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(notFound)
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
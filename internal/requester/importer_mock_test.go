@@ -0,0 +1,1576 @@
+package requester
+
+import (
+	"bytes"
+	"errors"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/importer"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	importResponse           = "../testdata/importer/import.json"
+	importIncompleteResponse = "../testdata/importer/import_incomplete.json"
+	importsResponse          = "../testdata/importer/imports.json"
+	taskResponse             = "../testdata/importer/task.json"
+	tasksResponse            = "../testdata/importer/tasks.json"
+	layerResponse            = "../testdata/importer/layer.json"
+	transformsResponse       = "../testdata/importer/transforms.json"
+	progressResponse         = "../testdata/importer/progress.json"
+)
+
+func TestImporterRequester_Create(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(importResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		context, err := importerRequester.Create([]byte(`{}`))
+		assert.NoError(t, err)
+		assert.NotNil(t, context)
+		assert.Equal(t, 4, context.ID)
+		assert.Equal(t, importer.StatePending, context.State)
+		assert.False(t, context.State.Done())
+		assert.Equal(t, "PLAYGROUND", context.TargetWorkspace.Workspace.Name)
+		assert.Equal(t, "POSTGIS", context.TargetStore.DataStore.Name)
+		assert.Equal(t, "/data/shps", context.Data.Location)
+		assert.Len(t, context.Tasks, 2)
+		assert.Equal(t, importer.TaskStateNoCRS, context.Tasks[1].State)
+		assert.NoError(t, context.Err())
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		context, err := importerRequester.Create([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "importer extension not installed")
+		assert.Nil(t, context)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		context, err := importerRequester.Create([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, context)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		context, err := importerRequester.Create([]byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, context)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		context, err := importerRequester.Create([]byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, context)
+	})
+}
+
+func TestImporterRequester_GetAll(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(importsResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		imports, err := importerRequester.GetAll()
+		assert.NoError(t, err)
+		assert.Len(t, imports, 2)
+		assert.Equal(t, importer.StateComplete, imports[0].State)
+		assert.Equal(t, 4, imports[1].ID)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		imports, err := importerRequester.GetAll()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "importer extension not installed")
+		assert.Nil(t, imports)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		imports, err := importerRequester.GetAll()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, imports)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		imports, err := importerRequester.GetAll()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, imports)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		imports, err := importerRequester.GetAll()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, imports)
+	})
+}
+
+func TestImporterRequester_Get(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(importIncompleteResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		context, err := importerRequester.Get(4)
+		assert.NoError(t, err)
+		assert.NotNil(t, context)
+		assert.Equal(t, importer.StateIncomplete, context.State)
+		assert.True(t, context.State.Done())
+
+		var importError *importer.ImportError
+		assert.ErrorAs(t, context.Err(), &importError)
+		assert.Len(t, importError.Tasks, 1)
+		assert.EqualError(t, context.Err(), "import 4 ended with state INCOMPLETE (task 1 ERROR: Unable to determine the srs of the layer)")
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		context, err := importerRequester.Get(4)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "import 4 not found")
+		assert.Nil(t, context)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		context, err := importerRequester.Get(4)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, context)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		context, err := importerRequester.Get(4)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, context)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		context, err := importerRequester.Get(4)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, context)
+	})
+}
+
+func TestImporterRequester_Delete(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.Delete(4)
+		assert.NoError(t, err)
+	})
+
+	t.Run("204 No Content", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.Delete(4)
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.Delete(4)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "import 4 not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.Delete(4)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.Delete(4)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestImporterRequester_Run(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.Run(4)
+		assert.NoError(t, err)
+	})
+
+	t.Run("204 No Content", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.Run(4)
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.Run(4)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "import 4 not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.Run(4)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.Run(4)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestImporterRequester_GetTasks(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(tasksResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.GetTasks(4)
+		assert.NoError(t, err)
+		assert.Len(t, tasks, 2)
+		assert.Equal(t, importer.TaskStateReady, tasks[0].State)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.GetTasks(4)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "import 4 not found")
+		assert.Nil(t, tasks)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.GetTasks(4)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, tasks)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.GetTasks(4)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, tasks)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.GetTasks(4)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, tasks)
+	})
+}
+
+func TestImporterRequester_Upload(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(taskResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.Upload(4, "roads.zip", []byte("PK"))
+		assert.NoError(t, err)
+		assert.Len(t, tasks, 1)
+		assert.Equal(t, "roads.shp", tasks[0].Data.File)
+		assert.Equal(t, "roads", tasks[0].Layer.Name)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.Upload(4, "roads.zip", []byte("PK"))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "import 4 not found")
+		assert.Nil(t, tasks)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.Upload(4, "roads.zip", []byte("PK"))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, tasks)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.Upload(4, "roads.zip", []byte("PK"))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, tasks)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.Upload(4, "roads.zip", []byte("PK"))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, tasks)
+	})
+}
+
+func TestImporterRequester_AddURL(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(tasksResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.AddURL(4, "file:///data/shps")
+		assert.NoError(t, err)
+		assert.Len(t, tasks, 2)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.AddURL(4, "file:///data/shps")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "import 4 not found")
+		assert.Nil(t, tasks)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.AddURL(4, "file:///data/shps")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, tasks)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.AddURL(4, "file:///data/shps")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, tasks)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		tasks, err := importerRequester.AddURL(4, "file:///data/shps")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, tasks)
+	})
+}
+
+func TestImporterRequester_GetTask(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(taskResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		task, err := importerRequester.GetTask(4, 1)
+		assert.NoError(t, err)
+		assert.NotNil(t, task)
+		assert.Equal(t, 1, task.ID)
+		assert.Equal(t, importer.UpdateModeCreate, task.UpdateMode)
+		assert.Equal(t, "POSTGIS", task.Target.DataStore.Name)
+		assert.Equal(t, "vector", task.TransformChain.Type)
+		assert.Equal(t, []importer.Transform{{Type: "ReprojectTransform", Href: "http://localhost:8080/geoserver/rest/imports/4/tasks/1/transforms/0", Target: "EPSG:4326"}}, task.TransformChain.Transforms)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		task, err := importerRequester.GetTask(4, 1)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "task 1 of import 4 not found")
+		assert.Nil(t, task)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		task, err := importerRequester.GetTask(4, 1)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, task)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		task, err := importerRequester.GetTask(4, 1)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, task)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		task, err := importerRequester.GetTask(4, 1)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, task)
+	})
+}
+
+func TestImporterRequester_UpdateTask(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.UpdateTask(4, 1, []byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("204 No Content", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.UpdateTask(4, 1, []byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.UpdateTask(4, 1, []byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "task 1 of import 4 not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.UpdateTask(4, 1, []byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.UpdateTask(4, 1, []byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestImporterRequester_DeleteTask(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.DeleteTask(4, 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("204 No Content", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.DeleteTask(4, 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.DeleteTask(4, 1)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "task 1 of import 4 not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.DeleteTask(4, 1)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.DeleteTask(4, 1)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestImporterRequester_GetLayer(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(layerResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		layer, err := importerRequester.GetLayer(4, 1)
+		assert.NoError(t, err)
+		assert.NotNil(t, layer)
+		assert.Equal(t, "roads", layer.Name)
+		assert.Equal(t, "EPSG:3857", layer.SRS)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		layer, err := importerRequester.GetLayer(4, 1)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "task 1 of import 4 not found")
+		assert.Nil(t, layer)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		layer, err := importerRequester.GetLayer(4, 1)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, layer)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		layer, err := importerRequester.GetLayer(4, 1)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, layer)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		layer, err := importerRequester.GetLayer(4, 1)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, layer)
+	})
+}
+
+func TestImporterRequester_UpdateLayer(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.UpdateLayer(4, 1, []byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("204 No Content", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.UpdateLayer(4, 1, []byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.UpdateLayer(4, 1, []byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "task 1 of import 4 not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.UpdateLayer(4, 1, []byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.UpdateLayer(4, 1, []byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestImporterRequester_GetTransforms(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(transformsResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		transforms, err := importerRequester.GetTransforms(4, 1)
+		assert.NoError(t, err)
+		assert.Len(t, transforms, 2)
+		assert.Equal(t, "EPSG:3857", transforms[0].Source)
+		assert.Equal(t, "built", transforms[1].Field)
+		assert.Equal(t, "yyyyMMdd", transforms[1].Format)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		transforms, err := importerRequester.GetTransforms(4, 1)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "task 1 of import 4 not found")
+		assert.Nil(t, transforms)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		transforms, err := importerRequester.GetTransforms(4, 1)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, transforms)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		transforms, err := importerRequester.GetTransforms(4, 1)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, transforms)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		transforms, err := importerRequester.GetTransforms(4, 1)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, transforms)
+	})
+}
+
+func TestImporterRequester_AddTransform(t *testing.T) {
+	t.Run("201 Created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.AddTransform(4, 1, []byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.AddTransform(4, 1, []byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "task 1 of import 4 not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.AddTransform(4, 1, []byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.AddTransform(4, 1, []byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestImporterRequester_DeleteTransform(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.DeleteTransform(4, 1, 0)
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.DeleteTransform(4, 1, 0)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "transform 0 of task 1 of import 4 not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.DeleteTransform(4, 1, 0)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		err := importerRequester.DeleteTransform(4, 1, 0)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestImporterRequester_GetProgress(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(progressResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		progress, err := importerRequester.GetProgress(4, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, &importer.Progress{Progress: 250, Total: 1000, State: importer.TaskStateRunning}, progress)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		progress, err := importerRequester.GetProgress(4, 1)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "task 1 of import 4 not found")
+		assert.Nil(t, progress)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		progress, err := importerRequester.GetProgress(4, 1)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, progress)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		progress, err := importerRequester.GetProgress(4, 1)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, progress)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		importerRequester := NewImporterRequester(testdata.GeoserverInfo(mockClient))
+
+		progress, err := importerRequester.GetProgress(4, 1)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, progress)
+	})
+}
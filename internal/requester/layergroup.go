@@ -33,7 +33,11 @@ func (lgr LayerGroupRequester) Get(name string) (*layers.Group, error) {
 		return nil, err
 	}
 
-	request.SetBasicAuth(lgr.data.Connection.Credentials.Username, lgr.data.Connection.Credentials.Password)
+	err = lgr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := lgr.data.Client.Do(request)
@@ -82,6 +86,61 @@ func (lgr LayerGroupRequester) Get(name string) (*layers.Group, error) {
 	}
 }
 
+func (lgr LayerGroupRequester) GetAll() (*layers.Groups, error) {
+	var target string
+	if validator.Empty(lgr.data.Workspace) {
+		target = fmt.Sprintf("%s/geoserver/rest/layergroups", lgr.data.Connection.URL)
+	} else {
+		target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/layergroups", lgr.data.Connection.URL, lgr.data.Workspace)
+	}
+
+	request, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = lgr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := lgr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var groups layers.GroupsWrapper
+		err = json.Unmarshal(body, &groups)
+		if err != nil {
+			//geoserver responds with an empty string when there are no layer groups
+			var noGroups struct {
+				Groups string `json:"layerGroups"`
+			}
+			if json.Unmarshal(body, &noGroups) == nil {
+				return &layers.Groups{Entries: nil}, nil
+			}
+
+			return nil, err
+		}
+
+		return &groups.Groups, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("workspace %s not found", lgr.data.Workspace))
+	default:
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
 func (lgr LayerGroupRequester) Create(content []byte) error {
 	var target string
 	if validator.Empty(lgr.data.Workspace) {
@@ -95,7 +154,11 @@ func (lgr LayerGroupRequester) Create(content []byte) error {
 		return err
 	}
 
-	request.SetBasicAuth(lgr.data.Connection.Credentials.Username, lgr.data.Connection.Credentials.Password)
+	err = lgr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := lgr.data.Client.Do(request)
@@ -130,7 +193,11 @@ func (lgr LayerGroupRequester) Update(name string, content []byte) error {
 		return err
 	}
 
-	request.SetBasicAuth(lgr.data.Connection.Credentials.Username, lgr.data.Connection.Credentials.Password)
+	err = lgr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := lgr.data.Client.Do(request)
@@ -167,7 +234,10 @@ func (lgr LayerGroupRequester) Delete(name string) error {
 		return err
 	}
 
-	request.SetBasicAuth(lgr.data.Connection.Credentials.Username, lgr.data.Connection.Credentials.Password)
+	err = lgr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
 
 	response, err := lgr.data.Client.Do(request)
 	if err != nil {
@@ -17,7 +17,11 @@ import (
 )
 
 const (
-	getLayerGroupResponse = "../testdata/layers/getgroup.json"
+	getLayerGroupResponse      = "../testdata/layers/getgroup.json"
+	getLayerGroupLinksResponse = "../testdata/layers/links.json"
+	getLayerGroupSingleStyle   = "../testdata/layers/getgroupsinglestyle.json"
+	getLayerGroupDefaultStyle  = "../testdata/layers/getgroupdefaultstyle.json"
+	getLayerGroupsResponse     = "../testdata/layers/getgroups.json"
 )
 
 func TestLayerGroupRequester_Create(t *testing.T) {
@@ -115,6 +119,79 @@ func TestLayerGroupRequester_Get(t *testing.T) {
 		assert.NotNil(t, group)
 		assert.Equal(t, layers.ModeSingle, group.Mode)
 		assert.Equal(t, 2, len(group.Publishables.Entries))
+		assert.Len(t, group.Styles.Style, 2)
+		assert.Equal(t, "line", group.Styles.Style[1].Name)
+	})
+
+	t.Run("200 Ok Single Style", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(getLayerGroupSingleStyle)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lgr := &LayerGroupRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		group, err := lgr.Get(testdata.LayerGroupName)
+		assert.NoError(t, err)
+		assert.Len(t, group.Publishables.Entries, 1)
+		assert.Len(t, group.Styles.Style, 1)
+		assert.Equal(t, "line", group.Styles.Style[0].Name)
+	})
+
+	t.Run("200 Ok Default Style", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(getLayerGroupDefaultStyle)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lgr := &LayerGroupRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		group, err := lgr.Get(testdata.LayerGroupName)
+		assert.NoError(t, err)
+		assert.Len(t, group.Publishables.Entries, 1)
+		assert.Len(t, group.Styles.Style, 1)
+		assert.Equal(t, "", group.Styles.Style[0].Name)
+	})
+
+	t.Run("200 Ok Metadata Links", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(getLayerGroupLinksResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lgr := &LayerGroupRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		group, err := lgr.Get(testdata.LayerGroupName)
+		assert.NoError(t, err)
+		assert.Len(t, group.MetadataLinks.MetadataLink, 2)
+		assert.Equal(t, "https://catalogue.example.com/group.html", group.MetadataLinks.MetadataLink[1].Content)
 	})
 
 	t.Run("404 Not Found", func(t *testing.T) {
@@ -214,6 +291,121 @@ func TestLayerGroupRequester_Get(t *testing.T) {
 	})
 }
 
+func TestLayerGroupRequester_GetAll(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getLayerGroupsResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lgr := &LayerGroupRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		groups, err := lgr.GetAll()
+		assert.NoError(t, err)
+		assert.NotNil(t, groups)
+		assert.Len(t, groups.Entries, 2)
+		assert.Equal(t, testdata.LayerGroupName, groups.Entries[0].Name)
+	})
+
+	t.Run("200 Ok Empty", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(`{"layerGroups": ""}`)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lgr := &LayerGroupRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		groups, err := lgr.GetAll()
+		assert.NoError(t, err)
+		assert.NotNil(t, groups)
+		assert.Empty(t, groups.Entries)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lgr := &LayerGroupRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		groups, err := lgr.GetAll()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "workspace PLAYGROUND not found")
+		assert.Nil(t, groups)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lgr := &LayerGroupRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		groups, err := lgr.GetAll()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, groups)
+	})
+
+	t.Run("Invalid JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lgr := &LayerGroupRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		groups, err := lgr.GetAll()
+		assert.Error(t, err)
+		assert.Nil(t, groups)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		lgr := &LayerGroupRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		groups, err := lgr.GetAll()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, groups)
+	})
+}
+
 func TestLayerGroupRequester_Delete(t *testing.T) {
 	t.Run("200 Ok", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -0,0 +1,122 @@
+package requester
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/layers"
+	"io"
+	"net/http"
+)
+
+type LayerRequester struct {
+	data internal.GeoserverData
+}
+
+func NewLayerRequester(data internal.GeoserverData) LayerRequester {
+	return LayerRequester{data: data}
+}
+
+func (lr LayerRequester) Get(name string) (*layers.Layer, error) {
+	var target string
+	if validator.Empty(lr.data.Workspace) {
+		target = fmt.Sprintf("%s/geoserver/rest/layers/%s", lr.data.Connection.URL, name)
+	} else {
+		target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/layers/%s", lr.data.Connection.URL, lr.data.Workspace, name)
+	}
+
+	request, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = lr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := lr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var layer layers.LayerWrapper
+		err = json.Unmarshal(body, &layer)
+		if err != nil {
+			return nil, err
+		}
+
+		return &layer.Layer, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("layer %s not found", name))
+	default:
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (lr LayerRequester) GetAll() (*layers.Layers, error) {
+	var target string
+	if validator.Empty(lr.data.Workspace) {
+		target = fmt.Sprintf("%s/geoserver/rest/layers", lr.data.Connection.URL)
+	} else {
+		target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/layers", lr.data.Connection.URL, lr.data.Workspace)
+	}
+
+	request, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = lr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := lr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var all layers.LayersWrapper
+		err = json.Unmarshal(body, &all)
+		if err != nil {
+			//geoserver responds with an empty string when there are no layers
+			var noLayers struct {
+				Layers string `json:"layers"`
+			}
+			if json.Unmarshal(body, &noLayers) == nil {
+				return &layers.Layers{Entries: nil}, nil
+			}
+
+			return nil, err
+		}
+
+		return &all.Layers, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("workspace %s not found", lr.data.Workspace))
+	default:
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
@@ -0,0 +1,273 @@
+package requester
+
+import (
+	"bytes"
+	"errors"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	getLayerResponse  = "../testdata/layers/getlayer.json"
+	getLayersResponse = "../testdata/layers/getlayers.json"
+)
+
+func TestLayerRequester_Get(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getLayerResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lr := &LayerRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		layer, err := lr.Get(testdata.FeatureTypePostgis)
+		assert.NoError(t, err)
+		assert.NotNil(t, layer)
+		assert.Equal(t, testdata.FeatureTypePostgis, layer.Name)
+		assert.Equal(t, "line", layer.DefaultStyle.Name)
+		assert.Len(t, layer.Styles.Style, 1)
+		assert.Equal(t, "PLAYGROUND:dashed", layer.Styles.Style[0].Name)
+		assert.Equal(t, "featureType", layer.Resource.Class)
+		assert.Equal(t, testdata.DatastorePostgis, layer.Resource.Store())
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lr := &LayerRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		layer, err := lr.Get(testdata.FeatureTypePostgis)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "layer init not found")
+		assert.Nil(t, layer)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lr := &LayerRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		layer, err := lr.Get(testdata.FeatureTypePostgis)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, layer)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lr := &LayerRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		layer, err := lr.Get(testdata.FeatureTypePostgis)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected end of JSON input")
+		assert.Nil(t, layer)
+	})
+
+	t.Run("Styles Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(`{"layer": {"name": "init", "styles": 42}}`)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lr := &LayerRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		layer, err := lr.Get(testdata.FeatureTypePostgis)
+		assert.Error(t, err)
+		assert.Nil(t, layer)
+	})
+
+	t.Run("No Alternative Styles", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(`{"layer": {"name": "init", "styles": ""}}`)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lr := &LayerRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		layer, err := lr.Get(testdata.FeatureTypePostgis)
+		assert.NoError(t, err)
+		assert.Empty(t, layer.Styles.Style)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		lr := &LayerRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		layer, err := lr.Get(testdata.FeatureTypePostgis)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, layer)
+	})
+}
+
+func TestLayerRequester_GetAll(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getLayersResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lr := &LayerRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := lr.GetAll()
+		assert.NoError(t, err)
+		assert.NotNil(t, all)
+		assert.Len(t, all.Entries, 2)
+		assert.Equal(t, testdata.FeatureTypePostgis, all.Entries[0].Name)
+	})
+
+	t.Run("200 Ok Empty", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(`{"layers": ""}`)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lr := &LayerRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := lr.GetAll()
+		assert.NoError(t, err)
+		assert.NotNil(t, all)
+		assert.Empty(t, all.Entries)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lr := &LayerRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := lr.GetAll()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "workspace PLAYGROUND not found")
+		assert.Nil(t, all)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lr := &LayerRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := lr.GetAll()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, all)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		lr := &LayerRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := lr.GetAll()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected end of JSON input")
+		assert.Nil(t, all)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		lr := &LayerRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := lr.GetAll()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, all)
+	})
+}
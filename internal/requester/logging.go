@@ -27,7 +27,11 @@ func (lr LoggingRequester) Get() (*logging.Log, error) {
 		return nil, err
 	}
 
-	request.SetBasicAuth(lr.data.Connection.Credentials.Username, lr.data.Connection.Credentials.Password)
+	err = lr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := lr.data.Client.Do(request)
@@ -61,7 +65,11 @@ func (lr LoggingRequester) Put(content []byte) error {
 		return err
 	}
 
-	request.SetBasicAuth(lr.data.Connection.Credentials.Username, lr.data.Connection.Credentials.Password)
+	err = lr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := lr.data.Client.Do(request)
@@ -0,0 +1,196 @@
+package requester
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/namespaces"
+	"io"
+	"net/http"
+)
+
+type NamespaceRequester struct {
+	data internal.GeoserverData
+}
+
+func NewNamespaceRequester(data internal.GeoserverData) NamespaceRequester {
+	return NamespaceRequester{data: data}
+}
+
+func (nr NamespaceRequester) Create(content []byte) error {
+	request, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/geoserver/rest/namespaces", nr.data.Connection.URL), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	err = nr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Content-Type", "application/json")
+
+	response, err := nr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusCreated:
+		return nil
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (nr NamespaceRequester) Get(prefix string) (*namespaces.Namespace, error) {
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/geoserver/rest/namespaces/%s", nr.data.Connection.URL, prefix), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = nr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := nr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var namespace namespaces.NamespaceWrapper
+		err = json.NewDecoder(response.Body).Decode(&namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		return &namespace.Namespace, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("namespace %s not found", prefix))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (nr NamespaceRequester) GetAll() (*namespaces.Namespaces, error) {
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/geoserver/rest/namespaces", nr.data.Connection.URL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = nr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := nr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var wrapper namespaces.NamespacesWrapper
+		err = json.NewDecoder(response.Body).Decode(&wrapper)
+		if err != nil {
+			return nil, err
+		}
+
+		return &wrapper.Namespaces, nil
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (nr NamespaceRequester) Update(prefix string, content []byte) error {
+	request, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/geoserver/rest/namespaces/%s", nr.data.Connection.URL, prefix), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	err = nr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Content-Type", "application/json")
+
+	response, err := nr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(fmt.Errorf("namespace %s not found", prefix))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (nr NamespaceRequester) Delete(prefix string) error {
+	request, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/geoserver/rest/namespaces/%s", nr.data.Connection.URL, prefix), nil)
+	if err != nil {
+		return err
+	}
+
+	err = nr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	response, err := nr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(fmt.Errorf("namespace %s not found", prefix))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
@@ -0,0 +1,406 @@
+package requester
+
+import (
+	"bytes"
+	"errors"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	getSingleNamespaceResponse = "../testdata/namespaces/single.json"
+	getAllNamespacesResponse   = "../testdata/namespaces/multi.json"
+	getNoNamespacesResponse    = "../testdata/namespaces/empty.json"
+)
+
+func TestNamespaceRequester_Create(t *testing.T) {
+	t.Run("201 Created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := namespaceRequester.Create(nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := namespaceRequester.Create(nil)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := namespaceRequester.Create(nil)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestNamespaceRequester_Get(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getSingleNamespaceResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		namespace, err := namespaceRequester.Get(testdata.Workspace)
+		assert.NoError(t, err)
+		assert.NotNil(t, namespace)
+		assert.Equal(t, testdata.Workspace, namespace.Prefix)
+		assert.Equal(t, "http://example.com/playground", namespace.URI)
+		assert.True(t, namespace.Isolated)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		namespace, err := namespaceRequester.Get(testdata.Workspace)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "namespace PLAYGROUND not found")
+		assert.Nil(t, namespace)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		namespace, err := namespaceRequester.Get(testdata.Workspace)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, namespace)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		namespace, err := namespaceRequester.Get(testdata.Workspace)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, namespace)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		namespace, err := namespaceRequester.Get(testdata.Workspace)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, namespace)
+	})
+}
+
+func TestNamespaceRequester_GetAll(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getAllNamespacesResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := namespaceRequester.GetAll()
+		assert.NoError(t, err)
+		assert.NotNil(t, all)
+		assert.Len(t, all.Entries, 2)
+		assert.Equal(t, testdata.Workspace, all.Entries[0].Name)
+	})
+
+	t.Run("200 Ok No Namespaces", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getNoNamespacesResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := namespaceRequester.GetAll()
+		assert.NoError(t, err)
+		assert.NotNil(t, all)
+		assert.Empty(t, all.Entries)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := namespaceRequester.GetAll()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, all)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := namespaceRequester.GetAll()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, all)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := namespaceRequester.GetAll()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, all)
+	})
+}
+
+func TestNamespaceRequester_Update(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := namespaceRequester.Update(testdata.Workspace, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := namespaceRequester.Update(testdata.Workspace, nil)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "namespace PLAYGROUND not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := namespaceRequester.Update(testdata.Workspace, nil)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := namespaceRequester.Update(testdata.Workspace, nil)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestNamespaceRequester_Delete(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := namespaceRequester.Delete(testdata.Workspace)
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := namespaceRequester.Delete(testdata.Workspace)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "namespace PLAYGROUND not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := namespaceRequester.Delete(testdata.Workspace)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		namespaceRequester := &NamespaceRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := namespaceRequester.Delete(testdata.Workspace)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
@@ -0,0 +1,37 @@
+package requester
+
+import (
+	"errors"
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/pkg/security"
+	"net/http"
+)
+
+// PasswordRequester changes the password of the authenticated user and the keystore master password
+type PasswordRequester struct {
+	data internal.GeoserverData
+}
+
+func NewPasswordRequester(data internal.GeoserverData) PasswordRequester {
+	return PasswordRequester{data: data}
+}
+
+func (pr PasswordRequester) ChangeSelf(content []byte) error {
+	return securityWrite(pr.data, http.MethodPut, fmt.Sprintf("%s/geoserver/rest/security/self/password", pr.data.Connection.URL), content, errors.New("authenticated user not found"))
+}
+
+func (pr PasswordRequester) GetMaster() (string, error) {
+	var master security.MasterPassword
+	err := securityRead(pr.data, fmt.Sprintf("%s/geoserver/rest/security/masterpw", pr.data.Connection.URL), &master, errors.New("master password not found"))
+	if err != nil {
+		return "", err
+	}
+
+	return master.OldMasterPassword, nil
+}
+
+// ChangeMaster fails with 422 when the old master password is wrong or the new one does not satisfy the password policy
+func (pr PasswordRequester) ChangeMaster(content []byte) error {
+	return securityWrite(pr.data, http.MethodPut, fmt.Sprintf("%s/geoserver/rest/security/masterpw", pr.data.Connection.URL), content, errors.New("master password not found"))
+}
@@ -0,0 +1,194 @@
+package requester
+
+import (
+	"bytes"
+	"errors"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	getMasterPasswordResponse = "../testdata/security/masterpw.json"
+)
+
+func TestPasswordRequester_ChangeSelf(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		passwordRequester := NewPasswordRequester(testdata.GeoserverInfo(mockClient))
+
+		err := passwordRequester.ChangeSelf([]byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		passwordRequester := NewPasswordRequester(testdata.GeoserverInfo(mockClient))
+
+		err := passwordRequester.ChangeSelf([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		passwordRequester := NewPasswordRequester(testdata.GeoserverInfo(mockClient))
+
+		err := passwordRequester.ChangeSelf([]byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestPasswordRequester_GetMaster(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getMasterPasswordResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		passwordRequester := NewPasswordRequester(testdata.GeoserverInfo(mockClient))
+
+		password, err := passwordRequester.GetMaster()
+		assert.NoError(t, err)
+		assert.Equal(t, "geoserver", password)
+	})
+
+	t.Run("403 Forbidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("forbidden")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		passwordRequester := NewPasswordRequester(testdata.GeoserverInfo(mockClient))
+
+		password, err := passwordRequester.GetMaster()
+		assert.Error(t, err)
+		assert.Empty(t, password)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 403 from geoserver: forbidden")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		passwordRequester := NewPasswordRequester(testdata.GeoserverInfo(mockClient))
+
+		password, err := passwordRequester.GetMaster()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Empty(t, password)
+	})
+}
+
+func TestPasswordRequester_ChangeMaster(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		passwordRequester := NewPasswordRequester(testdata.GeoserverInfo(mockClient))
+
+		err := passwordRequester.ChangeMaster([]byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("422 Unprocessable Entity", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusUnprocessableEntity,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("invalid password")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		passwordRequester := NewPasswordRequester(testdata.GeoserverInfo(mockClient))
+
+		err := passwordRequester.ChangeMaster([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 422 from geoserver: invalid password")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		passwordRequester := NewPasswordRequester(testdata.GeoserverInfo(mockClient))
+
+		err := passwordRequester.ChangeMaster([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		passwordRequester := NewPasswordRequester(testdata.GeoserverInfo(mockClient))
+
+		err := passwordRequester.ChangeMaster([]byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
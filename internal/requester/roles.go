@@ -0,0 +1,316 @@
+package requester
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/security"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// RoleRequester manages the roles of a role service and their association with users and groups
+type RoleRequester struct {
+	data    internal.GeoserverData
+	service string
+}
+
+// NewRoleRequester targets the active role service when service is empty
+func NewRoleRequester(data internal.GeoserverData, service string) RoleRequester {
+	return RoleRequester{
+		data:    data,
+		service: service,
+	}
+}
+
+func (rr RoleRequester) base() string {
+	if rr.service == "" {
+		return fmt.Sprintf("%s/geoserver/rest/security/roles", rr.data.Connection.URL)
+	}
+
+	return fmt.Sprintf("%s/geoserver/rest/security/roles/service/%s", rr.data.Connection.URL, rr.service)
+}
+
+func (rr RoleRequester) notFound() error {
+	if rr.service == "" {
+		return fmt.Errorf("active role service not found")
+	}
+
+	return fmt.Errorf("role service %s not found", rr.service)
+}
+
+func (rr RoleRequester) GetAll() ([]string, error) {
+	var roles security.RolesWrapper
+	err := securityRead(rr.data, rr.base(), &roles, rr.notFound())
+	if err != nil {
+		return nil, err
+	}
+
+	return roles.Roles, nil
+}
+
+func (rr RoleRequester) GetUserRoles(user string) ([]string, error) {
+	var roles security.RolesWrapper
+	err := securityRead(rr.data, fmt.Sprintf("%s/user/%s", rr.base(), user), &roles, fmt.Errorf("user %s not found", user))
+	if err != nil {
+		return nil, err
+	}
+
+	return roles.Roles, nil
+}
+
+func (rr RoleRequester) GetGroupRoles(group string) ([]string, error) {
+	var roles security.RolesWrapper
+	err := securityRead(rr.data, fmt.Sprintf("%s/group/%s", rr.base(), group), &roles, fmt.Errorf("group %s not found", group))
+	if err != nil {
+		return nil, err
+	}
+
+	return roles.Roles, nil
+}
+
+func (rr RoleRequester) Create(role string) error {
+	return securityWrite(rr.data, http.MethodPost, fmt.Sprintf("%s/role/%s", rr.base(), role), nil, rr.notFound())
+}
+
+func (rr RoleRequester) Delete(role string) error {
+	return securityWrite(rr.data, http.MethodDelete, fmt.Sprintf("%s/role/%s", rr.base(), role), nil, fmt.Errorf("role %s not found", role))
+}
+
+func (rr RoleRequester) AssignToUser(role, user string) error {
+	return securityWrite(rr.data, http.MethodPost, fmt.Sprintf("%s/role/%s/user/%s", rr.base(), role, user), nil, fmt.Errorf("role %s or user %s not found", role, user))
+}
+
+func (rr RoleRequester) UnassignFromUser(role, user string) error {
+	return securityWrite(rr.data, http.MethodDelete, fmt.Sprintf("%s/role/%s/user/%s", rr.base(), role, user), nil, fmt.Errorf("role %s or user %s not found", role, user))
+}
+
+func (rr RoleRequester) AssignToGroup(role, group string) error {
+	return securityWrite(rr.data, http.MethodPost, fmt.Sprintf("%s/role/%s/group/%s", rr.base(), role, group), nil, fmt.Errorf("role %s or group %s not found", role, group))
+}
+
+func (rr RoleRequester) UnassignFromGroup(role, group string) error {
+	return securityWrite(rr.data, http.MethodDelete, fmt.Sprintf("%s/role/%s/group/%s", rr.base(), role, group), nil, fmt.Errorf("role %s or group %s not found", role, group))
+}
+
+// roleRegistry mirrors the roles.xml file of an XML role service. The user and group lists are kept verbatim.
+type roleRegistry struct {
+	XMLName  xml.Name `xml:"http://www.geoserver.org/security/roles roleRegistry"`
+	Version  string   `xml:"version,attr"`
+	RoleList struct {
+		Roles []registryRole `xml:"role"`
+	} `xml:"roleList"`
+	UserList struct {
+		Inner string `xml:",innerxml"`
+	} `xml:"userList"`
+	GroupList struct {
+		Inner string `xml:",innerxml"`
+	} `xml:"groupList"`
+}
+
+type registryRole struct {
+	ID         string `xml:"id,attr"`
+	ParentID   string `xml:"parentID,attr,omitempty"`
+	Properties []struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"property"`
+}
+
+// securityConfig mirrors the config.xml file of the security settings, for the name of the active role service
+type securityConfig struct {
+	RoleServiceName string `xml:"roleServiceName"`
+}
+
+// roleServiceConfig mirrors the config.xml file of a role service
+type roleServiceConfig struct {
+	Name      string `xml:"name"`
+	ClassName string `xml:"className"`
+	FileName  string `xml:"fileName"`
+}
+
+// xmlRoleService is the class of the role services storing their roles in an XML file
+const xmlRoleService = "org.geoserver.security.xml.XMLRoleService"
+
+// readResource reads a file of the data directory through the resource API
+func (rr RoleRequester) readResource(path string, notFound error) ([]byte, error) {
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/geoserver/rest/resource/%s", rr.data.Connection.URL, path), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := rr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(notFound)
+	default:
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+// rolesFile resolves the path of the roles file of the role service, or of the active role service when none is set,
+// failing for the role services which are not backed by an XML file
+func (rr RoleRequester) rolesFile() (string, error) {
+	service := rr.service
+	if service == "" {
+		body, err := rr.readResource("security/config.xml", fmt.Errorf("security configuration not found"))
+		if err != nil {
+			return "", err
+		}
+
+		var config securityConfig
+		if err = xml.Unmarshal(body, &config); err != nil {
+			return "", err
+		}
+
+		if config.RoleServiceName == "" {
+			return "", customerrors.WrapGeoserverError(fmt.Errorf("the security configuration names no active role service"))
+		}
+
+		service = config.RoleServiceName
+	}
+
+	body, err := rr.readResource(fmt.Sprintf("security/role/%s/config.xml", service), fmt.Errorf("role service %s not found", service))
+	if err != nil {
+		return "", err
+	}
+
+	var config roleServiceConfig
+	if err = xml.Unmarshal(body, &config); err != nil {
+		return "", err
+	}
+
+	if config.ClassName != xmlRoleService {
+		return "", customerrors.WrapInputError(fmt.Errorf("role service %s is not an xml role service (%s), the role hierarchy can only be changed for xml role services", service, config.ClassName))
+	}
+
+	fileName := config.FileName
+	if fileName == "" {
+		fileName = "roles.xml"
+	}
+
+	// a file outside the directory of the service cannot be reached through the resource API
+	if strings.ContainsAny(fileName, `/\`) {
+		return "", customerrors.WrapInputError(fmt.Errorf("the roles file %s of role service %s is outside its directory", fileName, service))
+	}
+
+	return fmt.Sprintf("security/role/%s/%s", service, fileName), nil
+}
+
+// SetParent changes the parent of the role. The REST API does not expose the role hierarchy, so the roles file of
+// the role service is edited through the resource API, which only works for XML role services. The active role
+// service is looked up in the security configuration when none is set, and the service is checked to be XML-backed
+// before its file is changed. GeoServer watches the file and reloads the role service on change.
+// The file is read, changed and written back without any lock, so a change made to the role service in between is lost.
+func (rr RoleRequester) SetParent(role, parent string) error {
+	file, err := rr.rolesFile()
+	if err != nil {
+		return err
+	}
+
+	body, err := rr.readResource(file, fmt.Errorf("roles file %s not found", file))
+	if err != nil {
+		return err
+	}
+
+	target := fmt.Sprintf("%s/geoserver/rest/resource/%s", rr.data.Connection.URL, file)
+
+	var registry roleRegistry
+	if err = xml.Unmarshal(body, &registry); err != nil {
+		return err
+	}
+
+	var found, parentFound bool
+	for i := range registry.RoleList.Roles {
+		if registry.RoleList.Roles[i].ID == role {
+			found = true
+		}
+
+		if registry.RoleList.Roles[i].ID == parent {
+			parentFound = true
+		}
+	}
+
+	if !found {
+		return customerrors.WrapNotFoundError(fmt.Errorf("role %s not found", role))
+	}
+
+	if parent != "" && !parentFound {
+		return customerrors.WrapNotFoundError(fmt.Errorf("role %s not found", parent))
+	}
+
+	//walk up from the new parent, reaching the role again means the role would become its own ancestor
+	parents := make(map[string]string, len(registry.RoleList.Roles))
+	for _, r := range registry.RoleList.Roles {
+		parents[r.ID] = r.ParentID
+	}
+
+	visited := make(map[string]bool)
+	for ancestor := parent; ancestor != "" && !visited[ancestor]; ancestor = parents[ancestor] {
+		if ancestor == role {
+			return customerrors.WrapInputError(fmt.Errorf("role %s cannot be the parent of %s since it would create a cycle", parent, role))
+		}
+		visited[ancestor] = true
+	}
+
+	for i := range registry.RoleList.Roles {
+		if registry.RoleList.Roles[i].ID == role {
+			registry.RoleList.Roles[i].ParentID = parent
+		}
+	}
+
+	content, err := xml.MarshalIndent(registry, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPut, target, bytes.NewReader(append([]byte(xml.Header), content...)))
+	if err != nil {
+		return err
+	}
+
+	err = rr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Content-Type", "application/xml")
+
+	response, err := rr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return nil
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
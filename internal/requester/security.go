@@ -0,0 +1,89 @@
+package requester
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"io"
+	"net/http"
+)
+
+// securityWrite sends a request to one of the security endpoints, which reply with 200 or 201 on success
+// and 404 when one of the referenced users, groups or roles does not exist.
+func securityWrite(data internal.GeoserverData, method, target string, content []byte, notFound error) error {
+	var body io.Reader
+	if content != nil {
+		body = bytes.NewReader(content)
+	}
+
+	request, err := http.NewRequest(method, target, body)
+	if err != nil {
+		return err
+	}
+
+	err = data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	if content != nil {
+		request.Header.Add("Content-Type", "application/json")
+	}
+
+	response, err := data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(notFound)
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+// securityRead decodes the response of one of the security endpoints into v
+func securityRead(data internal.GeoserverData, target string, v any, notFound error) error {
+	request, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+
+	err = data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return json.NewDecoder(response.Body).Decode(v)
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(notFound)
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
@@ -0,0 +1,1895 @@
+package requester
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	getUsersResponse      = "../testdata/security/users.json"
+	getGroupsResponse     = "../testdata/security/groups.json"
+	getRolesResponse      = "../testdata/security/roles.json"
+	getSingleRoleResponse = "../testdata/security/single_role.json"
+	getRolesXMLResponse   = "../testdata/security/roles.xml"
+)
+
+func TestUserGroupRequester_GetUsers(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getUsersResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		users, err := userGroupRequester.GetUsers()
+		assert.NoError(t, err)
+		assert.Len(t, users, 2)
+		assert.Equal(t, "tenant.a@example.com", users[1].UserName)
+		assert.False(t, users[1].Enabled)
+	})
+
+	t.Run("200 Ok No Users", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(`{"users": ""}`)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		users, err := userGroupRequester.GetUsers()
+		assert.NoError(t, err)
+		assert.NotNil(t, users)
+		assert.Empty(t, users)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		users, err := userGroupRequester.GetUsers()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "default user/group service not found")
+		assert.Nil(t, users)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		users, err := userGroupRequester.GetUsers()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, users)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		users, err := userGroupRequester.GetUsers()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, users)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		users, err := userGroupRequester.GetUsers()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, users)
+	})
+}
+
+func TestUserGroupRequester_CreateUser(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.CreateUser([]byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("201 Created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.CreateUser([]byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.CreateUser([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "default user/group service not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.CreateUser([]byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.CreateUser([]byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestUserGroupRequester_UpdateUser(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.UpdateUser("tenant", []byte(`{}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.UpdateUser("tenant", []byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "user tenant not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.UpdateUser("tenant", []byte(`{}`))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.UpdateUser("tenant", []byte(`{}`))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestUserGroupRequester_DeleteUser(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.DeleteUser("tenant")
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.DeleteUser("tenant")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "user tenant not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.DeleteUser("tenant")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.DeleteUser("tenant")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestUserGroupRequester_GetGroups(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getGroupsResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		groups, err := userGroupRequester.GetGroups()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"TENANTS", "OPERATORS"}, groups)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		groups, err := userGroupRequester.GetGroups()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "default user/group service not found")
+		assert.Nil(t, groups)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		groups, err := userGroupRequester.GetGroups()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, groups)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		groups, err := userGroupRequester.GetGroups()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, groups)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		groups, err := userGroupRequester.GetGroups()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, groups)
+	})
+}
+
+func TestUserGroupRequester_CreateGroup(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.CreateGroup("TENANTS")
+		assert.NoError(t, err)
+	})
+
+	t.Run("201 Created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.CreateGroup("TENANTS")
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.CreateGroup("TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "default user/group service not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.CreateGroup("TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.CreateGroup("TENANTS")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestUserGroupRequester_DeleteGroup(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.DeleteGroup("TENANTS")
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.DeleteGroup("TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "group TENANTS not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.DeleteGroup("TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.DeleteGroup("TENANTS")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestUserGroupRequester_GetUserGroups(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getGroupsResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		groups, err := userGroupRequester.GetUserGroups("tenant")
+		assert.NoError(t, err)
+		assert.Len(t, groups, 2)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		groups, err := userGroupRequester.GetUserGroups("tenant")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "user tenant not found")
+		assert.Nil(t, groups)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		groups, err := userGroupRequester.GetUserGroups("tenant")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, groups)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		groups, err := userGroupRequester.GetUserGroups("tenant")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, groups)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		groups, err := userGroupRequester.GetUserGroups("tenant")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, groups)
+	})
+}
+
+func TestUserGroupRequester_GetGroupUsers(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getUsersResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		users, err := userGroupRequester.GetGroupUsers("TENANTS")
+		assert.NoError(t, err)
+		assert.Len(t, users, 2)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		users, err := userGroupRequester.GetGroupUsers("TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "group TENANTS not found")
+		assert.Nil(t, users)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		users, err := userGroupRequester.GetGroupUsers("TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, users)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		users, err := userGroupRequester.GetGroupUsers("TENANTS")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, users)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		users, err := userGroupRequester.GetGroupUsers("TENANTS")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, users)
+	})
+}
+
+func TestUserGroupRequester_AddUserToGroup(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, request.Method)
+			assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/security/usergroup/service/ldap/user/tenant/group/TENANTS", request.URL.String())
+			return mockResponse, nil
+		})
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "ldap")
+
+		err := userGroupRequester.AddUserToGroup("tenant", "TENANTS")
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.AddUserToGroup("tenant", "TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "user tenant or group TENANTS not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.AddUserToGroup("tenant", "TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.AddUserToGroup("tenant", "TENANTS")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestUserGroupRequester_RemoveUserFromGroup(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.RemoveUserFromGroup("tenant", "TENANTS")
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.RemoveUserFromGroup("tenant", "TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "user tenant or group TENANTS not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.RemoveUserFromGroup("tenant", "TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		userGroupRequester := NewUserGroupRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := userGroupRequester.RemoveUserFromGroup("tenant", "TENANTS")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestRoleRequester_GetAll(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getRolesResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetAll()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ADMIN", "GROUP_ADMIN", "ROLE_TENANT"}, roles)
+	})
+
+	t.Run("200 Ok Single Role", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getSingleRoleResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetAll()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ADMIN"}, roles)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetAll()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "active role service not found")
+		assert.Nil(t, roles)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetAll()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, roles)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetAll()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, roles)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetAll()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, roles)
+	})
+}
+
+func TestRoleRequester_GetUserRoles(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getSingleRoleResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetUserRoles("admin")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ADMIN"}, roles)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetUserRoles("admin")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "user admin not found")
+		assert.Nil(t, roles)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetUserRoles("admin")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, roles)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetUserRoles("admin")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, roles)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetUserRoles("admin")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, roles)
+	})
+}
+
+func TestRoleRequester_GetGroupRoles(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getRolesResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetGroupRoles("TENANTS")
+		assert.NoError(t, err)
+		assert.Len(t, roles, 3)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetGroupRoles("TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "group TENANTS not found")
+		assert.Nil(t, roles)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetGroupRoles("TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, roles)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetGroupRoles("TENANTS")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, roles)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		roles, err := roleRequester.GetGroupRoles("TENANTS")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, roles)
+	})
+}
+
+func TestRoleRequester_Create(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.Create("ROLE_TENANT")
+		assert.NoError(t, err)
+	})
+
+	t.Run("201 Created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.Create("ROLE_TENANT")
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.Create("ROLE_TENANT")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "active role service not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.Create("ROLE_TENANT")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.Create("ROLE_TENANT")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestRoleRequester_Delete(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.Delete("ROLE_TENANT")
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.Delete("ROLE_TENANT")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "role ROLE_TENANT not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.Delete("ROLE_TENANT")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.Delete("ROLE_TENANT")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestRoleRequester_AssignToUser(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.AssignToUser("ROLE_TENANT", "tenant")
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.AssignToUser("ROLE_TENANT", "tenant")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "role ROLE_TENANT or user tenant not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.AssignToUser("ROLE_TENANT", "tenant")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.AssignToUser("ROLE_TENANT", "tenant")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestRoleRequester_UnassignFromUser(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.UnassignFromUser("ROLE_TENANT", "tenant")
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.UnassignFromUser("ROLE_TENANT", "tenant")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "role ROLE_TENANT or user tenant not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.UnassignFromUser("ROLE_TENANT", "tenant")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.UnassignFromUser("ROLE_TENANT", "tenant")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestRoleRequester_AssignToGroup(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.AssignToGroup("ROLE_TENANT", "TENANTS")
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.AssignToGroup("ROLE_TENANT", "TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "role ROLE_TENANT or group TENANTS not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.AssignToGroup("ROLE_TENANT", "TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.AssignToGroup("ROLE_TENANT", "TENANTS")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestRoleRequester_UnassignFromGroup(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.UnassignFromGroup("ROLE_TENANT", "TENANTS")
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.UnassignFromGroup("ROLE_TENANT", "TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "role ROLE_TENANT or group TENANTS not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.UnassignFromGroup("ROLE_TENANT", "TENANTS")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.UnassignFromGroup("ROLE_TENANT", "TENANTS")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+const (
+	securityConfigXML  = `<security><roleServiceName>default</roleServiceName></security>`
+	roleServiceXML     = `<roleService><name>default</name><className>org.geoserver.security.xml.XMLRoleService</className><fileName>roles.xml</fileName></roleService>`
+	jdbcRoleServiceXML = `<roleService><name>jdbc</name><className>org.geoserver.security.jdbc.JDBCRoleService</className></roleService>`
+)
+
+// expectResource expects a read of the file of the data directory through the resource API
+func expectResource(t *testing.T, mockClient *mocks.MockHTTPClient, path, content string) *gomock.Call {
+	return mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/resource/"+path, request.URL.String())
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(content)),
+		}, nil
+	})
+}
+
+func TestRoleRequester_SetParent(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(getRolesXMLResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		putResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		var written string
+		gomock.InOrder(
+			expectResource(t, mockClient, "security/config.xml", securityConfigXML),
+			expectResource(t, mockClient, "security/role/default/config.xml", roleServiceXML),
+			expectResource(t, mockClient, "security/role/default/roles.xml", string(content)),
+			mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPut, request.Method)
+				assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/resource/security/role/default/roles.xml", request.URL.String())
+				body, err := io.ReadAll(request.Body)
+				assert.NoError(t, err)
+				written = string(body)
+				return putResponse, nil
+			}),
+		)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err = roleRequester.SetParent("ROLE_TENANT", "GROUP_ADMIN")
+		assert.NoError(t, err)
+		assert.Contains(t, written, `<role id="ROLE_TENANT" parentID="GROUP_ADMIN">`)
+		assert.Contains(t, written, `<property name="tenant">a</property>`)
+		assert.Contains(t, written, `<roleRef roleID="ADMIN"/>`)
+		assert.Contains(t, written, `xmlns="http://www.geoserver.org/security/roles"`)
+	})
+
+	t.Run("Named Service", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(getRolesXMLResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		putResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		gomock.InOrder(
+			expectResource(t, mockClient, "security/role/tenants/config.xml", `<roleService><name>tenants</name><className>org.geoserver.security.xml.XMLRoleService</className><fileName>tenants.xml</fileName></roleService>`),
+			expectResource(t, mockClient, "security/role/tenants/tenants.xml", string(content)),
+			mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPut, request.Method)
+				assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/resource/security/role/tenants/tenants.xml", request.URL.String())
+				return putResponse, nil
+			}),
+		)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "tenants")
+
+		err = roleRequester.SetParent("ROLE_TENANT", "GROUP_ADMIN")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Not An XML Role Service", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+
+		//the roles are neither read nor written
+		gomock.InOrder(
+			expectResource(t, mockClient, "security/config.xml", `<security><roleServiceName>jdbc</roleServiceName></security>`),
+			expectResource(t, mockClient, "security/role/jdbc/config.xml", jdbcRoleServiceXML),
+		)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.SetParent("ROLE_TENANT", "ADMIN")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.InputError{}, err)
+		assert.EqualError(t, err, "role service jdbc is not an xml role service (org.geoserver.security.jdbc.JDBCRoleService), the role hierarchy can only be changed for xml role services")
+	})
+
+	t.Run("Role Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(getRolesXMLResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		gomock.InOrder(
+			expectResource(t, mockClient, "security/role/default/config.xml", roleServiceXML),
+			expectResource(t, mockClient, "security/role/default/roles.xml", string(content)),
+		)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "default")
+
+		err = roleRequester.SetParent("ROLE_MISSING", "ADMIN")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "role ROLE_MISSING not found")
+	})
+
+	t.Run("Parent Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(getRolesXMLResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		gomock.InOrder(
+			expectResource(t, mockClient, "security/role/default/config.xml", roleServiceXML),
+			expectResource(t, mockClient, "security/role/default/roles.xml", string(content)),
+		)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "default")
+
+		err = roleRequester.SetParent("ROLE_TENANT", "ROLE_MISSING")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "role ROLE_MISSING not found")
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		cycles := []struct {
+			role   string
+			parent string
+		}{
+			{role: "ADMIN", parent: "ADMIN"},
+			{role: "ADMIN", parent: "GROUP_ADMIN"},
+		}
+
+		for _, cycle := range cycles {
+			ctrl := gomock.NewController(t)
+
+			content, err := testdata.Read(getRolesXMLResponse)
+			assert.NoError(t, err)
+
+			mockClient := mocks.NewMockHTTPClient(ctrl)
+
+			//the registry is not written back
+			gomock.InOrder(
+				expectResource(t, mockClient, "security/role/default/config.xml", roleServiceXML),
+				expectResource(t, mockClient, "security/role/default/roles.xml", string(content)),
+			)
+
+			roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "default")
+
+			err = roleRequester.SetParent(cycle.role, cycle.parent)
+			assert.Error(t, err)
+			assert.IsType(t, &customerrors.InputError{}, err)
+			assert.EqualError(t, err, fmt.Sprintf("role %s cannot be the parent of %s since it would create a cycle", cycle.parent, cycle.role))
+		}
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "jdbc")
+
+		err := roleRequester.SetParent("ROLE_TENANT", "ADMIN")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "role service jdbc not found")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		roleRequester := NewRoleRequester(testdata.GeoserverInfo(mockClient), "")
+
+		err := roleRequester.SetParent("ROLE_TENANT", "ADMIN")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
@@ -0,0 +1,149 @@
+package requester
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"io"
+	"net/http"
+)
+
+// ServiceRequester reads and writes the settings of an OGC service. T is one of the service settings in pkg/services.
+type ServiceRequester[T any] struct {
+	data    internal.GeoserverData
+	service string
+}
+
+func NewServiceRequester[T any](data internal.GeoserverData, service string) ServiceRequester[T] {
+	return ServiceRequester[T]{
+		data:    data,
+		service: service,
+	}
+}
+
+// target returns the global settings url when workspace is empty and the workspace specific settings url otherwise
+func (sr ServiceRequester[T]) target(workspace string) string {
+	if workspace == "" {
+		return fmt.Sprintf("%s/geoserver/rest/services/%s/settings", sr.data.Connection.URL, sr.service)
+	}
+
+	return fmt.Sprintf("%s/geoserver/rest/services/%s/workspaces/%s/settings", sr.data.Connection.URL, sr.service, workspace)
+}
+
+func (sr ServiceRequester[T]) Get(workspace string) (*T, error) {
+	request, err := http.NewRequest(http.MethodGet, sr.target(workspace), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := sr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var settings map[string]T
+		err = json.NewDecoder(response.Body).Decode(&settings)
+		if err != nil {
+			return nil, err
+		}
+
+		s, ok := settings[sr.service]
+		if !ok {
+			return nil, customerrors.WrapGeoserverError(fmt.Errorf("%s settings missing from geoserver response", sr.service))
+		}
+
+		return &s, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("%s settings not found", sr.service))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (sr ServiceRequester[T]) Update(workspace string, settings T) error {
+	content, err := json.Marshal(map[string]T{sr.service: settings})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPut, sr.target(workspace), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	err = sr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Content-Type", "application/json")
+
+	response, err := sr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(fmt.Errorf("workspace %s not found", workspace))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (sr ServiceRequester[T]) Delete(workspace string) error {
+	request, err := http.NewRequest(http.MethodDelete, sr.target(workspace), nil)
+	if err != nil {
+		return err
+	}
+
+	err = sr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	response, err := sr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(fmt.Errorf("%s settings not found", sr.service))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
@@ -0,0 +1,320 @@
+package requester
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/services"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	getWMSSettingsResponse          = "../testdata/services/wms.json"
+	getWorkspaceWFSSettingsResponse = "../testdata/services/wfs_workspace.json"
+)
+
+func TestServiceRequester_Get(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(getWMSSettingsResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/services/wms/settings", request.URL.String())
+			return mockResponse, nil
+		})
+
+		serviceRequester := NewServiceRequester[services.WMS](testdata.GeoserverInfo(mockClient), services.NameWMS)
+
+		settings, err := serviceRequester.Get("")
+		assert.NoError(t, err)
+		assert.NotNil(t, settings)
+		assert.Equal(t, "WMS", *settings.Name)
+		assert.Equal(t, "A compliant implementation of WMS plus most of the SLD extension", *settings.Abstract)
+		assert.Equal(t, []string{"WFS", "WMS", "GEOSERVER"}, settings.Keywords.Keywords)
+		assert.Equal(t, services.BottomRight, *settings.Watermark.Position)
+		assert.Equal(t, 25, *settings.MaxBuffer)
+		assert.Nil(t, settings.Workspace)
+	})
+
+	t.Run("200 Ok Workspace", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		content, err := testdata.Read(getWorkspaceWFSSettingsResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/services/wfs/workspaces/"+testdata.Workspace+"/settings", request.URL.String())
+			return mockResponse, nil
+		})
+
+		serviceRequester := NewServiceRequester[services.WFS](testdata.GeoserverInfo(mockClient), services.NameWFS)
+
+		settings, err := serviceRequester.Get(testdata.Workspace)
+		assert.NoError(t, err)
+		assert.NotNil(t, settings)
+		assert.Equal(t, testdata.Workspace, settings.Workspace.Name)
+		assert.Equal(t, services.ServiceLevelComplete, *settings.ServiceLevel)
+		assert.Equal(t, 1000, *settings.MaxFeatures)
+		assert.Equal(t, []string{"EPSG:4326", "EPSG:3857"}, settings.SRS.SRS)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		serviceRequester := NewServiceRequester[services.WCS](testdata.GeoserverInfo(mockClient), services.NameWCS)
+
+		settings, err := serviceRequester.Get(testdata.Workspace)
+		assert.Error(t, err)
+		assert.Nil(t, settings)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "wcs settings not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		serviceRequester := NewServiceRequester[services.WMS](testdata.GeoserverInfo(mockClient), services.NameWMS)
+
+		settings, err := serviceRequester.Get("")
+		assert.Error(t, err)
+		assert.Nil(t, settings)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Missing Service Key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(`{"wfs": {}}`)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		serviceRequester := NewServiceRequester[services.WMS](testdata.GeoserverInfo(mockClient), services.NameWMS)
+
+		settings, err := serviceRequester.Get("")
+		assert.Error(t, err)
+		assert.Nil(t, settings)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "wms settings missing from geoserver response")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		serviceRequester := NewServiceRequester[services.WMS](testdata.GeoserverInfo(mockClient), services.NameWMS)
+
+		settings, err := serviceRequester.Get("")
+		assert.Error(t, err)
+		assert.Nil(t, settings)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestServiceRequester_Update(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPut, request.Method)
+			assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/services/wms/workspaces/"+testdata.Workspace+"/settings", request.URL.String())
+
+			var body map[string]map[string]any
+			assert.NoError(t, json.NewDecoder(request.Body).Decode(&body))
+			assert.Equal(t, map[string]any{"title": "Playground WMS"}, body["wms"])
+			return mockResponse, nil
+		})
+
+		serviceRequester := NewServiceRequester[services.WMS](testdata.GeoserverInfo(mockClient), services.NameWMS)
+
+		title := "Playground WMS"
+		err := serviceRequester.Update(testdata.Workspace, services.WMS{Service: services.Service{Title: &title}})
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		serviceRequester := NewServiceRequester[services.WMS](testdata.GeoserverInfo(mockClient), services.NameWMS)
+
+		err := serviceRequester.Update(testdata.Workspace, services.WMS{})
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "workspace PLAYGROUND not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		serviceRequester := NewServiceRequester[services.WMS](testdata.GeoserverInfo(mockClient), services.NameWMS)
+
+		err := serviceRequester.Update("", services.WMS{})
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		serviceRequester := NewServiceRequester[services.WMS](testdata.GeoserverInfo(mockClient), services.NameWMS)
+
+		err := serviceRequester.Update("", services.WMS{})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestServiceRequester_Delete(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodDelete, request.Method)
+			assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/services/wmts/workspaces/"+testdata.Workspace+"/settings", request.URL.String())
+			return mockResponse, nil
+		})
+
+		serviceRequester := NewServiceRequester[services.WMTS](testdata.GeoserverInfo(mockClient), services.NameWMTS)
+
+		err := serviceRequester.Delete(testdata.Workspace)
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		serviceRequester := NewServiceRequester[services.WMTS](testdata.GeoserverInfo(mockClient), services.NameWMTS)
+
+		err := serviceRequester.Delete(testdata.Workspace)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "wmts settings not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		serviceRequester := NewServiceRequester[services.WMTS](testdata.GeoserverInfo(mockClient), services.NameWMTS)
+
+		err := serviceRequester.Delete(testdata.Workspace)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		serviceRequester := NewServiceRequester[services.WMTS](testdata.GeoserverInfo(mockClient), services.NameWMTS)
+
+		err := serviceRequester.Delete(testdata.Workspace)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
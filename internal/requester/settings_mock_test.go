@@ -0,0 +1,655 @@
+package requester
+
+import (
+	"bytes"
+	"errors"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/settings"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	getGlobalSettingsResponse    = "../testdata/settings/global.json"
+	getContactResponse           = "../testdata/settings/contact.json"
+	getWorkspaceSettingsResponse = "../testdata/settings/workspace.json"
+)
+
+func TestSettingsRequester_Get(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getGlobalSettingsResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		global, err := settingsRequester.Get()
+		assert.NoError(t, err)
+		assert.NotNil(t, global)
+		assert.Equal(t, "https://maps.example.com/geoserver", *global.Settings.ProxyBaseURL)
+		assert.Equal(t, 8, *global.Settings.NumDecimals)
+		assert.Equal(t, "Maps Team", *global.Settings.Contact.Person)
+		assert.Equal(t, 7, *global.JAI.TileThreads)
+		assert.Equal(t, 0.75, *global.JAI.MemoryThreshold)
+		assert.Equal(t, settings.QueueUnbounded, *global.CoverageAccess.QueueType)
+		assert.True(t, *global.GlobalServices)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		global, err := settingsRequester.Get()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, global)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		global, err := settingsRequester.Get()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, global)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		global, err := settingsRequester.Get()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, global)
+	})
+}
+
+func TestSettingsRequester_Update(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.Update(nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("201 Created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.Update(nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.Update(nil)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.Update(nil)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestSettingsRequester_GetContact(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getContactResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		contact, err := settingsRequester.GetContact()
+		assert.NoError(t, err)
+		assert.NotNil(t, contact)
+		assert.Equal(t, "maps@example.com", *contact.Email)
+		assert.Equal(t, "Example", *contact.Organization)
+		assert.Nil(t, contact.Voice)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		contact, err := settingsRequester.GetContact()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, contact)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		contact, err := settingsRequester.GetContact()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, contact)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		contact, err := settingsRequester.GetContact()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, contact)
+	})
+}
+
+func TestSettingsRequester_UpdateContact(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.UpdateContact(nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("201 Created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.UpdateContact(nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.UpdateContact(nil)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.UpdateContact(nil)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestSettingsRequester_GetWorkspace(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getWorkspaceSettingsResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		workspaceSettings, err := settingsRequester.GetWorkspace(testdata.Workspace)
+		assert.NoError(t, err)
+		assert.NotNil(t, workspaceSettings)
+		assert.Equal(t, testdata.Workspace, workspaceSettings.Workspace.Name)
+		assert.Equal(t, 4, *workspaceSettings.NumDecimals)
+		assert.True(t, *workspaceSettings.VerboseExceptions)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		workspaceSettings, err := settingsRequester.GetWorkspace(testdata.Workspace)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "settings of workspace PLAYGROUND not found")
+		assert.Nil(t, workspaceSettings)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		workspaceSettings, err := settingsRequester.GetWorkspace(testdata.Workspace)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, workspaceSettings)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		workspaceSettings, err := settingsRequester.GetWorkspace(testdata.Workspace)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, workspaceSettings)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		workspaceSettings, err := settingsRequester.GetWorkspace(testdata.Workspace)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, workspaceSettings)
+	})
+}
+
+func TestSettingsRequester_CreateWorkspace(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.CreateWorkspace(testdata.Workspace, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("201 Created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.CreateWorkspace(testdata.Workspace, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.CreateWorkspace(testdata.Workspace, nil)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "workspace PLAYGROUND not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.CreateWorkspace(testdata.Workspace, nil)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.CreateWorkspace(testdata.Workspace, nil)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestSettingsRequester_UpdateWorkspace(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.UpdateWorkspace(testdata.Workspace, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("201 Created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.UpdateWorkspace(testdata.Workspace, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.UpdateWorkspace(testdata.Workspace, nil)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "settings of workspace PLAYGROUND not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.UpdateWorkspace(testdata.Workspace, nil)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.UpdateWorkspace(testdata.Workspace, nil)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestSettingsRequester_DeleteWorkspace(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(nil),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.DeleteWorkspace(testdata.Workspace)
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.DeleteWorkspace(testdata.Workspace)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "settings of workspace PLAYGROUND not found")
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.DeleteWorkspace(testdata.Workspace)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		settingsRequester := &SettingsRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := settingsRequester.DeleteWorkspace(testdata.Workspace)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+	})
+}
@@ -0,0 +1,286 @@
+package requester
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/styles"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// sldContentType is the media type of SLD 1.0 documents
+const sldContentType = "application/vnd.ogc.sld+xml"
+
+type StyleRequester struct {
+	data internal.GeoserverData
+}
+
+func NewStyleRequester(data internal.GeoserverData) StyleRequester {
+	return StyleRequester{data: data}
+}
+
+func (sr StyleRequester) Get(name string) (*styles.Style, error) {
+	var target string
+	if validator.Empty(sr.data.Workspace) {
+		target = fmt.Sprintf("%s/geoserver/rest/styles/%s", sr.data.Connection.URL, name)
+	} else {
+		target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/styles/%s", sr.data.Connection.URL, sr.data.Workspace, name)
+	}
+
+	request, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := sr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var style styles.StyleWrapper
+		err = json.NewDecoder(response.Body).Decode(&style)
+		if err != nil {
+			return nil, err
+		}
+
+		return &style.Style, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("style %s not found", name))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (sr StyleRequester) GetAll() (*styles.Styles, error) {
+	var target string
+	if validator.Empty(sr.data.Workspace) {
+		target = fmt.Sprintf("%s/geoserver/rest/styles", sr.data.Connection.URL)
+	} else {
+		target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/styles", sr.data.Connection.URL, sr.data.Workspace)
+	}
+
+	request, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := sr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var wrapper styles.StylesWrapper
+		err = json.NewDecoder(response.Body).Decode(&wrapper)
+		if err != nil {
+			return nil, err
+		}
+
+		return &wrapper.Styles, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("workspace %s not found", sr.data.Workspace))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+// GetSLD returns the SLD document of the style
+func (sr StyleRequester) GetSLD(name string) ([]byte, error) {
+	var target string
+	if validator.Empty(sr.data.Workspace) {
+		target = fmt.Sprintf("%s/geoserver/rest/styles/%s.sld", sr.data.Connection.URL, name)
+	} else {
+		target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/styles/%s.sld", sr.data.Connection.URL, sr.data.Workspace, name)
+	}
+
+	request, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", sldContentType)
+
+	response, err := sr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("style %s not found", name))
+	default:
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+// Create uploads the SLD document as a new style, GeoServer creating the style and its file in a single request
+func (sr StyleRequester) Create(name string, content []byte) error {
+	var target string
+	if validator.Empty(sr.data.Workspace) {
+		target = fmt.Sprintf("%s/geoserver/rest/styles?name=%s", sr.data.Connection.URL, url.QueryEscape(name))
+	} else {
+		target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/styles?name=%s", sr.data.Connection.URL, sr.data.Workspace, url.QueryEscape(name))
+	}
+
+	request, err := http.NewRequest(http.MethodPost, target, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	err = sr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Content-Type", sldContentType)
+
+	response, err := sr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusCreated:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(fmt.Errorf("workspace %s not found", sr.data.Workspace))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+// Update replaces the SLD document of the style
+func (sr StyleRequester) Update(name string, content []byte) error {
+	var target string
+	if validator.Empty(sr.data.Workspace) {
+		target = fmt.Sprintf("%s/geoserver/rest/styles/%s", sr.data.Connection.URL, name)
+	} else {
+		target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/styles/%s", sr.data.Connection.URL, sr.data.Workspace, name)
+	}
+
+	request, err := http.NewRequest(http.MethodPut, target, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	err = sr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Content-Type", sldContentType)
+
+	response, err := sr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(fmt.Errorf("style %s not found", name))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+// Delete removes the style along with its file. With recurse, the layers using the style fall back to their default
+// style instead of preventing the delete.
+func (sr StyleRequester) Delete(name string, recurse bool) error {
+	var target string
+	if validator.Empty(sr.data.Workspace) {
+		target = fmt.Sprintf("%s/geoserver/rest/styles/%s?purge=true&recurse=%v", sr.data.Connection.URL, name, recurse)
+	} else {
+		target = fmt.Sprintf("%s/geoserver/rest/workspaces/%s/styles/%s?purge=true&recurse=%v", sr.data.Connection.URL, sr.data.Workspace, name, recurse)
+	}
+
+	request, err := http.NewRequest(http.MethodDelete, target, nil)
+	if err != nil {
+		return err
+	}
+
+	err = sr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	response, err := sr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(fmt.Errorf("style %s not found", name))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
@@ -0,0 +1,507 @@
+package requester
+
+import (
+	"bytes"
+	"errors"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	getSingleStyleResponse = "../testdata/styles/single.json"
+	getAllStylesResponse   = "../testdata/styles/multi.json"
+	getNoStylesResponse    = "../testdata/styles/empty.json"
+)
+
+func TestStyleRequester_Get(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getSingleStyleResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/workspaces/"+testdata.Workspace+"/styles/roads", request.URL.String())
+			return mockResponse, nil
+		})
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		style, err := styleRequester.Get("roads")
+		assert.NoError(t, err)
+		assert.NotNil(t, style)
+		assert.Equal(t, "roads", style.Name)
+		assert.Equal(t, testdata.Workspace, style.Workspace.Name)
+		assert.Equal(t, "sld", style.Format)
+		assert.Equal(t, "1.0.0", style.LanguageVersion.Version)
+		assert.Equal(t, "roads.sld", style.Filename)
+	})
+
+	t.Run("200 Ok Global", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(`{"style":{"name":"point","format":"sld","languageVersion":{"version":"1.0.0"},"filename":"default_point.sld"}}`)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/styles/point", request.URL.String())
+			return mockResponse, nil
+		})
+
+		data := testdata.GeoserverInfo(mockClient)
+		data.Workspace = ""
+		styleRequester := &StyleRequester{data: data}
+
+		style, err := styleRequester.Get("point")
+		assert.NoError(t, err)
+		assert.Equal(t, "point", style.Name)
+		assert.Nil(t, style.Workspace)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		style, err := styleRequester.Get("roads")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "style roads not found")
+		assert.Nil(t, style)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		style, err := styleRequester.Get("roads")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, style)
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("{")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		style, err := styleRequester.Get("roads")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unexpected EOF")
+		assert.Nil(t, style)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		style, err := styleRequester.Get("roads")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, style)
+	})
+}
+
+func TestStyleRequester_GetAll(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getAllStylesResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := styleRequester.GetAll()
+		assert.NoError(t, err)
+		assert.NotNil(t, all)
+		assert.Len(t, all.Entries, 2)
+		assert.Equal(t, "roads", all.Entries[0].Name)
+		assert.Equal(t, "rivers", all.Entries[1].Name)
+	})
+
+	t.Run("200 Ok No Styles", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		content, err := testdata.Read(getNoStylesResponse)
+		assert.NoError(t, err)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(content)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := styleRequester.GetAll()
+		assert.NoError(t, err)
+		assert.NotNil(t, all)
+		assert.Empty(t, all.Entries)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := styleRequester.GetAll()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "workspace PLAYGROUND not found")
+		assert.Nil(t, all)
+	})
+
+	t.Run("500 Internal Server Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := styleRequester.GetAll()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 500 from geoserver: some error")
+		assert.Nil(t, all)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		all, err := styleRequester.GetAll()
+		assert.Error(t, err)
+		assert.EqualError(t, err, "client error")
+		assert.Nil(t, all)
+	})
+}
+
+const roadsSLD = `<StyledLayerDescriptor version="1.0.0"><NamedLayer><Name>roads</Name></NamedLayer></StyledLayerDescriptor>`
+
+func TestStyleRequester_GetSLD(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(roadsSLD)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/workspaces/"+testdata.Workspace+"/styles/roads.sld", request.URL.String())
+			assert.Equal(t, "application/vnd.ogc.sld+xml", request.Header.Get("Accept"))
+			return mockResponse, nil
+		})
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		sld, err := styleRequester.GetSLD("roads")
+		assert.NoError(t, err)
+		assert.Equal(t, roadsSLD, string(sld))
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		_, err := styleRequester.GetSLD("roads")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "style roads not found")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		_, err := styleRequester.GetSLD("roads")
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestStyleRequester_Create(t *testing.T) {
+	t.Run("201 Created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("roads")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, request.Method)
+			assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/workspaces/"+testdata.Workspace+"/styles?name=roads", request.URL.String())
+			assert.Equal(t, "application/vnd.ogc.sld+xml", request.Header.Get("Content-Type"))
+
+			body, err := io.ReadAll(request.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, roadsSLD, string(body))
+			return mockResponse, nil
+		})
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := styleRequester.Create("roads", []byte(roadsSLD))
+		assert.NoError(t, err)
+	})
+
+	t.Run("201 Created Global", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("roads")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/styles?name=roads", request.URL.String())
+			return mockResponse, nil
+		})
+
+		data := testdata.GeoserverInfo(mockClient)
+		data.Workspace = ""
+		styleRequester := &StyleRequester{data: data}
+
+		err := styleRequester.Create("roads", []byte(roadsSLD))
+		assert.NoError(t, err)
+	})
+
+	t.Run("403 Forbidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("Style roads already exists.")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := styleRequester.Create("roads", []byte(roadsSLD))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 403 from geoserver: Style roads already exists.")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := styleRequester.Create("roads", []byte(roadsSLD))
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestStyleRequester_Update(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPut, request.Method)
+			assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/workspaces/"+testdata.Workspace+"/styles/roads", request.URL.String())
+			assert.Equal(t, "application/vnd.ogc.sld+xml", request.Header.Get("Content-Type"))
+			return mockResponse, nil
+		})
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := styleRequester.Update("roads", []byte(roadsSLD))
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := styleRequester.Update("roads", []byte(roadsSLD))
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, "style roads not found")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := styleRequester.Update("roads", []byte(roadsSLD))
+		assert.EqualError(t, err, "client error")
+	})
+}
+
+func TestStyleRequester_Delete(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodDelete, request.Method)
+			assert.Equal(t, testdata.GeoserverUrl+"/geoserver/rest/workspaces/"+testdata.Workspace+"/styles/roads?purge=true&recurse=true", request.URL.String())
+			return mockResponse, nil
+		})
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := styleRequester.Delete("roads", true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("403 Forbidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("Can't delete style referenced by existing layers.")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := styleRequester.Delete("roads", false)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 403 from geoserver: Can't delete style referenced by existing layers.")
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := styleRequester.Delete("roads", false)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		styleRequester := &StyleRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		err := styleRequester.Delete("roads", false)
+		assert.EqualError(t, err, "client error")
+	})
+}
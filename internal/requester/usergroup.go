@@ -0,0 +1,106 @@
+package requester
+
+import (
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/pkg/security"
+	"net/http"
+)
+
+// UserGroupRequester manages the users and groups of a user/group service
+type UserGroupRequester struct {
+	data    internal.GeoserverData
+	service string
+}
+
+// NewUserGroupRequester targets the default user/group service when service is empty
+func NewUserGroupRequester(data internal.GeoserverData, service string) UserGroupRequester {
+	return UserGroupRequester{
+		data:    data,
+		service: service,
+	}
+}
+
+func (ugr UserGroupRequester) base() string {
+	if ugr.service == "" {
+		return fmt.Sprintf("%s/geoserver/rest/security/usergroup", ugr.data.Connection.URL)
+	}
+
+	return fmt.Sprintf("%s/geoserver/rest/security/usergroup/service/%s", ugr.data.Connection.URL, ugr.service)
+}
+
+func (ugr UserGroupRequester) notFound() error {
+	if ugr.service == "" {
+		return fmt.Errorf("default user/group service not found")
+	}
+
+	return fmt.Errorf("user/group service %s not found", ugr.service)
+}
+
+func (ugr UserGroupRequester) GetUsers() ([]security.User, error) {
+	var users security.UsersWrapper
+	err := securityRead(ugr.data, ugr.base()+"/users", &users, ugr.notFound())
+	if err != nil {
+		return nil, err
+	}
+
+	return users.Users, nil
+}
+
+func (ugr UserGroupRequester) CreateUser(content []byte) error {
+	return securityWrite(ugr.data, http.MethodPost, ugr.base()+"/users", content, ugr.notFound())
+}
+
+func (ugr UserGroupRequester) UpdateUser(name string, content []byte) error {
+	return securityWrite(ugr.data, http.MethodPut, fmt.Sprintf("%s/user/%s", ugr.base(), name), content, fmt.Errorf("user %s not found", name))
+}
+
+func (ugr UserGroupRequester) DeleteUser(name string) error {
+	return securityWrite(ugr.data, http.MethodDelete, fmt.Sprintf("%s/user/%s", ugr.base(), name), nil, fmt.Errorf("user %s not found", name))
+}
+
+func (ugr UserGroupRequester) GetGroups() ([]string, error) {
+	var groups security.GroupsWrapper
+	err := securityRead(ugr.data, ugr.base()+"/groups", &groups, ugr.notFound())
+	if err != nil {
+		return nil, err
+	}
+
+	return groups.Groups, nil
+}
+
+func (ugr UserGroupRequester) CreateGroup(name string) error {
+	return securityWrite(ugr.data, http.MethodPost, fmt.Sprintf("%s/group/%s", ugr.base(), name), nil, ugr.notFound())
+}
+
+func (ugr UserGroupRequester) DeleteGroup(name string) error {
+	return securityWrite(ugr.data, http.MethodDelete, fmt.Sprintf("%s/group/%s", ugr.base(), name), nil, fmt.Errorf("group %s not found", name))
+}
+
+func (ugr UserGroupRequester) GetUserGroups(user string) ([]string, error) {
+	var groups security.GroupsWrapper
+	err := securityRead(ugr.data, fmt.Sprintf("%s/user/%s/groups", ugr.base(), user), &groups, fmt.Errorf("user %s not found", user))
+	if err != nil {
+		return nil, err
+	}
+
+	return groups.Groups, nil
+}
+
+func (ugr UserGroupRequester) GetGroupUsers(group string) ([]security.User, error) {
+	var users security.UsersWrapper
+	err := securityRead(ugr.data, fmt.Sprintf("%s/group/%s/users", ugr.base(), group), &users, fmt.Errorf("group %s not found", group))
+	if err != nil {
+		return nil, err
+	}
+
+	return users.Users, nil
+}
+
+func (ugr UserGroupRequester) AddUserToGroup(user, group string) error {
+	return securityWrite(ugr.data, http.MethodPost, fmt.Sprintf("%s/user/%s/group/%s", ugr.base(), user, group), nil, fmt.Errorf("user %s or group %s not found", user, group))
+}
+
+func (ugr UserGroupRequester) RemoveUserFromGroup(user, group string) error {
+	return securityWrite(ugr.data, http.MethodDelete, fmt.Sprintf("%s/user/%s/group/%s", ugr.base(), user, group), nil, fmt.Errorf("user %s or group %s not found", user, group))
+}
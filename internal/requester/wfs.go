@@ -0,0 +1,71 @@
+package requester
+
+import (
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type WFSRequester struct {
+	data internal.GeoserverData
+}
+
+func NewWFSRequester(data internal.GeoserverData) WFSRequester {
+	return WFSRequester{
+		data: data,
+	}
+}
+
+// GetFeature requests the features of the type names through WFS 2.0.0 and returns them as GeoJSON
+func (wfsR WFSRequester) GetFeature(typeNames []string, options ...options.GetFeatureOption) ([]byte, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/geoserver/wfs", wfsR.data.Connection.URL))
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	q.Add("service", "WFS")
+	q.Add("version", "2.0.0")
+	q.Add("request", "GetFeature")
+	q.Add("typeNames", strings.Join(typeNames, ","))
+	q.Add("outputFormat", "application/json")
+
+	for _, option := range options {
+		option(&q)
+	}
+
+	u.RawQuery = q.Encode()
+
+	request, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = wfsR.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := wfsR.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	default:
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
@@ -0,0 +1,125 @@
+package requester
+
+import (
+	"errors"
+	mocks "github.com/canghel3/go-geoserver/internal/mock"
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestWFSRequester_GetFeature(t *testing.T) {
+	t.Run("200 Ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(`{"type":"FeatureCollection","features":[]}`)),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, "/geoserver/wfs", request.URL.Path)
+			query := request.URL.Query()
+			assert.Equal(t, "GetFeature", query.Get("request"))
+			assert.Equal(t, "PLAYGROUND:cities", query.Get("typeNames"))
+			assert.Equal(t, "application/json", query.Get("outputFormat"))
+			assert.False(t, query.Has("viewparams"))
+			return mockResponse, nil
+		})
+
+		wfsRequester := &WFSRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		features, err := wfsRequester.GetFeature([]string{"PLAYGROUND:cities"})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"type":"FeatureCollection","features":[]}`, string(features))
+	})
+
+	t.Run("With Options", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some content")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+			query := request.URL.Query()
+			assert.Equal(t, `min_pop:1000;name:a\;b\,c`, query.Get("viewparams"))
+			assert.Equal(t, "country = 'RO'", query.Get("cql_filter"))
+			assert.Equal(t, "10", query.Get("count"))
+			return mockResponse, nil
+		})
+
+		wfsRequester := &WFSRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		_, err := wfsRequester.GetFeature([]string{"PLAYGROUND:cities"},
+			options.GetFeature.ViewParams(map[string]string{
+				"name":    "a;b,c",
+				"min_pop": "1000",
+			}),
+			options.GetFeature.CQLFilter("country = 'RO'"),
+			options.GetFeature.Count(10),
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("400 Bad Request", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("some error")),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		wfsRequester := &WFSRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		_, err := wfsRequester.GetFeature([]string{"PLAYGROUND:cities"})
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+		assert.EqualError(t, err, "received status code 400 from geoserver: some error")
+	})
+
+	t.Run("Invalid Body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockResponse := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(&testdata.ErrorReader{}),
+		}
+
+		mockClient.EXPECT().Do(gomock.Any()).Return(mockResponse, nil)
+
+		wfsRequester := &WFSRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		_, err := wfsRequester.GetFeature([]string{"PLAYGROUND:cities"})
+		assert.EqualError(t, err, "reader error")
+	})
+
+	t.Run("Client Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockClient := mocks.NewMockHTTPClient(ctrl)
+		mockClient.EXPECT().Do(gomock.Any()).Return(nil, errors.New("client error"))
+
+		wfsRequester := &WFSRequester{data: testdata.GeoserverInfo(mockClient)}
+
+		_, err := wfsRequester.GetFeature([]string{"PLAYGROUND:cities"})
+		assert.EqualError(t, err, "client error")
+	})
+}
@@ -32,7 +32,10 @@ func (wmsR WMSRequester) GetCapabilities(version wms.WMSVersion) ([]byte, error)
 		return nil, err
 	}
 
-	request.SetBasicAuth(wmsR.data.Connection.Credentials.Username, wmsR.data.Connection.Credentials.Password)
+	err = wmsR.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
 
 	response, err := wmsR.data.Client.Do(request)
 	if err != nil {
@@ -89,7 +92,10 @@ func (wmsR WMSRequester) GetMap(width, height uint16, layers []string, bbox shar
 		return nil, err
 	}
 
-	request.SetBasicAuth(wmsR.data.Connection.Credentials.Username, wmsR.data.Connection.Credentials.Password)
+	err = wmsR.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
 
 	response, err := wmsR.data.Client.Do(request)
 	if err != nil {
@@ -171,6 +171,31 @@ func TestWMSRequester_GetMap(t *testing.T) {
 			assert.NotNil(t, map_)
 			assert.Equal(t, "some content", string(map_))
 		})
+
+		t.Run("With View Params", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockClient := mocks.NewMockHTTPClient(ctrl)
+			mockResponse := &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader("some content")),
+			}
+
+			mockClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) (*http.Response, error) {
+				assert.Equal(t, `min_pop:1000;name:a\;b\,c`, request.URL.Query().Get("viewparams"))
+				return mockResponse, nil
+			})
+
+			wmsRequester := &WMSRequester{data: testdata.GeoserverInfo(mockClient)}
+
+			map_, err := wmsRequester.GetMap(0, 0, nil, shared.BBOX{}, wms.Version130, wms.PNG, options.GetMap.ViewParams(map[string]string{
+				"name":    "a;b,c",
+				"min_pop": "1000",
+			}))
+			assert.NoError(t, err)
+			assert.Equal(t, "some content", string(map_))
+		})
 	})
 
 	t.Run("500 Internal Server Error", func(t *testing.T) {
@@ -26,7 +26,11 @@ func (wr WorkspaceRequester) Create(content []byte, _default bool) error {
 		return err
 	}
 
-	request.SetBasicAuth(wr.data.Connection.Credentials.Username, wr.data.Connection.Credentials.Password)
+	err = wr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := wr.data.Client.Do(request)
@@ -56,7 +60,11 @@ func (wr WorkspaceRequester) Get(name string) (*workspace.Workspace, error) {
 		return nil, err
 	}
 
-	request.SetBasicAuth(wr.data.Connection.Credentials.Username, wr.data.Connection.Credentials.Password)
+	err = wr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := wr.data.Client.Do(request)
@@ -93,7 +101,11 @@ func (wr WorkspaceRequester) GetAll() ([]workspace.MultiWorkspace, error) {
 		return nil, err
 	}
 
-	request.SetBasicAuth(wr.data.Connection.Credentials.Username, wr.data.Connection.Credentials.Password)
+	err = wr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
 	request.Header.Add("Accept", "application/json")
 
 	response, err := wr.data.Client.Do(request)
@@ -137,7 +149,11 @@ func (wr WorkspaceRequester) Update(content []byte, oldName string) error {
 		return err
 	}
 
-	request.SetBasicAuth(wr.data.Connection.Credentials.Username, wr.data.Connection.Credentials.Password)
+	err = wr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Add("Content-Type", "application/json")
 
 	response, err := wr.data.Client.Do(request)
@@ -168,7 +184,87 @@ func (wr WorkspaceRequester) Delete(name string, recurse bool) error {
 		return err
 	}
 
-	request.SetBasicAuth(wr.data.Connection.Credentials.Username, wr.data.Connection.Credentials.Password)
+	err = wr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	response, err := wr.data.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return customerrors.WrapNotFoundError(fmt.Errorf("workspace %s not found", name))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (wr WorkspaceRequester) GetDefault() (*workspace.Workspace, error) {
+	var target = fmt.Sprintf("%s/geoserver/rest/workspaces/default", wr.data.Connection.URL)
+
+	request, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = wr.data.Authenticate(request)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Accept", "application/json")
+
+	response, err := wr.data.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var wksp workspace.GetSingleWorkspaceWrapper
+		err = json.NewDecoder(response.Body).Decode(&wksp)
+		if err != nil {
+			return nil, err
+		}
+
+		return &wksp.Workspace, nil
+	case http.StatusNotFound:
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("no default workspace set"))
+	default:
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, customerrors.WrapGeoserverError(fmt.Errorf("received status code %d from geoserver: %s", response.StatusCode, string(body)))
+	}
+}
+
+func (wr WorkspaceRequester) SetDefault(content []byte, name string) error {
+	var target = fmt.Sprintf("%s/geoserver/rest/workspaces/default", wr.data.Connection.URL)
+	request, err := http.NewRequest(http.MethodPut, target, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	err = wr.data.Authenticate(request)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Content-Type", "application/json")
 
 	response, err := wr.data.Client.Do(request)
 	if err != nil {
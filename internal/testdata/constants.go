@@ -15,6 +15,7 @@ const (
 	DatastoreDirOfShapefiles   = "SHAPEFILES"
 	DatastoreCSV               = "CSV"
 	DatastoreWebFeatureService = "WEBFEATURESERVICE"
+	DatastoreProperty          = "PROPERTY"
 
 	PostgisHost     = "postgis"
 	PostgisPort     = "5432"
@@ -25,6 +26,12 @@ const (
 
 	DatastoreWFSUrl = "http://geoserver:8080/geoserver/wfs?service=wfs&version=1.3.0&request=GetCapabilities"
 
+	WMSStore     = "CASCADED_WMS"
+	WMSStoreUrl  = "http://geoserver:8080/geoserver/wms?service=WMS&version=1.3.0&request=GetCapabilities"
+	WMSLayer     = "CASCADED_WMS_LAYER"
+	WMTSStore    = "CASCADED_WMTS"
+	WMTSStoreUrl = "http://geoserver:8080/geoserver/gwc/service/wmts?service=WMTS&version=1.0.0&request=GetCapabilities"
+
 	FeatureTypePostgis              = "init"
 	FeatureTypePostgisNativeName    = "init"
 	FeatureTypeGeoPackage           = "buildings"
@@ -53,6 +60,7 @@ const (
 	DirRST              = "rst"
 	DirVRT              = "vrt"
 	DirShapefiles       = "shps"
+	DirProperties       = "properties"
 
 	FileShapefile        = "shp/ne_110m_coastline.shp"
 	FileGeoPackage       = "gpkg/bld_fts_buildingpart.gpkg"
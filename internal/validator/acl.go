@@ -0,0 +1,93 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/security"
+	"net/http"
+	"strings"
+)
+
+var ACL ACLValidator
+
+type ACLValidator struct{}
+
+// LayerKey validates the workspace.layer.permission syntax of data access rules
+func (av ACLValidator) LayerKey(key string) error {
+	parts := strings.Split(key, ".")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return customerrors.WrapInputError(fmt.Errorf("invalid data access rule %s, expected workspace.layer.permission", key))
+	}
+
+	switch security.Permission(parts[2]) {
+	case security.Read, security.Write, security.Administer:
+	default:
+		return customerrors.WrapInputError(fmt.Errorf("invalid permission %s in data access rule %s, expected r, w or a", parts[2], key))
+	}
+
+	if parts[0] == security.Any && parts[1] != security.Any {
+		return customerrors.WrapInputError(fmt.Errorf("invalid data access rule %s, a specific layer requires a specific workspace", key))
+	}
+
+	return nil
+}
+
+// ServiceKey validates the service.method syntax of service access rules
+func (av ACLValidator) ServiceKey(key string) error {
+	parts := strings.Split(key, ".")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return customerrors.WrapInputError(fmt.Errorf("invalid service access rule %s, expected service.method", key))
+	}
+
+	if parts[0] == security.Any && parts[1] != security.Any {
+		return customerrors.WrapInputError(fmt.Errorf("invalid service access rule %s, a specific method requires a specific service", key))
+	}
+
+	return nil
+}
+
+// RESTKey validates the pattern:methods syntax of REST access rules
+func (av ACLValidator) RESTKey(key string) error {
+	index := strings.LastIndex(key, ":")
+	if index <= 0 || index == len(key)-1 {
+		return customerrors.WrapInputError(fmt.Errorf("invalid rest access rule %s, expected pattern:methods", key))
+	}
+
+	if !strings.HasPrefix(key, "/") {
+		return customerrors.WrapInputError(fmt.Errorf("invalid rest access rule %s, the pattern must start with /", key))
+	}
+
+	for _, method := range strings.Split(key[index+1:], ",") {
+		switch method {
+		case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodHead, http.MethodOptions, http.MethodPatch, http.MethodTrace:
+		default:
+			return customerrors.WrapInputError(fmt.Errorf("invalid http method %s in rest access rule %s", method, key))
+		}
+	}
+
+	return nil
+}
+
+func (av ACLValidator) Roles(roles []string) error {
+	if len(roles) == 0 {
+		return customerrors.WrapInputError(errors.New("access rule requires at least one role"))
+	}
+
+	for _, role := range roles {
+		if len(strings.TrimSpace(role)) == 0 || strings.Contains(role, ",") {
+			return customerrors.WrapInputError(fmt.Errorf("invalid role %q in access rule", role))
+		}
+	}
+
+	return nil
+}
+
+func (av ACLValidator) CatalogMode(mode security.CatalogMode) error {
+	switch mode {
+	case security.Hide, security.Middle, security.Challenge:
+		return nil
+	default:
+		return customerrors.WrapInputError(fmt.Errorf("invalid catalog mode %s, expected HIDE, MIDDLE or CHALLENGE", mode))
+	}
+}
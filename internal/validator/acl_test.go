@@ -0,0 +1,270 @@
+package validator
+
+import (
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/security"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestACLValidator_LayerKey(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:    "Layer read rule",
+			key:     "topp.states.r",
+			wantErr: false,
+		},
+		{
+			name:    "Workspace write rule",
+			key:     "topp.*.w",
+			wantErr: false,
+		},
+		{
+			name:    "Global admin rule",
+			key:     "*.*.a",
+			wantErr: false,
+		},
+		{
+			name:         "Missing permission",
+			key:          "topp.states",
+			wantErr:      true,
+			errorMessage: "invalid data access rule topp.states, expected workspace.layer.permission",
+		},
+		{
+			name:         "Empty layer",
+			key:          "topp..r",
+			wantErr:      true,
+			errorMessage: "invalid data access rule topp..r, expected workspace.layer.permission",
+		},
+		{
+			name:         "Unknown permission",
+			key:          "topp.states.x",
+			wantErr:      true,
+			errorMessage: "invalid permission x in data access rule topp.states.x, expected r, w or a",
+		},
+		{
+			name:         "Layer without workspace",
+			key:          "*.states.r",
+			wantErr:      true,
+			errorMessage: "invalid data access rule *.states.r, a specific layer requires a specific workspace",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			av := ACLValidator{}
+			err := av.LayerKey(tt.key)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestACLValidator_ServiceKey(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:    "Operation rule",
+			key:     "wfs.GetFeature",
+			wantErr: false,
+		},
+		{
+			name:    "Service rule",
+			key:     "wms.*",
+			wantErr: false,
+		},
+		{
+			name:         "Missing method",
+			key:          "wfs",
+			wantErr:      true,
+			errorMessage: "invalid service access rule wfs, expected service.method",
+		},
+		{
+			name:         "Method without service",
+			key:          "*.GetMap",
+			wantErr:      true,
+			errorMessage: "invalid service access rule *.GetMap, a specific method requires a specific service",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			av := ACLValidator{}
+			err := av.ServiceKey(tt.key)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestACLValidator_RESTKey(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:    "Single method",
+			key:     "/**:GET",
+			wantErr: false,
+		},
+		{
+			name:    "Multiple methods",
+			key:     "/rest/workspaces/**:POST,DELETE",
+			wantErr: false,
+		},
+		{
+			name:         "Missing methods",
+			key:          "/**",
+			wantErr:      true,
+			errorMessage: "invalid rest access rule /**, expected pattern:methods",
+		},
+		{
+			name:         "Relative pattern",
+			key:          "rest/**:GET",
+			wantErr:      true,
+			errorMessage: "invalid rest access rule rest/**:GET, the pattern must start with /",
+		},
+		{
+			name:         "Unknown method",
+			key:          "/**:FETCH",
+			wantErr:      true,
+			errorMessage: "invalid http method FETCH in rest access rule /**:FETCH",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			av := ACLValidator{}
+			err := av.RESTKey(tt.key)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestACLValidator_Roles(t *testing.T) {
+	tests := []struct {
+		name         string
+		roles        []string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:    "Single role",
+			roles:   []string{"ROLE_TENANT"},
+			wantErr: false,
+		},
+		{
+			name:    "Everyone",
+			roles:   []string{security.Any},
+			wantErr: false,
+		},
+		{
+			name:         "No roles",
+			roles:        nil,
+			wantErr:      true,
+			errorMessage: "access rule requires at least one role",
+		},
+		{
+			name:         "Comma in role",
+			roles:        []string{"A,B"},
+			wantErr:      true,
+			errorMessage: `invalid role "A,B" in access rule`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			av := ACLValidator{}
+			err := av.Roles(tt.roles)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestACLValidator_CatalogMode(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         security.CatalogMode
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:    "Hide",
+			mode:    security.Hide,
+			wantErr: false,
+		},
+		{
+			name:    "Challenge",
+			mode:    security.Challenge,
+			wantErr: false,
+		},
+		{
+			name:         "Unknown mode",
+			mode:         "SHOW",
+			wantErr:      true,
+			errorMessage: "invalid catalog mode SHOW, expected HIDE, MIDDLE or CHALLENGE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			av := ACLValidator{}
+			err := av.CatalogMode(tt.mode)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
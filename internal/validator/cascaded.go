@@ -0,0 +1,29 @@
+package validator
+
+import (
+	"errors"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"net/url"
+	"strings"
+)
+
+var CascadedStore CascadedStoreValidator
+
+type CascadedStoreValidator struct{}
+
+func (csv CascadedStoreValidator) CapabilitiesURL(u string) error {
+	if len(strings.TrimSpace(u)) == 0 {
+		return customerrors.WrapInputError(errors.New("empty capabilities url"))
+	}
+
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return customerrors.WrapInputError(err)
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return customerrors.WrapInputError(errors.New("capabilities url must use http or https"))
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package validator
+
+import (
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCascadedStoreValidator_CapabilitiesURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		url          string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:    "Valid capabilities URL",
+			url:     "https://basemaps.example.com/wms?service=WMS&request=GetCapabilities",
+			wantErr: false,
+		},
+		{
+			name:         "Empty capabilities URL",
+			url:          "",
+			wantErr:      true,
+			errorMessage: "empty capabilities url",
+		},
+		{
+			name:         "Unsupported scheme",
+			url:          "file:///tmp/capabilities.xml",
+			wantErr:      true,
+			errorMessage: "capabilities url must use http or https",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			csv := CascadedStoreValidator{}
+			err := csv.CapabilitiesURL(tt.url)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -20,6 +20,14 @@ func (dsv DataStoreValidator) PostGIS(name string) error {
 	return validateAlphaNumerical(name)
 }
 
+func (dsv DataStoreValidator) PostGISJNDI(reference string) error {
+	if len(strings.TrimSpace(reference)) == 0 {
+		return customerrors.WrapInputError(errors.New("empty jndi reference name"))
+	}
+
+	return nil
+}
+
 func (dsv DataStoreValidator) GeoPackage(url string) error {
 	if len(url) == 0 {
 		return customerrors.WrapInputError(errors.New("empty geopackage url"))
@@ -61,6 +69,51 @@ func (dsv DataStoreValidator) CSV(url string) error {
 	return nil
 }
 
+// JDBC validates the connection parameters shared by the Oracle, MySQL and SQL Server stores.
+func (dsv DataStoreValidator) JDBC(host, database string) error {
+	if len(strings.TrimSpace(host)) == 0 {
+		return customerrors.WrapInputError(errors.New("empty database host"))
+	}
+
+	if len(strings.TrimSpace(database)) == 0 {
+		return customerrors.WrapInputError(errors.New("empty database name"))
+	}
+
+	return nil
+}
+
+func (dsv DataStoreValidator) H2(database string) error {
+	if len(strings.TrimSpace(database)) == 0 {
+		return customerrors.WrapInputError(errors.New("empty h2 database path"))
+	}
+
+	return nil
+}
+
+func (dsv DataStoreValidator) PropertyDirectory(dir string) error {
+	if len(strings.TrimSpace(dir)) == 0 {
+		return customerrors.WrapInputError(errors.New("empty directory path"))
+	}
+
+	return nil
+}
+
+func (dsv DataStoreValidator) AppSchema(mappingFile string) error {
+	if len(strings.TrimSpace(mappingFile)) == 0 {
+		return customerrors.WrapInputError(errors.New("empty mapping file path"))
+	}
+
+	return nil
+}
+
+func (dsv DataStoreValidator) Custom(params map[string]string) error {
+	if len(params) == 0 {
+		return customerrors.WrapInputError(errors.New("empty connection parameters"))
+	}
+
+	return nil
+}
+
 func (dsv DataStoreValidator) WebFeatureService(u string) error {
 	if len(strings.TrimSpace(u)) == 0 {
 		return customerrors.WrapInputError(errors.New("empty wfs url"))
@@ -51,6 +51,50 @@ func TestDataStoreValidator_PostGIS(t *testing.T) {
 	}
 }
 
+func TestDataStoreValidator_PostGISJNDI(t *testing.T) {
+	tests := []struct {
+		name         string
+		reference    string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:      "Valid JNDI reference name",
+			reference: "java:comp/env/jdbc/postgis",
+			wantErr:   false,
+		},
+		{
+			name:         "Empty JNDI reference name",
+			reference:    "",
+			wantErr:      true,
+			errorMessage: "empty jndi reference name",
+		},
+		{
+			name:         "Blank JNDI reference name",
+			reference:    "   ",
+			wantErr:      true,
+			errorMessage: "empty jndi reference name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsv := DataStoreValidator{}
+			err := dsv.PostGISJNDI(tt.reference)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestDataStoreValidator_GeoPackage(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -252,3 +296,203 @@ func TestDataStoreValidator_WebFeatureService(t *testing.T) {
 		})
 	}
 }
+
+func TestDataStoreValidator_JDBC(t *testing.T) {
+	tests := []struct {
+		name         string
+		host         string
+		database     string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:     "Valid host and database",
+			host:     "localhost",
+			database: "vectors",
+			wantErr:  false,
+		},
+		{
+			name:         "Empty host",
+			host:         "",
+			database:     "vectors",
+			wantErr:      true,
+			errorMessage: "empty database host",
+		},
+		{
+			name:         "Empty database",
+			host:         "localhost",
+			database:     " ",
+			wantErr:      true,
+			errorMessage: "empty database name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsv := DataStoreValidator{}
+			err := dsv.JDBC(tt.host, tt.database)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDataStoreValidator_H2(t *testing.T) {
+	tests := []struct {
+		name         string
+		database     string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:     "Valid database path",
+			database: "/data/h2/vectors",
+			wantErr:  false,
+		},
+		{
+			name:         "Empty database path",
+			database:     "",
+			wantErr:      true,
+			errorMessage: "empty h2 database path",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsv := DataStoreValidator{}
+			err := dsv.H2(tt.database)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDataStoreValidator_PropertyDirectory(t *testing.T) {
+	tests := []struct {
+		name         string
+		dir          string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:    "Valid directory",
+			dir:     "/path/to/properties",
+			wantErr: false,
+		},
+		{
+			name:         "Empty directory",
+			dir:          "",
+			wantErr:      true,
+			errorMessage: "empty directory path",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsv := DataStoreValidator{}
+			err := dsv.PropertyDirectory(tt.dir)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDataStoreValidator_AppSchema(t *testing.T) {
+	tests := []struct {
+		name         string
+		mappingFile  string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:        "Valid mapping file",
+			mappingFile: "/path/to/mapping.xml",
+			wantErr:     false,
+		},
+		{
+			name:         "Empty mapping file",
+			mappingFile:  " ",
+			wantErr:      true,
+			errorMessage: "empty mapping file path",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsv := DataStoreValidator{}
+			err := dsv.AppSchema(tt.mappingFile)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDataStoreValidator_Custom(t *testing.T) {
+	tests := []struct {
+		name         string
+		params       map[string]string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:    "Valid parameters",
+			params:  map[string]string{"directory": "file:/data"},
+			wantErr: false,
+		},
+		{
+			name:         "Nil parameters",
+			params:       nil,
+			wantErr:      true,
+			errorMessage: "empty connection parameters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsv := DataStoreValidator{}
+			err := dsv.Custom(tt.params)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
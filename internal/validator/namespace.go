@@ -0,0 +1,30 @@
+package validator
+
+import (
+	"errors"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"net/url"
+	"strings"
+)
+
+var Namespace NamespaceValidator
+
+type NamespaceValidator struct{}
+
+// URI requires an absolute uri. GeoServer does not resolve it, so any scheme (http, urn, ...) is accepted
+func (nv NamespaceValidator) URI(uri string) error {
+	if len(strings.TrimSpace(uri)) == 0 {
+		return customerrors.WrapInputError(errors.New("empty namespace uri"))
+	}
+
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return customerrors.WrapInputError(err)
+	}
+
+	if !parsed.IsAbs() {
+		return customerrors.WrapInputError(errors.New("namespace uri must be absolute"))
+	}
+
+	return nil
+}
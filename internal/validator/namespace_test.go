@@ -0,0 +1,56 @@
+package validator
+
+import (
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNamespaceValidator_URI(t *testing.T) {
+	tests := []struct {
+		name         string
+		uri          string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:    "Valid http URI",
+			uri:     "http://example.com/playground",
+			wantErr: false,
+		},
+		{
+			name:    "Valid URN",
+			uri:     "urn:example:playground",
+			wantErr: false,
+		},
+		{
+			name:         "Empty URI",
+			uri:          " ",
+			wantErr:      true,
+			errorMessage: "empty namespace uri",
+		},
+		{
+			name:         "Relative URI",
+			uri:          "playground",
+			wantErr:      true,
+			errorMessage: "namespace uri must be absolute",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nv := NamespaceValidator{}
+			err := nv.URI(tt.uri)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package validator
+
+import (
+	"errors"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"strings"
+)
+
+var Security SecurityValidator
+
+type SecurityValidator struct{}
+
+// Name validates user, group and role names. These are more permissive than catalog names (e.g. e-mail addresses
+// are common user names), but they are part of the request path, so path separators are rejected.
+func (sv SecurityValidator) Name(name string) error {
+	if len(strings.TrimSpace(name)) == 0 {
+		return customerrors.WrapInputError(errors.New("empty name"))
+	}
+
+	if strings.ContainsAny(name, "/\\?#") {
+		return customerrors.WrapInputError(errors.New("name cannot contain /, \\, ? or #"))
+	}
+
+	return nil
+}
+
+func (sv SecurityValidator) Password(password string) error {
+	if len(password) == 0 {
+		return customerrors.WrapInputError(errors.New("empty password"))
+	}
+
+	return nil
+}
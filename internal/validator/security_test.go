@@ -0,0 +1,100 @@
+package validator
+
+import (
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSecurityValidator_Name(t *testing.T) {
+	tests := []struct {
+		name         string
+		securityName string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:         "Valid role name",
+			securityName: "ROLE_TENANT_A",
+			wantErr:      false,
+		},
+		{
+			name:         "Valid e-mail user name",
+			securityName: "tenant.a@example.com",
+			wantErr:      false,
+		},
+		{
+			name:         "Empty name",
+			securityName: " ",
+			wantErr:      true,
+			errorMessage: "empty name",
+		},
+		{
+			name:         "Path separator",
+			securityName: "tenant/a",
+			wantErr:      true,
+			errorMessage: "name cannot contain /, \\, ? or #",
+		},
+		{
+			name:         "Query separator",
+			securityName: "tenant?a",
+			wantErr:      true,
+			errorMessage: "name cannot contain /, \\, ? or #",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := SecurityValidator{}
+			err := sv.Name(tt.securityName)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSecurityValidator_Password(t *testing.T) {
+	tests := []struct {
+		name         string
+		password     string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:     "Valid password",
+			password: "s3cret",
+			wantErr:  false,
+		},
+		{
+			name:         "Empty password",
+			password:     "",
+			wantErr:      true,
+			errorMessage: "empty password",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := SecurityValidator{}
+			err := sv.Password(tt.password)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
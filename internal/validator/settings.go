@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"errors"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"net/url"
+	"strings"
+)
+
+var Settings SettingsValidator
+
+type SettingsValidator struct{}
+
+// ProxyBaseURL accepts an empty url, which disables the proxy base url, and urls containing
+// header placeholders such as ${X-Forwarded-Host}, which are resolved by GeoServer on each request.
+func (sv SettingsValidator) ProxyBaseURL(u string) error {
+	if len(strings.TrimSpace(u)) == 0 || strings.Contains(u, "${") {
+		return nil
+	}
+
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return customerrors.WrapInputError(err)
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return customerrors.WrapInputError(errors.New("proxy base url must use http or https"))
+	}
+
+	if parsed.Host == "" {
+		return customerrors.WrapInputError(errors.New("proxy base url must contain a host"))
+	}
+
+	return nil
+}
+
+func (sv SettingsValidator) NumDecimals(n int) error {
+	if n < 0 {
+		return customerrors.WrapInputError(errors.New("number of decimals cannot be negative"))
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package validator
+
+import (
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSettingsValidator_ProxyBaseURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		url          string
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:    "Valid proxy base URL",
+			url:     "https://maps.example.com/geoserver",
+			wantErr: false,
+		},
+		{
+			name:    "Empty proxy base URL",
+			url:     "",
+			wantErr: false,
+		},
+		{
+			name:    "Header placeholders",
+			url:     "${X-Forwarded-Proto}://${X-Forwarded-Host}/geoserver",
+			wantErr: false,
+		},
+		{
+			name:         "Unsupported scheme",
+			url:          "ftp://maps.example.com/geoserver",
+			wantErr:      true,
+			errorMessage: "proxy base url must use http or https",
+		},
+		{
+			name:         "Missing host",
+			url:          "https:///geoserver",
+			wantErr:      true,
+			errorMessage: "proxy base url must contain a host",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := SettingsValidator{}
+			err := sv.ProxyBaseURL(tt.url)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSettingsValidator_NumDecimals(t *testing.T) {
+	tests := []struct {
+		name         string
+		numDecimals  int
+		wantErr      bool
+		errorMessage string
+	}{
+		{
+			name:        "Zero decimals",
+			numDecimals: 0,
+			wantErr:     false,
+		},
+		{
+			name:        "Positive decimals",
+			numDecimals: 8,
+			wantErr:     false,
+		},
+		{
+			name:         "Negative decimals",
+			numDecimals:  -1,
+			wantErr:      true,
+			errorMessage: "number of decimals cannot be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := SettingsValidator{}
+			err := sv.NumDecimals(tt.numDecimals)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+
+				var inputError *customerrors.InputError
+				assert.ErrorAs(t, err, &inputError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package actions
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/canghel3/go-geoserver/internal"
 	"github.com/canghel3/go-geoserver/internal/requester"
 	"github.com/canghel3/go-geoserver/pkg/about"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
 )
 
 type About struct {
@@ -31,3 +35,25 @@ func (a About) Status() (*about.Status, error) {
 func (a About) SystemStatus() (*about.Metrics, error) {
 	return a.requester.SystemStatus()
 }
+
+// WaitUntilHealthy polls the status of the modules every interval until GeoServer answers,
+// failing once timeout has elapsed.
+func (a About) WaitUntilHealthy(timeout, interval time.Duration) error {
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		_, err := a.requester.Status()
+		if err == nil {
+			return nil
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return customerrors.WrapGeoserverError(fmt.Errorf("geoserver did not become healthy within %s: %w", timeout, err))
+		}
+
+		time.Sleep(interval)
+	}
+}
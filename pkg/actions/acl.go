@@ -0,0 +1,118 @@
+package actions
+
+import (
+	"encoding/json"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/requester"
+	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/security"
+)
+
+type ACL struct {
+	data internal.GeoserverData
+}
+
+// ACL manages the data, service and REST access rules and the catalog mode
+func (s Security) ACL() ACL {
+	return ACL{
+		data: s.data.Clone(),
+	}
+}
+
+// Layers manages the data access rules, with workspace.layer.permission keys
+func (a ACL) Layers() AccessRules {
+	return AccessRules{
+		requester: requester.NewACLRequester(a.data.Clone(), requester.ACLLayers),
+		validate:  validator.ACL.LayerKey,
+	}
+}
+
+// Services manages the service access rules, with service.method keys
+func (a ACL) Services() AccessRules {
+	return AccessRules{
+		requester: requester.NewACLRequester(a.data.Clone(), requester.ACLServices),
+		validate:  validator.ACL.ServiceKey,
+	}
+}
+
+// REST manages the REST access rules, with pattern:methods keys
+func (a ACL) REST() AccessRules {
+	return AccessRules{
+		requester: requester.NewACLRequester(a.data.Clone(), requester.ACLREST),
+		validate:  validator.ACL.RESTKey,
+	}
+}
+
+func (a ACL) CatalogMode() (*security.CatalogMode, error) {
+	return requester.NewACLRequester(a.data.Clone(), "").GetCatalogMode()
+}
+
+func (a ACL) SetCatalogMode(mode security.CatalogMode) error {
+	if err := validator.ACL.CatalogMode(mode); err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(security.CatalogModeWrapper{Mode: mode})
+	if err != nil {
+		return err
+	}
+
+	return requester.NewACLRequester(a.data.Clone(), "").SetCatalogMode(content)
+}
+
+type AccessRules struct {
+	requester requester.ACLRequester
+	validate  func(key string) error
+}
+
+// GetAll retrieves the rules sorted by key
+func (ar AccessRules) GetAll() ([]security.AccessRule, error) {
+	rules, err := ar.requester.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return security.FromRules(rules), nil
+}
+
+// Add new rules. GeoServer rejects the request if one of the rules already exists.
+func (ar AccessRules) Add(rules ...security.AccessRule) error {
+	content, err := ar.content(rules)
+	if err != nil {
+		return err
+	}
+
+	return ar.requester.Add(content)
+}
+
+// Update the roles of existing rules. GeoServer rejects the request if one of the rules does not exist.
+func (ar AccessRules) Update(rules ...security.AccessRule) error {
+	content, err := ar.content(rules)
+	if err != nil {
+		return err
+	}
+
+	return ar.requester.Update(content)
+}
+
+func (ar AccessRules) Delete(key string) error {
+	if err := ar.validate(key); err != nil {
+		return err
+	}
+
+	return ar.requester.Delete(key)
+}
+
+func (ar AccessRules) content(rules []security.AccessRule) ([]byte, error) {
+	for _, rule := range rules {
+		if err := ar.validate(rule.Key); err != nil {
+			return nil, err
+		}
+
+		if err := validator.ACL.Roles(rule.Roles); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(security.Rules(rules...))
+}
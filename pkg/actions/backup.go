@@ -0,0 +1,158 @@
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/internal/requester"
+	"github.com/canghel3/go-geoserver/pkg/backup"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/options"
+)
+
+// Backup requires the backup-restore extension to be installed.
+type Backup struct {
+	data      internal.GeoserverData
+	requester requester.BackupRequester
+}
+
+func NewBackupActions(data internal.GeoserverData) Backup {
+	return Backup{
+		data:      data,
+		requester: requester.NewBackupRequester(data, requester.BackupKind),
+	}
+}
+
+// Start launches a backup of the configuration to archiveFile, a zip archive on the GeoServer host.
+// The backup runs asynchronously, use Wait to block until it is done.
+func (b Backup) Start(archiveFile string, opts ...options.BackupOption) (*backup.Execution, error) {
+	if err := validateArchiveFile(archiveFile); err != nil {
+		return nil, err
+	}
+
+	execution := models.BackupExecution{ArchiveFile: archiveFile}
+	for _, opt := range opts {
+		opt(&execution)
+	}
+
+	content, err := json.Marshal(models.BackupWrapper{Backup: execution})
+	if err != nil {
+		return nil, err
+	}
+
+	return b.requester.Start(content)
+}
+
+// Get retrieves the status and progress of a backup
+func (b Backup) Get(id int64) (*backup.Execution, error) {
+	return b.requester.Get(id)
+}
+
+// Abort stops a running backup
+func (b Backup) Abort(id int64) error {
+	return b.requester.Abort(id)
+}
+
+// Wait polls the backup every interval until it is done. It returns a *backup.ExecutionError if the backup did not
+// complete and a customerrors.GeoserverError if it is still running after timeout.
+func (b Backup) Wait(id int64, timeout, interval time.Duration) (*backup.Execution, error) {
+	return waitForExecution(b.requester, id, timeout, interval)
+}
+
+// Download retrieves the zip archive written by a completed backup
+func (b Backup) Download(id int64) ([]byte, error) {
+	return b.requester.Download(id)
+}
+
+// Restore manages the restores of backup archives.
+func (b Backup) Restore() Restore {
+	return Restore{
+		requester: requester.NewBackupRequester(b.data, requester.RestoreKind),
+	}
+}
+
+type Restore struct {
+	requester requester.BackupRequester
+}
+
+// Start launches a restore of archiveFile, a zip archive on the GeoServer host.
+// Use options.Restore.DryRun to only check the archive against the current configuration.
+func (r Restore) Start(archiveFile string, opts ...options.RestoreOption) (*backup.Execution, error) {
+	if err := validateArchiveFile(archiveFile); err != nil {
+		return nil, err
+	}
+
+	execution := models.BackupExecution{ArchiveFile: archiveFile}
+	for _, opt := range opts {
+		opt(&execution)
+	}
+
+	content, err := json.Marshal(models.RestoreWrapper{Restore: execution})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.requester.Start(content)
+}
+
+// Get retrieves the status and progress of a restore
+func (r Restore) Get(id int64) (*backup.Execution, error) {
+	return r.requester.Get(id)
+}
+
+// Abort stops a running restore
+func (r Restore) Abort(id int64) error {
+	return r.requester.Abort(id)
+}
+
+// Wait polls the restore every interval until it is done. It returns a *backup.ExecutionError if the restore did not
+// complete and a customerrors.GeoserverError if it is still running after timeout.
+func (r Restore) Wait(id int64, timeout, interval time.Duration) (*backup.Execution, error) {
+	return waitForExecution(r.requester, id, timeout, interval)
+}
+
+// Upload stores a zip archive at path, relative to the data directory, so that it can be restored.
+// Start expects the absolute path of the archive on the GeoServer host, i.e. the data directory joined with path.
+func (r Restore) Upload(path string, archive []byte) error {
+	if err := validateArchiveFile(path); err != nil {
+		return err
+	}
+
+	return r.requester.Upload(path, archive)
+}
+
+func waitForExecution(br requester.BackupRequester, id int64, timeout, interval time.Duration) (*backup.Execution, error) {
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		execution, err := br.Get(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if execution.Execution.Status.Done() {
+			return execution, execution.Err()
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return execution, customerrors.WrapGeoserverError(fmt.Errorf("execution %d still %s after %s (%s)", id, execution.Execution.Status, timeout, execution.Execution.Progress))
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+func validateArchiveFile(archiveFile string) error {
+	if !strings.HasSuffix(strings.ToLower(archiveFile), ".zip") {
+		return customerrors.WrapInputError(fmt.Errorf("archive file %s must be a zip archive", archiveFile))
+	}
+
+	return nil
+}
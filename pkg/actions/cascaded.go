@@ -0,0 +1,162 @@
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/internal/requester"
+	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/cascaded"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"strings"
+)
+
+// CascadedStores manages the stores of a workspace cascading remote WMS or WMTS servers, depending on whether they
+// were obtained with WMSStores or WMTSStores.
+type CascadedStores struct {
+	kind      requester.CascadedKind
+	data      internal.GeoserverData
+	requester requester.CascadedStoreRequester
+}
+
+func newCascadedStoresActions(info internal.GeoserverData, kind requester.CascadedKind) CascadedStores {
+	return CascadedStores{
+		kind:      kind,
+		data:      info,
+		requester: requester.NewCascadedStoreRequester(info, kind),
+	}
+}
+
+func (cs CascadedStores) Use(name string) CascadedLayers {
+	return newCascadedLayers(name, cs.data.Clone(), cs.kind)
+}
+
+// Create a store cascading the remote server described by the capabilities URL.
+func (cs CascadedStores) Create(name, capabilitiesURL string, options ...options.CascadedStoreOption) error {
+	if err := validator.Name(name); err != nil {
+		return err
+	}
+
+	if err := validator.CascadedStore.CapabilitiesURL(capabilitiesURL); err != nil {
+		return err
+	}
+
+	store := models.CascadedStore{
+		Name:            name,
+		Type:            strings.ToUpper(string(cs.kind)),
+		Enabled:         true,
+		CapabilitiesURL: capabilitiesURL,
+	}
+
+	for _, option := range options {
+		option(&store)
+	}
+
+	content, err := json.Marshal(map[string]models.CascadedStore{cs.kind.StoreKey(): store})
+	if err != nil {
+		return err
+	}
+
+	return cs.requester.Create(content)
+}
+
+func (cs CascadedStores) Get(name string) (*cascaded.Store, error) {
+	return cs.requester.Get(name)
+}
+
+func (cs CascadedStores) GetAll() (*cascaded.Stores, error) {
+	return cs.requester.GetAll()
+}
+
+func (cs CascadedStores) Update(name string, store cascaded.Store) error {
+	if err := validator.Name(name); err != nil {
+		return err
+	}
+
+	if err := validator.Name(store.Name); err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(map[string]cascaded.Store{cs.kind.StoreKey(): store})
+	if err != nil {
+		return err
+	}
+
+	return cs.requester.Update(name, content)
+}
+
+func (cs CascadedStores) Delete(name string, recurse bool) error {
+	return cs.requester.Delete(name, recurse)
+}
+
+// CascadedLayers manages the layers published from a WMS or WMTS store.
+type CascadedLayers struct {
+	kind      requester.CascadedKind
+	store     string
+	data      internal.GeoserverData
+	requester requester.CascadedLayerRequester
+}
+
+func newCascadedLayers(store string, info internal.GeoserverData, kind requester.CascadedKind) CascadedLayers {
+	return CascadedLayers{
+		kind:      kind,
+		store:     store,
+		data:      info,
+		requester: requester.NewCascadedLayerRequester(info, kind),
+	}
+}
+
+// Publish a layer of the remote server. Use cascaded.NewLayer to build the layer.
+func (cl CascadedLayers) Publish(layer models.CascadedLayer) error {
+	if err := validator.Name(layer.Name); err != nil {
+		return err
+	}
+
+	layer.Namespace = models.Namespace{
+		Name: cl.data.Workspace,
+		Href: fmt.Sprintf("%s/geoserver/rest/workspaces/%s.json", cl.data.Connection.URL, cl.data.Workspace),
+	}
+
+	layer.Store = models.Store{
+		Class: cl.kind.StoreKey(),
+		Name:  fmt.Sprintf("%s:%s", cl.data.Workspace, cl.store),
+		Href:  fmt.Sprintf("%s/geoserver/rest/workspaces/%s/%s/%s.json", cl.data.Connection.URL, cl.data.Workspace, cl.kind.StoresPath(), cl.store),
+	}
+
+	content, err := json.Marshal(map[string]models.CascadedLayer{cl.kind.LayerKey(): layer})
+	if err != nil {
+		return err
+	}
+
+	return cl.requester.Create(cl.store, content)
+}
+
+func (cl CascadedLayers) Get(name string) (*cascaded.Layer, error) {
+	return cl.requester.Get(cl.store, name)
+}
+
+func (cl CascadedLayers) GetAll() (*cascaded.Layers, error) {
+	return cl.requester.GetAll(cl.store)
+}
+
+func (cl CascadedLayers) Update(name string, layer cascaded.Layer) error {
+	if err := validator.Name(name); err != nil {
+		return err
+	}
+
+	if err := validator.Name(layer.Name); err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(map[string]cascaded.Layer{cl.kind.LayerKey(): layer})
+	if err != nil {
+		return err
+	}
+
+	return cl.requester.Update(cl.store, name, content)
+}
+
+func (cl CascadedLayers) Delete(name string, recurse bool) error {
+	return cl.requester.Delete(cl.store, name, recurse)
+}
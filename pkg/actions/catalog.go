@@ -0,0 +1,55 @@
+package actions
+
+import (
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/internal/requester"
+	"github.com/canghel3/go-geoserver/pkg/options"
+)
+
+type Catalog struct {
+	data      internal.GeoserverData
+	requester requester.CatalogRequester
+}
+
+func NewCatalogActions(data internal.GeoserverData) Catalog {
+	return Catalog{
+		data:      data,
+		requester: requester.NewCatalogRequester(data),
+	}
+}
+
+// Reload rereads the whole catalog and configuration from the data directory.
+// GeoServer may be unresponsive while reloading, use options.Catalog.WaitUntilHealthy to block until it answers again.
+func (c Catalog) Reload(opts ...options.CatalogOption) error {
+	err := c.requester.Reload()
+	if err != nil {
+		return err
+	}
+
+	return c.wait(opts...)
+}
+
+// Reset clears the caches of all stores, feature types and raster readers without rereading the configuration.
+// Use FeatureTypes().Reset and Coverages().Reset to reset a single resource.
+func (c Catalog) Reset(opts ...options.CatalogOption) error {
+	err := c.requester.Reset()
+	if err != nil {
+		return err
+	}
+
+	return c.wait(opts...)
+}
+
+func (c Catalog) wait(opts ...options.CatalogOption) error {
+	var o models.CatalogOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if !o.Wait {
+		return nil
+	}
+
+	return NewAboutAction(c.data).WaitUntilHealthy(o.Timeout, o.Interval)
+}
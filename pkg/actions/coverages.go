@@ -24,11 +24,17 @@ func newCoverages(store string, data internal.GeoserverData) Coverages {
 	}
 }
 
+// Publish the coverage, enabled unless options.Coverage.Enabled(false) was given
 func (c Coverages) Publish(coverage models.Coverage) error {
 	if err := validator.Name(coverage.Name); err != nil {
 		return err
 	}
 
+	if coverage.Enabled == nil {
+		enabled := true
+		coverage.Enabled = &enabled
+	}
+
 	coverage.Namespace = models.NamespaceDetails{
 		Href: fmt.Sprintf("%s/geoserver/rest/workspaces/%s.json", c.data.Connection.URL, c.data.Workspace),
 		Name: c.data.Workspace,
@@ -56,6 +62,7 @@ func (c Coverages) GetAll() (*coverages.Coverages, error) {
 	return c.requester.GetAll(c.store)
 }
 
+// Update the coverage. Whether it is enabled is left as is unless options.Coverage.Enabled was given.
 func (c Coverages) Update(name string, coverage models.Coverage) error {
 	if err := validator.Name(name); err != nil {
 		return err
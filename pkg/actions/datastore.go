@@ -7,10 +7,16 @@ import (
 	"github.com/canghel3/go-geoserver/internal/models"
 	"github.com/canghel3/go-geoserver/internal/requester"
 	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
 	"github.com/canghel3/go-geoserver/pkg/datastores"
+	"github.com/canghel3/go-geoserver/pkg/datastores/h2"
+	"github.com/canghel3/go-geoserver/pkg/datastores/mysql"
+	"github.com/canghel3/go-geoserver/pkg/datastores/oracle"
 	"github.com/canghel3/go-geoserver/pkg/datastores/postgis"
+	"github.com/canghel3/go-geoserver/pkg/datastores/sqlserver"
 	"github.com/canghel3/go-geoserver/pkg/formats"
 	"github.com/canghel3/go-geoserver/pkg/options"
+	"sort"
 	"strings"
 )
 
@@ -79,6 +85,62 @@ func (ds DataStores) Update(name string, store datastores.DataStore) error {
 	return ds.requester.Update(name, content)
 }
 
+// UpdateConnectionParameters changes only the given connection parameters of the data store
+func (ds DataStores) UpdateConnectionParameters(name string, params datastores.ConnectionParams) error {
+	if err := validator.Name(name); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	data := models.DataStoreConnectionUpdate{
+		Name: name,
+		ConnectionParameters: models.ConnectionParameters{
+			Entry: make([]models.ConnectionParameter, 0, len(params)),
+		},
+	}
+
+	for _, key := range keys {
+		data.ConnectionParameters.Entry = append(data.ConnectionParameters.Entry, models.ConnectionParameter{Key: key, Value: params[key]})
+	}
+
+	content, err := json.Marshal(models.DataStoreConnectionUpdateWrapper{DataStore: data})
+	if err != nil {
+		return err
+	}
+
+	return ds.requester.Update(name, content)
+}
+
+// UpdatePassword changes only the password of the data store. The password parameter is detected from the
+// current connection parameters: passwd for the JDBC stores (PostGIS, Oracle, ...) and
+// WFSDataStoreFactory:PASSWORD for cascaded WFS stores.
+func (ds DataStores) UpdatePassword(name, password string) error {
+	if err := validator.Name(name); err != nil {
+		return err
+	}
+
+	store, err := ds.requester.Get(name)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range passwordParameters {
+		if _, ok := store.ConnectionParameters.Get(key); ok {
+			return ds.UpdateConnectionParameters(name, datastores.ConnectionParams{key: password})
+		}
+	}
+
+	return customerrors.WrapInputError(fmt.Errorf("data store %s has no password connection parameter", name))
+}
+
+// passwordParameters are the connection parameters holding the password of the supported data stores
+var passwordParameters = []string{"passwd", "WFSDataStoreFactory:PASSWORD"}
+
 func (ds DataStores) Delete(name string, recurse bool) error {
 	return ds.requester.Delete(name, recurse)
 }
@@ -103,27 +165,39 @@ func (dsl DataStoreList) PostGIS(name string, connectionParams postgis.Connectio
 		"dbtype":   string(formats.PostGIS),
 	}
 
+	if !validator.Empty(connectionParams.SSL) {
+		cp["SSL mode"] = strings.ToUpper(connectionParams.SSL)
+	}
+
 	for _, option := range options {
 		option(&cp)
 	}
 
-	data := datastores.GenericDataStoreCreationWrapper{
-		DataStore: datastores.GenericDataStoreCreationModel{
-			Name:                       name,
-			Description:                dsl.options.Description,
-			DisableOnConnectionFailure: dsl.options.AutoDisableOnConnFailure,
-			ConnectionParameters: datastores.ConnectionParameters{
-				Entry: cp.ToDatastoreEntries(),
-			},
-		},
+	return dsl.create(name, "", cp)
+}
+
+// PostGISJNDI creates a PostGIS store which uses a connection pool provided by the container through JNDI.
+func (dsl DataStoreList) PostGISJNDI(name string, jndiReferenceName string, options ...options.PostGISOption) error {
+	err := validator.Name(name)
+	if err != nil {
+		return err
 	}
 
-	content, err := json.Marshal(data)
+	err = validator.DataStore.PostGISJNDI(jndiReferenceName)
 	if err != nil {
 		return err
 	}
 
-	return dsl.requester.Create(content)
+	cp := datastores.ConnectionParams{
+		"jndiReferenceName": jndiReferenceName,
+		"dbtype":            string(formats.PostGIS),
+	}
+
+	for _, option := range options {
+		option(&cp)
+	}
+
+	return dsl.create(name, "", cp)
 }
 
 func (dsl DataStoreList) GeoPackage(name string, filepath string, options ...options.GeoPackageOptions) error {
@@ -153,23 +227,7 @@ func (dsl DataStoreList) GeoPackage(name string, filepath string, options ...opt
 		option(&cp)
 	}
 
-	data := datastores.GenericDataStoreCreationWrapper{
-		DataStore: datastores.GenericDataStoreCreationModel{
-			Name:                       name,
-			Description:                dsl.options.Description,
-			DisableOnConnectionFailure: dsl.options.AutoDisableOnConnFailure,
-			ConnectionParameters: datastores.ConnectionParameters{
-				Entry: cp.ToDatastoreEntries(),
-			},
-		},
-	}
-
-	content, err := json.Marshal(data)
-	if err != nil {
-		return err
-	}
-
-	return dsl.requester.Create(content)
+	return dsl.create(name, "", cp)
 }
 
 func (dsl DataStoreList) Shapefile(name string, filepath string, options ...options.ShapefileOption) error {
@@ -199,23 +257,7 @@ func (dsl DataStoreList) Shapefile(name string, filepath string, options ...opti
 		option(&cp)
 	}
 
-	data := datastores.GenericDataStoreCreationWrapper{
-		DataStore: datastores.GenericDataStoreCreationModel{
-			Name:                       name,
-			Description:                dsl.options.Description,
-			DisableOnConnectionFailure: dsl.options.AutoDisableOnConnFailure,
-			ConnectionParameters: datastores.ConnectionParameters{
-				Entry: cp.ToDatastoreEntries(),
-			},
-		},
-	}
-
-	content, err := json.Marshal(data)
-	if err != nil {
-		return err
-	}
-
-	return dsl.requester.Create(content)
+	return dsl.create(name, "", cp)
 }
 
 func (dsl DataStoreList) Shapefiles(name string, dir string, options ...options.ShapefileOption) error {
@@ -245,23 +287,7 @@ func (dsl DataStoreList) Shapefiles(name string, dir string, options ...options.
 		option(&cp)
 	}
 
-	data := datastores.GenericDataStoreCreationWrapper{
-		DataStore: datastores.GenericDataStoreCreationModel{
-			Name:                       name,
-			Description:                dsl.options.Description,
-			DisableOnConnectionFailure: dsl.options.AutoDisableOnConnFailure,
-			ConnectionParameters: datastores.ConnectionParameters{
-				Entry: cp.ToDatastoreEntries(),
-			},
-		},
-	}
-
-	content, err := json.Marshal(data)
-	if err != nil {
-		return err
-	}
-
-	return dsl.requester.Create(content)
+	return dsl.create(name, "", cp)
 }
 
 //func (dsl DataStoreList) CSV(name string, filepath string, options ...options.CSVOptions) error {
@@ -319,10 +345,215 @@ func (dsl DataStoreList) WebFeatureService(storeName, username, password, wfsCap
 		option(&cp)
 	}
 
+	return dsl.create(storeName, "", cp)
+}
+
+func (dsl DataStoreList) Oracle(name string, connectionParams oracle.ConnectionParams, options ...options.JDBCOption) error {
+	err := validator.Name(name)
+	if err != nil {
+		return err
+	}
+
+	err = validator.DataStore.JDBC(connectionParams.Host, connectionParams.Database)
+	if err != nil {
+		return err
+	}
+
+	cp := datastores.ConnectionParams{
+		"host":     connectionParams.Host,
+		"port":     connectionParams.Port,
+		"database": connectionParams.Database,
+		"user":     connectionParams.User,
+		"passwd":   connectionParams.Password,
+		"dbtype":   string(formats.Oracle),
+	}
+
+	if !validator.Empty(connectionParams.Schema) {
+		cp["schema"] = connectionParams.Schema
+	}
+
+	for _, option := range options {
+		option(&cp)
+	}
+
+	return dsl.create(name, "", cp)
+}
+
+func (dsl DataStoreList) MySQL(name string, connectionParams mysql.ConnectionParams, options ...options.JDBCOption) error {
+	err := validator.Name(name)
+	if err != nil {
+		return err
+	}
+
+	err = validator.DataStore.JDBC(connectionParams.Host, connectionParams.Database)
+	if err != nil {
+		return err
+	}
+
+	cp := datastores.ConnectionParams{
+		"host":     connectionParams.Host,
+		"port":     connectionParams.Port,
+		"database": connectionParams.Database,
+		"user":     connectionParams.User,
+		"passwd":   connectionParams.Password,
+		"dbtype":   string(formats.MySQL),
+	}
+
+	for _, option := range options {
+		option(&cp)
+	}
+
+	return dsl.create(name, "", cp)
+}
+
+func (dsl DataStoreList) SQLServer(name string, connectionParams sqlserver.ConnectionParams, options ...options.JDBCOption) error {
+	err := validator.Name(name)
+	if err != nil {
+		return err
+	}
+
+	err = validator.DataStore.JDBC(connectionParams.Host, connectionParams.Database)
+	if err != nil {
+		return err
+	}
+
+	cp := datastores.ConnectionParams{
+		"host":     connectionParams.Host,
+		"port":     connectionParams.Port,
+		"database": connectionParams.Database,
+		"user":     connectionParams.User,
+		"passwd":   connectionParams.Password,
+		"dbtype":   string(formats.SQLServer),
+	}
+
+	if !validator.Empty(connectionParams.Instance) {
+		cp["instance"] = connectionParams.Instance
+	}
+
+	if !validator.Empty(connectionParams.Schema) {
+		cp["schema"] = connectionParams.Schema
+	}
+
+	for _, option := range options {
+		option(&cp)
+	}
+
+	return dsl.create(name, "", cp)
+}
+
+func (dsl DataStoreList) H2(name string, connectionParams h2.ConnectionParams, options ...options.JDBCOption) error {
+	err := validator.Name(name)
+	if err != nil {
+		return err
+	}
+
+	err = validator.DataStore.H2(connectionParams.Database)
+	if err != nil {
+		return err
+	}
+
+	cp := datastores.ConnectionParams{
+		"database": connectionParams.Database,
+		"user":     connectionParams.User,
+		"passwd":   connectionParams.Password,
+		"dbtype":   string(formats.H2),
+	}
+
+	for _, option := range options {
+		option(&cp)
+	}
+
+	return dsl.create(name, "", cp)
+}
+
+// Property creates a store from a directory of .properties files, each one of them being published as a feature type.
+func (dsl DataStoreList) Property(name string, dir string, options ...options.PropertyOption) error {
+	err := validator.Name(name)
+	if err != nil {
+		return err
+	}
+
+	err = validator.DataStore.PropertyDirectory(dir)
+	if err != nil {
+		return err
+	}
+
+	var url string
+	if strings.HasPrefix(dir, "file:") {
+		url = dir
+	} else {
+		url = fmt.Sprintf("file:%s", dir)
+	}
+
+	cp := datastores.ConnectionParams{
+		"directory": url,
+		"dbtype":    string(formats.Property),
+	}
+
+	for _, option := range options {
+		option(&cp)
+	}
+
+	return dsl.create(name, "", cp)
+}
+
+// AppSchema creates a complex features store from an app-schema mapping file, which maps the features of other stores
+// to an application schema. It requires the app-schema extension.
+func (dsl DataStoreList) AppSchema(name string, mappingFile string) error {
+	err := validator.Name(name)
+	if err != nil {
+		return err
+	}
+
+	err = validator.DataStore.AppSchema(mappingFile)
+	if err != nil {
+		return err
+	}
+
+	var url string
+	if strings.HasPrefix(mappingFile, "file:") {
+		url = mappingFile
+	} else {
+		url = fmt.Sprintf("file:%s", mappingFile)
+	}
+
+	cp := datastores.ConnectionParams{
+		"url":    url,
+		"dbtype": string(formats.AppSchema),
+	}
+
+	return dsl.create(name, "", cp)
+}
+
+// Custom creates a store of any type, typically provided by a GeoServer extension,
+// from the raw connection parameters expected by its datastore factory.
+// The type is the display name of the store (e.g. "Elasticsearch") and can be empty.
+// Stores without a dedicated creator, such as the GeoJSON stores of the community modules, are created this way.
+func (dsl DataStoreList) Custom(name string, type_ string, params datastores.ConnectionParams) error {
+	err := validator.Name(name)
+	if err != nil {
+		return err
+	}
+
+	err = validator.DataStore.Custom(params)
+	if err != nil {
+		return err
+	}
+
+	cp := make(datastores.ConnectionParams, len(params))
+	for k, v := range params {
+		cp[k] = v
+	}
+
+	return dsl.create(name, type_, cp)
+}
+
+func (dsl DataStoreList) create(name string, type_ string, cp datastores.ConnectionParams) error {
 	data := datastores.GenericDataStoreCreationWrapper{
 		DataStore: datastores.GenericDataStoreCreationModel{
-			Name:                       storeName,
+			Name:                       name,
 			Description:                dsl.options.Description,
+			Type:                       type_,
 			DisableOnConnectionFailure: dsl.options.AutoDisableOnConnFailure,
 			ConnectionParameters: datastores.ConnectionParameters{
 				Entry: cp.ToDatastoreEntries(),
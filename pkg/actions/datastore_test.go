@@ -0,0 +1,42 @@
+package actions_test
+
+import (
+	"testing"
+
+	"github.com/canghel3/go-geoserver/pkg/datastores"
+	"github.com/canghel3/go-geoserver/pkg/geoservertest"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataStores_UpdateConnectionParameters(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+	gc := server.Client()
+
+	require.NoError(t, gc.Workspaces().Create("roads", false))
+	stores := gc.Workspace("roads").DataStores()
+	require.NoError(t, stores.Create(options.GenericStore.Description("road network")).Custom("postgis", "PostGIS", datastores.ConnectionParams{
+		"dbtype": "postgis",
+		"host":   "localhost",
+		"schema": "public",
+	}))
+
+	store, err := stores.Get("postgis")
+	require.NoError(t, err)
+	store.Enabled = true
+	require.NoError(t, stores.Update("postgis", *store))
+
+	require.NoError(t, stores.UpdateConnectionParameters("postgis", datastores.ConnectionParams{"host": "db.roads.org"}))
+
+	updated, err := stores.Get("postgis")
+	require.NoError(t, err)
+	assert.Equal(t, "road network", updated.Description)
+	assert.True(t, updated.Enabled)
+
+	host, _ := updated.ConnectionParameters.Get("host")
+	assert.Equal(t, "db.roads.org", host)
+	schema, _ := updated.ConnectionParameters.Get("schema")
+	assert.Equal(t, "public", schema, "the parameters which are not given are kept")
+}
@@ -56,6 +56,11 @@ func (ft FeatureTypes) GetAll() (*featuretypes.FeatureTypes, error) {
 	return ft.requester.GetAll(ft.store)
 }
 
+// Available lists the native names of the tables in the store which can be published as feature types.
+func (ft FeatureTypes) Available() ([]string, error) {
+	return ft.requester.GetAvailable(ft.store)
+}
+
 func (ft FeatureTypes) Update(name string, featureType featuretypes.FeatureType) error {
 	if err := validator.Name(name); err != nil {
 		return err
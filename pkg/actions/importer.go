@@ -0,0 +1,226 @@
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/internal/requester"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/importer"
+	"github.com/canghel3/go-geoserver/pkg/options"
+)
+
+// Importer requires the importer extension to be installed.
+type Importer struct {
+	requester requester.ImporterRequester
+}
+
+func NewImporterActions(data internal.GeoserverData) Importer {
+	return Importer{
+		requester: requester.NewImporterRequester(data),
+	}
+}
+
+// Create creates an import context. Without options.Import.Directory or options.Import.File the context is empty
+// and the data is added afterwards with Upload or AddURL.
+func (i Importer) Create(opts ...options.ImportOption) (*importer.Import, error) {
+	var context models.Import
+	for _, opt := range opts {
+		opt(&context)
+	}
+
+	content, err := json.Marshal(models.ImportWrapper{Import: context})
+	if err != nil {
+		return nil, err
+	}
+
+	return i.requester.Create(content)
+}
+
+// GetAll lists the import contexts
+func (i Importer) GetAll() ([]importer.Import, error) {
+	return i.requester.GetAll()
+}
+
+// Use selects an existing import context
+func (i Importer) Use(id int) Import {
+	return Import{
+		id:        id,
+		requester: i.requester,
+	}
+}
+
+type Import struct {
+	id        int
+	requester requester.ImporterRequester
+}
+
+// Get retrieves the import context along with the state of its tasks
+func (i Import) Get() (*importer.Import, error) {
+	return i.requester.Get(i.id)
+}
+
+// Delete removes the import context, the data that was already imported is kept
+func (i Import) Delete() error {
+	return i.requester.Delete(i.id)
+}
+
+// Upload adds a file to the import. A zip archive results in a task for each file it contains,
+// a shapefile must therefore be zipped together with its .dbf, .shx and .prj files.
+func (i Import) Upload(filename string, content []byte) ([]importer.Task, error) {
+	if filename == "" || path.Base(filename) != filename {
+		return nil, customerrors.WrapInputError(fmt.Errorf("invalid file name %s", filename))
+	}
+
+	return i.requester.Upload(i.id, filename, content)
+}
+
+// AddDirectory adds a task for each file of a directory on the GeoServer host
+func (i Import) AddDirectory(directory string) ([]importer.Task, error) {
+	if !path.IsAbs(directory) {
+		return nil, customerrors.WrapInputError(fmt.Errorf("directory %s must be an absolute path on the geoserver host", directory))
+	}
+
+	return i.requester.AddURL(i.id, "file://"+directory)
+}
+
+// AddURL adds the data found at a remote http(s) or ftp url, which GeoServer downloads before importing
+func (i Import) AddURL(location string) ([]importer.Task, error) {
+	switch {
+	case strings.HasPrefix(location, "http://"), strings.HasPrefix(location, "https://"), strings.HasPrefix(location, "ftp://"):
+	default:
+		return nil, customerrors.WrapInputError(fmt.Errorf("unsupported url %s", location))
+	}
+
+	return i.requester.AddURL(i.id, location)
+}
+
+// Tasks lists the tasks of the import
+func (i Import) Tasks() ([]importer.Task, error) {
+	return i.requester.GetTasks(i.id)
+}
+
+// Task selects a task of the import
+func (i Import) Task(id int) ImportTask {
+	return ImportTask{
+		importID:  i.id,
+		id:        id,
+		requester: i.requester,
+	}
+}
+
+// Run starts importing the ready tasks. The import runs asynchronously, use Wait to block until it is done.
+func (i Import) Run() error {
+	return i.requester.Run(i.id)
+}
+
+// Wait polls the import every interval until it is done. It returns an *importer.ImportError listing the tasks
+// that did not complete and a customerrors.GeoserverError if the import is still running after timeout.
+func (i Import) Wait(timeout, interval time.Duration) (*importer.Import, error) {
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		context, err := i.requester.Get(i.id)
+		if err != nil {
+			return nil, err
+		}
+
+		if context.State.Done() {
+			return context, context.Err()
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return context, customerrors.WrapGeoserverError(fmt.Errorf("import %d still %s after %s", i.id, context.State, timeout))
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+type ImportTask struct {
+	importID  int
+	id        int
+	requester requester.ImporterRequester
+}
+
+// Get retrieves the task, its state tells whether it is ready to be imported or needs adjusting (e.g. NO_CRS)
+func (it ImportTask) Get() (*importer.Task, error) {
+	return it.requester.GetTask(it.importID, it.id)
+}
+
+// Update changes the update mode or the target store of the task
+func (it ImportTask) Update(opts ...options.ImportTaskOption) error {
+	var task models.ImportTask
+	for _, opt := range opts {
+		opt(&task)
+	}
+
+	content, err := json.Marshal(models.ImportTaskWrapper{Task: task})
+	if err != nil {
+		return err
+	}
+
+	return it.requester.UpdateTask(it.importID, it.id, content)
+}
+
+// Delete removes the task from the import
+func (it ImportTask) Delete() error {
+	return it.requester.DeleteTask(it.importID, it.id)
+}
+
+// Layer retrieves the layer that the task will publish
+func (it ImportTask) Layer() (*importer.Layer, error) {
+	return it.requester.GetLayer(it.importID, it.id)
+}
+
+// UpdateLayer changes the name, title, abstract or srs of the layer that the task will publish
+func (it ImportTask) UpdateLayer(opts ...options.ImportLayerOption) error {
+	var layer models.ImportLayer
+	for _, opt := range opts {
+		opt(&layer)
+	}
+
+	content, err := json.Marshal(models.ImportLayerWrapper{Layer: layer})
+	if err != nil {
+		return err
+	}
+
+	return it.requester.UpdateLayer(it.importID, it.id, content)
+}
+
+// Transforms lists the transforms applied to the data of the task, in order
+func (it ImportTask) Transforms() ([]importer.Transform, error) {
+	return it.requester.GetTransforms(it.importID, it.id)
+}
+
+// AddTransform appends a transform, such as importer.Reproject, to the transforms of the task
+func (it ImportTask) AddTransform(transform importer.Transform) error {
+	if transform.Type == "" {
+		return customerrors.WrapInputError(fmt.Errorf("transform type cannot be empty"))
+	}
+
+	content, err := json.Marshal(transform)
+	if err != nil {
+		return err
+	}
+
+	return it.requester.AddTransform(it.importID, it.id, content)
+}
+
+// DeleteTransform removes the transform at index from the transforms of the task
+func (it ImportTask) DeleteTransform(index int) error {
+	return it.requester.DeleteTransform(it.importID, it.id, index)
+}
+
+// Progress retrieves the progress of the task while the import is running
+func (it ImportTask) Progress() (*importer.Progress, error) {
+	return it.requester.GetProgress(it.importID, it.id)
+}
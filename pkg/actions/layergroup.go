@@ -7,7 +7,6 @@ import (
 	"github.com/canghel3/go-geoserver/internal/models"
 	"github.com/canghel3/go-geoserver/internal/requester"
 	"github.com/canghel3/go-geoserver/internal/validator"
-	"github.com/canghel3/go-geoserver/pkg/customerrors"
 	"github.com/canghel3/go-geoserver/pkg/layers"
 	"github.com/canghel3/go-geoserver/pkg/workspace"
 	"strings"
@@ -30,19 +29,37 @@ func (lg LayerGroups) Get(name string) (*layers.Group, error) {
 	return lg.requester.Get(name)
 }
 
+// GetAll lists the layer groups of the workspace, or the global layer groups when no workspace is selected
+func (lg LayerGroups) GetAll() (*layers.Groups, error) {
+	return lg.requester.GetAll()
+}
+
+// Publish creates the layer group in the workspace of the group, or else in the selected workspace. Without either,
+// the group is created as a global one, whose layers must be named with their workspace as <workspace>:<layer>.
 func (lg LayerGroups) Publish(group models.Group) error {
 	if err := validator.Name(group.Name); err != nil {
 		return err
 	}
 
+	if group.Workspace == nil && !validator.Empty(lg.data.Workspace) {
+		group.Workspace = &workspace.Creation{
+			Name: lg.data.Workspace,
+		}
+	}
+
 	if group.Workspace == nil {
-		if validator.Empty(lg.data.Workspace) {
-			return customerrors.NewInputError("workspace is required. use Workspace from options.LayerGroup")
-		} else {
-			group.Workspace = &workspace.Creation{
-				Name: lg.data.Workspace,
+		for _, entry := range group.Publishables.Entries {
+			if err := validator.WorkspaceLayerFormat("", entry.Name); err != nil {
+				return err
 			}
 		}
+
+		content, err := json.Marshal(models.GroupWrapper{Group: group})
+		if err != nil {
+			return err
+		}
+
+		return lg.requester.Create(content)
 	}
 
 	if err := validator.Name(group.Workspace.Name); err != nil {
@@ -71,6 +88,7 @@ func (lg LayerGroups) Publish(group models.Group) error {
 	return lg.requester.Create(content)
 }
 
+// Update replaces the layer group, which is global when neither the group nor the selected workspace name a workspace
 func (lg LayerGroups) Update(name string, group layers.Group) error {
 	if err := validator.Name(name); err != nil {
 		return err
@@ -80,30 +98,37 @@ func (lg LayerGroups) Update(name string, group layers.Group) error {
 		return err
 	}
 
-	if group.Workspace == nil {
-		if validator.Empty(lg.data.Workspace) {
-			return customerrors.NewInputError("group.Workspace is required")
-		} else {
-			group.Workspace = &workspace.Creation{
-				Name: lg.data.Workspace,
-			}
+	if group.Workspace == nil && !validator.Empty(lg.data.Workspace) {
+		group.Workspace = &workspace.Creation{
+			Name: lg.data.Workspace,
 		}
 	}
 
-	if err := validator.Name(group.Workspace.Name); err != nil {
-		return err
-	}
+	if group.Workspace == nil {
+		// a global group has no workspace to resolve its layers in
+		if group.Publishables != nil {
+			for _, entry := range group.Publishables.Entries {
+				if err := validator.WorkspaceLayerFormat("", entry.Name); err != nil {
+					return err
+				}
+			}
+		}
+	} else {
+		if err := validator.Name(group.Workspace.Name); err != nil {
+			return err
+		}
 
-	for i := range group.Publishables.Entries {
-		if !strings.HasPrefix(group.Publishables.Entries[i].Name, group.Workspace.Name) {
-			group.Publishables.Entries[i].Name = fmt.Sprintf("%s:%s", group.Workspace.Name, group.Publishables.Entries[i].Name)
+		for i := range group.Publishables.Entries {
+			if !strings.HasPrefix(group.Publishables.Entries[i].Name, group.Workspace.Name) {
+				group.Publishables.Entries[i].Name = fmt.Sprintf("%s:%s", group.Workspace.Name, group.Publishables.Entries[i].Name)
+			}
 		}
-	}
 
-	if group.Styles != nil {
-		for i := range group.Styles.Style {
-			if !validator.Empty(group.Styles.Style[i].Name) && !strings.HasPrefix(group.Styles.Style[i].Name, group.Workspace.Name) {
-				group.Styles.Style[i].Name = fmt.Sprintf("%s:%s", group.Workspace.Name, group.Styles.Style[i].Name)
+		if group.Styles != nil {
+			for i := range group.Styles.Style {
+				if !validator.Empty(group.Styles.Style[i].Name) && !strings.HasPrefix(group.Styles.Style[i].Name, group.Workspace.Name) {
+					group.Styles.Style[i].Name = fmt.Sprintf("%s:%s", group.Workspace.Name, group.Styles.Style[i].Name)
+				}
 			}
 		}
 	}
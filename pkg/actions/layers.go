@@ -0,0 +1,29 @@
+package actions
+
+import (
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/requester"
+	"github.com/canghel3/go-geoserver/pkg/layers"
+)
+
+// Layers gives read access to the published layers, which GeoServer creates along with the feature types and
+// coverages and removes with them.
+type Layers struct {
+	requester requester.LayerRequester
+}
+
+func NewLayerActions(data internal.GeoserverData) Layers {
+	return Layers{
+		requester: requester.NewLayerRequester(data),
+	}
+}
+
+// Get retrieves the layer, along with its default and alternative styles
+func (l Layers) Get(name string) (*layers.Layer, error) {
+	return l.requester.Get(name)
+}
+
+// GetAll lists the layers of the workspace, or all the layers prefixed with their workspace when no workspace is selected
+func (l Layers) GetAll() (*layers.Layers, error) {
+	return l.requester.GetAll()
+}
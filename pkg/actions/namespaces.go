@@ -0,0 +1,82 @@
+package actions
+
+import (
+	"encoding/json"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/internal/requester"
+	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/namespaces"
+	"github.com/canghel3/go-geoserver/pkg/options"
+)
+
+type Namespaces struct {
+	requester requester.NamespaceRequester
+}
+
+func NewNamespaceActions(data internal.GeoserverData) Namespaces {
+	return Namespaces{
+		requester: requester.NewNamespaceRequester(data),
+	}
+}
+
+// Create a namespace. The workspace with the same name as the prefix is created by GeoServer alongside the namespace.
+func (n Namespaces) Create(prefix, uri string, options ...options.NamespaceOption) error {
+	content, err := n.content(prefix, uri, options...)
+	if err != nil {
+		return err
+	}
+
+	return n.requester.Create(content)
+}
+
+func (n Namespaces) Get(prefix string) (*namespaces.Namespace, error) {
+	if err := validator.Name(prefix); err != nil {
+		return nil, err
+	}
+
+	return n.requester.Get(prefix)
+}
+
+func (n Namespaces) GetAll() (*namespaces.Namespaces, error) {
+	return n.requester.GetAll()
+}
+
+func (n Namespaces) Update(prefix, uri string, options ...options.NamespaceOption) error {
+	content, err := n.content(prefix, uri, options...)
+	if err != nil {
+		return err
+	}
+
+	return n.requester.Update(prefix, content)
+}
+
+// Delete the namespace. GeoServer only deletes empty namespaces.
+func (n Namespaces) Delete(prefix string) error {
+	if err := validator.Name(prefix); err != nil {
+		return err
+	}
+
+	return n.requester.Delete(prefix)
+}
+
+func (n Namespaces) content(prefix, uri string, options ...options.NamespaceOption) ([]byte, error) {
+	if err := validator.Name(prefix); err != nil {
+		return nil, err
+	}
+
+	if err := validator.Namespace.URI(uri); err != nil {
+		return nil, err
+	}
+
+	data := models.NamespaceDefinition{
+		Prefix: prefix,
+		URI:    uri,
+	}
+
+	for _, option := range options {
+		option(&data)
+	}
+
+	return json.Marshal(models.NamespaceDefinitionWrapper{Namespace: data})
+}
@@ -0,0 +1,330 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/internal/requester"
+	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/canghel3/go-geoserver/pkg/security"
+)
+
+type Security struct {
+	data internal.GeoserverData
+}
+
+func NewSecurityActions(data internal.GeoserverData) Security {
+	return Security{
+		data: data,
+	}
+}
+
+// UserGroups manages the users and groups of the default user/group service
+func (s Security) UserGroups() UserGroups {
+	return s.UserGroupService("")
+}
+
+// UserGroupService manages the users and groups of the named user/group service
+func (s Security) UserGroupService(name string) UserGroups {
+	return UserGroups{
+		requester: requester.NewUserGroupRequester(s.data.Clone(), name),
+	}
+}
+
+// Roles manages the roles of the active role service
+func (s Security) Roles() Roles {
+	return s.RoleService("")
+}
+
+// RoleService manages the roles of the named role service
+func (s Security) RoleService(name string) Roles {
+	return Roles{
+		requester: requester.NewRoleRequester(s.data.Clone(), name),
+	}
+}
+
+type UserGroups struct {
+	requester requester.UserGroupRequester
+}
+
+func (ug UserGroups) Users() ([]security.User, error) {
+	return ug.requester.GetUsers()
+}
+
+// CreateUser creates an enabled user, unless options.User.Enabled(false) is passed
+func (ug UserGroups) CreateUser(name, password string, options ...options.UserOption) error {
+	if err := validator.Security.Name(name); err != nil {
+		return err
+	}
+
+	if err := validator.Security.Password(password); err != nil {
+		return err
+	}
+
+	enabled := true
+	data := models.User{
+		UserName: name,
+		Password: &password,
+		Enabled:  &enabled,
+	}
+
+	for _, option := range options {
+		option(&data)
+	}
+
+	content, err := json.Marshal(models.UserWrapper{User: data})
+	if err != nil {
+		return err
+	}
+
+	return ug.requester.CreateUser(content)
+}
+
+// UpdateUser changes the password or the enabled state of the user
+func (ug UserGroups) UpdateUser(name string, options ...options.UserOption) error {
+	if err := validator.Security.Name(name); err != nil {
+		return err
+	}
+
+	data := models.User{
+		UserName: name,
+	}
+
+	for _, option := range options {
+		option(&data)
+	}
+
+	if data.Password != nil {
+		if err := validator.Security.Password(*data.Password); err != nil {
+			return err
+		}
+	}
+
+	content, err := json.Marshal(models.UserWrapper{User: data})
+	if err != nil {
+		return err
+	}
+
+	return ug.requester.UpdateUser(name, content)
+}
+
+func (ug UserGroups) DeleteUser(name string) error {
+	if err := validator.Security.Name(name); err != nil {
+		return err
+	}
+
+	return ug.requester.DeleteUser(name)
+}
+
+func (ug UserGroups) Groups() ([]string, error) {
+	return ug.requester.GetGroups()
+}
+
+func (ug UserGroups) CreateGroup(name string) error {
+	if err := validator.Security.Name(name); err != nil {
+		return err
+	}
+
+	return ug.requester.CreateGroup(name)
+}
+
+func (ug UserGroups) DeleteGroup(name string) error {
+	if err := validator.Security.Name(name); err != nil {
+		return err
+	}
+
+	return ug.requester.DeleteGroup(name)
+}
+
+// GroupsOf retrieves the groups the user belongs to
+func (ug UserGroups) GroupsOf(user string) ([]string, error) {
+	if err := validator.Security.Name(user); err != nil {
+		return nil, err
+	}
+
+	return ug.requester.GetUserGroups(user)
+}
+
+// UsersOf retrieves the members of the group
+func (ug UserGroups) UsersOf(group string) ([]security.User, error) {
+	if err := validator.Security.Name(group); err != nil {
+		return nil, err
+	}
+
+	return ug.requester.GetGroupUsers(group)
+}
+
+func (ug UserGroups) AddToGroup(user, group string) error {
+	if err := validateSecurityNames(user, group); err != nil {
+		return err
+	}
+
+	return ug.requester.AddUserToGroup(user, group)
+}
+
+func (ug UserGroups) RemoveFromGroup(user, group string) error {
+	if err := validateSecurityNames(user, group); err != nil {
+		return err
+	}
+
+	return ug.requester.RemoveUserFromGroup(user, group)
+}
+
+type Roles struct {
+	requester requester.RoleRequester
+}
+
+func (r Roles) GetAll() ([]string, error) {
+	return r.requester.GetAll()
+}
+
+// Create a role. Use SetParent to place it in the role hierarchy.
+func (r Roles) Create(role string) error {
+	if err := validator.Security.Name(role); err != nil {
+		return err
+	}
+
+	return r.requester.Create(role)
+}
+
+// CreateWithParent creates the role and makes it inherit the permissions of parent.
+// The role is deleted again when its parent cannot be set.
+func (r Roles) CreateWithParent(role, parent string) error {
+	if err := r.Create(role); err != nil {
+		return err
+	}
+
+	if err := r.SetParent(role, parent); err != nil {
+		return errors.Join(err, r.requester.Delete(role))
+	}
+
+	return nil
+}
+
+// SetParent makes the role inherit the permissions of parent. An empty parent removes the current one.
+// Only XML role services (the default) support the role hierarchy through the REST API, an InputError is returned
+// when the role service, or the active one when none was selected, is of another kind.
+// A parent that would make the role its own ancestor is rejected.
+//
+// The role service is read and written back as a whole, so roles created, deleted or assigned by someone else
+// between the two requests are lost. Avoid changing the role service concurrently with SetParent.
+func (r Roles) SetParent(role, parent string) error {
+	if err := validator.Security.Name(role); err != nil {
+		return err
+	}
+
+	if parent != "" {
+		if err := validator.Security.Name(parent); err != nil {
+			return err
+		}
+	}
+
+	return r.requester.SetParent(role, parent)
+}
+
+func (r Roles) Delete(role string) error {
+	if err := validator.Security.Name(role); err != nil {
+		return err
+	}
+
+	return r.requester.Delete(role)
+}
+
+// OfUser retrieves the roles assigned to the user, directly or through its groups
+func (r Roles) OfUser(user string) ([]string, error) {
+	if err := validator.Security.Name(user); err != nil {
+		return nil, err
+	}
+
+	return r.requester.GetUserRoles(user)
+}
+
+func (r Roles) OfGroup(group string) ([]string, error) {
+	if err := validator.Security.Name(group); err != nil {
+		return nil, err
+	}
+
+	return r.requester.GetGroupRoles(group)
+}
+
+func (r Roles) AssignToUser(role, user string) error {
+	if err := validateSecurityNames(role, user); err != nil {
+		return err
+	}
+
+	return r.requester.AssignToUser(role, user)
+}
+
+func (r Roles) UnassignFromUser(role, user string) error {
+	if err := validateSecurityNames(role, user); err != nil {
+		return err
+	}
+
+	return r.requester.UnassignFromUser(role, user)
+}
+
+func (r Roles) AssignToGroup(role, group string) error {
+	if err := validateSecurityNames(role, group); err != nil {
+		return err
+	}
+
+	return r.requester.AssignToGroup(role, group)
+}
+
+func (r Roles) UnassignFromGroup(role, group string) error {
+	if err := validateSecurityNames(role, group); err != nil {
+		return err
+	}
+
+	return r.requester.UnassignFromGroup(role, group)
+}
+
+func validateSecurityNames(names ...string) error {
+	for _, name := range names {
+		if err := validator.Security.Name(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ChangePassword changes the password of the user the client authenticates with.
+// Clients using basic authentication must be recreated with the new password afterwards.
+func (s Security) ChangePassword(newPassword string) error {
+	if err := validator.Security.Password(newPassword); err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(security.SelfPassword{NewPassword: newPassword})
+	if err != nil {
+		return err
+	}
+
+	return requester.NewPasswordRequester(s.data.Clone()).ChangeSelf(content)
+}
+
+// MasterPassword retrieves the keystore master password. Only the root user is allowed to read it.
+func (s Security) MasterPassword() (string, error) {
+	return requester.NewPasswordRequester(s.data.Clone()).GetMaster()
+}
+
+// ChangeMasterPassword changes the password protecting the keystore, which encrypts the store passwords
+func (s Security) ChangeMasterPassword(oldPassword, newPassword string) error {
+	if err := validator.Security.Password(oldPassword); err != nil {
+		return err
+	}
+
+	if err := validator.Security.Password(newPassword); err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(security.MasterPassword{OldMasterPassword: oldPassword, NewMasterPassword: newPassword})
+	if err != nil {
+		return err
+	}
+
+	return requester.NewPasswordRequester(s.data.Clone()).ChangeMaster(content)
+}
@@ -0,0 +1,117 @@
+package actions
+
+import (
+	"errors"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/requester"
+	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/services"
+)
+
+type Services struct {
+	data internal.GeoserverData
+}
+
+func NewServicesActions(data internal.GeoserverData) Services {
+	return Services{
+		data: data,
+	}
+}
+
+func (s Services) WMS() ServiceSettings[services.WMS] {
+	return newServiceSettings[services.WMS](s.data, services.NameWMS)
+}
+
+func (s Services) WFS() ServiceSettings[services.WFS] {
+	return newServiceSettings[services.WFS](s.data, services.NameWFS)
+}
+
+func (s Services) WCS() ServiceSettings[services.WCS] {
+	return newServiceSettings[services.WCS](s.data, services.NameWCS)
+}
+
+func (s Services) WMTS() ServiceSettings[services.WMTS] {
+	return newServiceSettings[services.WMTS](s.data, services.NameWMTS)
+}
+
+// ServiceSettings manages the global settings of a service, or the settings
+// overriding them inside a workspace when obtained through Workspace.
+type ServiceSettings[T any] struct {
+	workspace string
+	requester requester.ServiceRequester[T]
+}
+
+func newServiceSettings[T any](data internal.GeoserverData, service string) ServiceSettings[T] {
+	return ServiceSettings[T]{
+		requester: requester.NewServiceRequester[T](data, service),
+	}
+}
+
+// Workspace returns the settings overriding the global ones inside the workspace.
+func (ss ServiceSettings[T]) Workspace(name string) ServiceSettings[T] {
+	ss.workspace = name
+	return ss
+}
+
+func (ss ServiceSettings[T]) Get() (*T, error) {
+	if err := ss.validate(); err != nil {
+		return nil, err
+	}
+
+	return ss.requester.Get(ss.workspace)
+}
+
+// Update the settings. Only the fields which are set are changed.
+// Updating the settings of a workspace creates the override if it does not exist.
+func (ss ServiceSettings[T]) Update(settings T) error {
+	if err := ss.validate(); err != nil {
+		return err
+	}
+
+	return ss.requester.Update(ss.workspace, settings)
+}
+
+// Delete removes the workspace override, so the workspace falls back to the global settings.
+// The global settings cannot be deleted.
+func (ss ServiceSettings[T]) Delete() error {
+	if ss.workspace == "" {
+		return customerrors.WrapInputError(errors.New("global service settings cannot be deleted"))
+	}
+
+	if err := ss.validate(); err != nil {
+		return err
+	}
+
+	return ss.requester.Delete(ss.workspace)
+}
+
+func (ss ServiceSettings[T]) validate() error {
+	if ss.workspace == "" {
+		return nil
+	}
+
+	return validator.Name(ss.workspace)
+}
+
+// WorkspaceServices is shorthand for Services().<Service>().Workspace(name)
+type WorkspaceServices struct {
+	workspace string
+	services  Services
+}
+
+func (ws WorkspaceServices) WMS() ServiceSettings[services.WMS] {
+	return ws.services.WMS().Workspace(ws.workspace)
+}
+
+func (ws WorkspaceServices) WFS() ServiceSettings[services.WFS] {
+	return ws.services.WFS().Workspace(ws.workspace)
+}
+
+func (ws WorkspaceServices) WCS() ServiceSettings[services.WCS] {
+	return ws.services.WCS().Workspace(ws.workspace)
+}
+
+func (ws WorkspaceServices) WMTS() ServiceSettings[services.WMTS] {
+	return ws.services.WMTS().Workspace(ws.workspace)
+}
@@ -0,0 +1,156 @@
+package actions
+
+import (
+	"encoding/json"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/requester"
+	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/settings"
+)
+
+type Settings struct {
+	requester requester.SettingsRequester
+}
+
+func NewSettingsActions(data internal.GeoserverData) Settings {
+	return Settings{
+		requester: requester.NewSettingsRequester(data),
+	}
+}
+
+func (s Settings) Get() (*settings.Global, error) {
+	return s.requester.Get()
+}
+
+// Update the global settings. GeoServer replaces each section which is sent as a whole,
+// so sections should be retrieved with Get, modified and sent back.
+func (s Settings) Update(global settings.Global) error {
+	if err := validateSettings(global.Settings); err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(settings.GlobalWrapper{Global: global})
+	if err != nil {
+		return err
+	}
+
+	return s.requester.Update(content)
+}
+
+// ProxyBaseURL sets the url used by GeoServer to build links when running behind a proxy or load balancer.
+// An empty url removes the proxy base url.
+func (s Settings) ProxyBaseURL(url string) error {
+	if err := validator.Settings.ProxyBaseURL(url); err != nil {
+		return err
+	}
+
+	global, err := s.requester.Get()
+	if err != nil {
+		return err
+	}
+
+	if global.Settings == nil {
+		global.Settings = &settings.Settings{}
+	}
+	global.Settings.ProxyBaseURL = &url
+
+	return s.Update(settings.Global{Settings: global.Settings})
+}
+
+func (s Settings) Contact() (*settings.Contact, error) {
+	return s.requester.GetContact()
+}
+
+func (s Settings) UpdateContact(contact settings.Contact) error {
+	content, err := json.Marshal(settings.ContactWrapper{Contact: contact})
+	if err != nil {
+		return err
+	}
+
+	return s.requester.UpdateContact(content)
+}
+
+// Workspace returns the actions for the settings overriding the global ones inside the workspace
+func (s Settings) Workspace(name string) WorkspaceSettings {
+	return WorkspaceSettings{
+		workspace: name,
+		requester: s.requester,
+	}
+}
+
+type WorkspaceSettings struct {
+	workspace string
+	requester requester.SettingsRequester
+}
+
+func (ws WorkspaceSettings) Get() (*settings.Settings, error) {
+	if err := validator.Name(ws.workspace); err != nil {
+		return nil, err
+	}
+
+	return ws.requester.GetWorkspace(ws.workspace)
+}
+
+// Create enables the workspace specific settings
+func (ws WorkspaceSettings) Create(s settings.Settings) error {
+	if err := validator.Name(ws.workspace); err != nil {
+		return err
+	}
+
+	if err := validateSettings(&s); err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(settings.SettingsWrapper{Settings: s})
+	if err != nil {
+		return err
+	}
+
+	return ws.requester.CreateWorkspace(ws.workspace, content)
+}
+
+func (ws WorkspaceSettings) Update(s settings.Settings) error {
+	if err := validator.Name(ws.workspace); err != nil {
+		return err
+	}
+
+	if err := validateSettings(&s); err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(settings.SettingsWrapper{Settings: s})
+	if err != nil {
+		return err
+	}
+
+	return ws.requester.UpdateWorkspace(ws.workspace, content)
+}
+
+// Delete disables the workspace specific settings, so the workspace falls back to the global settings
+func (ws WorkspaceSettings) Delete() error {
+	if err := validator.Name(ws.workspace); err != nil {
+		return err
+	}
+
+	return ws.requester.DeleteWorkspace(ws.workspace)
+}
+
+func validateSettings(s *settings.Settings) error {
+	if s == nil {
+		return nil
+	}
+
+	if s.ProxyBaseURL != nil {
+		if err := validator.Settings.ProxyBaseURL(*s.ProxyBaseURL); err != nil {
+			return err
+		}
+	}
+
+	if s.NumDecimals != nil {
+		if err := validator.Settings.NumDecimals(*s.NumDecimals); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package actions
+
+import (
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/requester"
+	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/styles"
+)
+
+// Styles manages the styles written as SLD 1.0 documents. Styles in other languages, such as CSS, can be listed but not
+// created or updated.
+type Styles struct {
+	requester requester.StyleRequester
+}
+
+func NewStyleActions(data internal.GeoserverData) Styles {
+	return Styles{
+		requester: requester.NewStyleRequester(data),
+	}
+}
+
+func (s Styles) Get(name string) (*styles.Style, error) {
+	if err := validator.Style.Name(name); err != nil {
+		return nil, err
+	}
+
+	return s.requester.Get(name)
+}
+
+// GetAll lists the styles of the workspace, or the global styles when no workspace is selected
+func (s Styles) GetAll() (*styles.Styles, error) {
+	return s.requester.GetAll()
+}
+
+// SLD returns the SLD document of the style
+func (s Styles) SLD(name string) ([]byte, error) {
+	if err := validator.Style.Name(name); err != nil {
+		return nil, err
+	}
+
+	return s.requester.GetSLD(name)
+}
+
+// Create uploads the SLD document as a new style of the workspace, or as a global style when no workspace is selected
+func (s Styles) Create(name string, sld []byte) error {
+	if err := validator.Style.Name(name); err != nil {
+		return err
+	}
+
+	if len(sld) == 0 {
+		return customerrors.NewInputError("empty style document")
+	}
+
+	return s.requester.Create(name, sld)
+}
+
+// Update replaces the SLD document of the style
+func (s Styles) Update(name string, sld []byte) error {
+	if err := validator.Style.Name(name); err != nil {
+		return err
+	}
+
+	if len(sld) == 0 {
+		return customerrors.NewInputError("empty style document")
+	}
+
+	return s.requester.Update(name, sld)
+}
+
+// Delete removes the style. With recurse, the layers using the style fall back to their default style instead of
+// preventing the delete.
+func (s Styles) Delete(name string, recurse bool) error {
+	if err := validator.Style.Name(name); err != nil {
+		return err
+	}
+
+	return s.requester.Delete(name, recurse)
+}
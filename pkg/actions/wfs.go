@@ -0,0 +1,42 @@
+package actions
+
+import (
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/requester"
+	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"strings"
+)
+
+type WFS struct {
+	data      internal.GeoserverData
+	requester requester.WFSRequester
+}
+
+func NewWFSActions(data internal.GeoserverData) WFS {
+	return WFS{
+		data:      data,
+		requester: requester.NewWFSRequester(data),
+	}
+}
+
+// GetFeature returns the features of the feature types as a GeoJSON feature collection.
+// Type names without a workspace prefix are qualified with the workspace.
+func (wf WFS) GetFeature(typeNames []string, options ...options.GetFeatureOption) ([]byte, error) {
+	qualified := make([]string, 0, len(typeNames))
+	for _, typeName := range typeNames {
+		if !strings.HasPrefix(typeName, wf.data.Workspace+":") {
+			typeName = fmt.Sprintf("%s:%s", wf.data.Workspace, typeName)
+		}
+
+		err := validator.WorkspaceLayerFormat(wf.data.Workspace, typeName)
+		if err != nil {
+			return nil, err
+		}
+
+		qualified = append(qualified, typeName)
+	}
+
+	return wf.requester.GetFeature(qualified, options...)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/canghel3/go-geoserver/internal"
 	"github.com/canghel3/go-geoserver/internal/requester"
 	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/options"
 	"github.com/canghel3/go-geoserver/pkg/shared"
 	"github.com/canghel3/go-geoserver/pkg/wms"
 	"golang.org/x/image/tiff"
@@ -53,7 +54,7 @@ func NewWMSActions(data internal.GeoserverData, version wms.WMSVersion) WMS {
 //	}
 //}
 
-func (wm WMS) GetMap(width, height uint16, layers []string, bbox shared.BBOX) MapFormats {
+func (wm WMS) GetMap(width, height uint16, layers []string, bbox shared.BBOX, options ...options.GetMapOption) MapFormats {
 	return MapFormats{
 		workspace: wm.data.Workspace,
 		width:     width,
@@ -61,6 +62,7 @@ func (wm WMS) GetMap(width, height uint16, layers []string, bbox shared.BBOX) Ma
 		bbox:      bbox,
 		layers:    layers,
 		version:   wm.version,
+		options:   options,
 		requester: wm.requester,
 	}
 }
@@ -72,6 +74,7 @@ type MapFormats struct {
 	layers    []string
 	bbox      shared.BBOX
 	version   wms.WMSVersion
+	options   []options.GetMapOption
 	requester requester.WMSRequester
 }
 
@@ -87,7 +90,7 @@ func (mf MapFormats) Png() (image.Image, error) {
 		}
 	}
 
-	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.PNG)
+	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.PNG, mf.options...)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +110,7 @@ func (mf MapFormats) Png8() (image.Image, error) {
 		}
 	}
 
-	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.PNG8)
+	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.PNG8, mf.options...)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +130,7 @@ func (mf MapFormats) Jpeg() (image.Image, error) {
 		}
 	}
 
-	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.JPEG)
+	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.JPEG, mf.options...)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +150,7 @@ func (mf MapFormats) JpegPng() (image.Image, error) {
 		}
 	}
 
-	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.JPEG_PNG)
+	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.JPEG_PNG, mf.options...)
 	if err != nil {
 		return nil, err
 	}
@@ -167,7 +170,7 @@ func (mf MapFormats) JpegPng8() (image.Image, error) {
 		}
 	}
 
-	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.JPEG_PNG8)
+	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.JPEG_PNG8, mf.options...)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +190,7 @@ func (mf MapFormats) Gif() (image.Image, error) {
 		}
 	}
 
-	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.GIF)
+	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.GIF, mf.options...)
 	if err != nil {
 		return nil, err
 	}
@@ -207,7 +210,7 @@ func (mf MapFormats) Tiff() (image.Image, error) {
 		}
 	}
 
-	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.TIFF)
+	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.TIFF, mf.options...)
 	if err != nil {
 		return nil, err
 	}
@@ -227,7 +230,7 @@ func (mf MapFormats) Tiff8() (image.Image, error) {
 		}
 	}
 
-	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.TIFF8)
+	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.TIFF8, mf.options...)
 	if err != nil {
 		return nil, err
 	}
@@ -247,7 +250,7 @@ func (mf MapFormats) GeoTiff() (image.Image, error) {
 		}
 	}
 
-	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.GeoTIFF)
+	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.GeoTIFF, mf.options...)
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +270,7 @@ func (mf MapFormats) GeoTiff8() (image.Image, error) {
 		}
 	}
 
-	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.GeoTIFF8)
+	content, err := mf.requester.GetMap(mf.width, mf.height, mf.layers, mf.bbox, mf.version, wms.GeoTIFF8, mf.options...)
 	if err != nil {
 		return nil, err
 	}
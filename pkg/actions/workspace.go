@@ -2,9 +2,12 @@ package actions
 
 import (
 	"encoding/json"
+	"errors"
 	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/internal/models"
 	"github.com/canghel3/go-geoserver/internal/requester"
 	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/options"
 	"github.com/canghel3/go-geoserver/pkg/wms"
 	"github.com/canghel3/go-geoserver/pkg/workspace"
 )
@@ -21,19 +24,35 @@ func NewWorkspaceActions(info internal.GeoserverData) Workspaces {
 	}
 }
 
-func (ws Workspaces) Create(name string, _default bool) error {
+// Create a workspace. The namespace with the same name is created by GeoServer alongside the workspace.
+// When a uri is given, the namespace is posted instead, GeoServer creating the workspace with it in a single request.
+// A workspace created this way which cannot be made the default one is deleted again.
+func (ws Workspaces) Create(name string, _default bool, options ...options.WorkspaceOption) error {
 	err := validator.Name(name)
 	if err != nil {
 		return err
 	}
 
-	data := workspace.WorkspaceCreationWrapper{
-		Workspace: workspace.Creation{
-			Name: name,
-		},
+	data := models.Workspace{
+		Name: name,
 	}
 
-	content, err := json.Marshal(data)
+	for _, option := range options {
+		option(&data)
+	}
+
+	if len(data.URI) > 0 {
+		err = validator.Namespace.URI(data.URI)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(data.URI) > 0 {
+		return ws.createNamespace(data, _default)
+	}
+
+	content, err := json.Marshal(models.WorkspaceWrapper{Workspace: data})
 	if err != nil {
 		return err
 	}
@@ -49,7 +68,8 @@ func (ws Workspaces) GetAll() ([]workspace.MultiWorkspace, error) {
 	return ws.requester.GetAll()
 }
 
-func (ws Workspaces) Update(oldName, newName string) error {
+// Update renames the workspace. Pass the same name as oldName and newName to only change the options.
+func (ws Workspaces) Update(oldName, newName string, options ...options.WorkspaceOption) error {
 	err := validator.Name(oldName)
 	if err != nil {
 		return err
@@ -60,24 +80,96 @@ func (ws Workspaces) Update(oldName, newName string) error {
 		return err
 	}
 
-	data := workspace.WorkspaceUpdateWrapper{
-		Workspace: workspace.WorkspaceUpdate{
-			Name: newName,
-		},
+	data := models.Workspace{
+		Name: newName,
+	}
+
+	for _, option := range options {
+		option(&data)
+	}
+
+	if len(data.URI) > 0 {
+		err = validator.Namespace.URI(data.URI)
+		if err != nil {
+			return err
+		}
+	}
+
+	content, err := json.Marshal(models.WorkspaceWrapper{Workspace: data})
+	if err != nil {
+		return err
 	}
 
-	content, err := json.Marshal(data)
+	err = ws.requester.Update(content, oldName)
 	if err != nil {
 		return err
 	}
 
-	return ws.requester.Update(content, oldName)
+	return ws.updateNamespaceURI(data)
 }
 
 func (ws Workspaces) Delete(name string, recurse bool) error {
 	return ws.requester.Delete(name, recurse)
 }
 
+// Default retrieves the workspace used when a resource is referenced without a workspace
+func (ws Workspaces) Default() (*workspace.Workspace, error) {
+	return ws.requester.GetDefault()
+}
+
+// SetDefault makes the workspace, and its namespace, the default ones
+func (ws Workspaces) SetDefault(name string) error {
+	err := validator.Name(name)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(models.WorkspaceWrapper{Workspace: models.Workspace{Name: name}})
+	if err != nil {
+		return err
+	}
+
+	return ws.requester.SetDefault(content, name)
+}
+
+// createNamespace creates the workspace through its namespace, since only the namespace holds the uri
+func (ws Workspaces) createNamespace(data models.Workspace, _default bool) error {
+	content, err := json.Marshal(models.NamespaceDefinitionWrapper{Namespace: models.NamespaceDefinition{Prefix: data.Name, URI: data.URI, Isolated: data.Isolated}})
+	if err != nil {
+		return err
+	}
+
+	err = requester.NewNamespaceRequester(ws.data.Clone()).Create(content)
+	if err != nil {
+		return err
+	}
+
+	if !_default {
+		return nil
+	}
+
+	err = ws.SetDefault(data.Name)
+	if err != nil {
+		return errors.Join(err, ws.requester.Delete(data.Name, false))
+	}
+
+	return nil
+}
+
+// updateNamespaceURI sets the uri of the namespace belonging to the workspace, since the workspace does not hold it
+func (ws Workspaces) updateNamespaceURI(data models.Workspace) error {
+	if len(data.URI) == 0 {
+		return nil
+	}
+
+	content, err := json.Marshal(models.NamespaceDefinitionWrapper{Namespace: models.NamespaceDefinition{Prefix: data.Name, URI: data.URI}})
+	if err != nil {
+		return err
+	}
+
+	return requester.NewNamespaceRequester(ws.data.Clone()).Update(data.Name, content)
+}
+
 type Workspace struct {
 	data internal.GeoserverData
 }
@@ -113,6 +205,52 @@ func (w Workspace) WMS(version wms.WMSVersion) WMS {
 	return NewWMSActions(w.data.Clone(), version)
 }
 
+// WFS queries the feature types of the workspace through the web feature service
+func (w Workspace) WFS() WFS {
+	return NewWFSActions(w.data.Clone())
+}
+
 func (w Workspace) LayerGroups() LayerGroups {
 	return NewLayerGroup(w.data.Clone())
 }
+
+func (w Workspace) Layers() Layers {
+	return NewLayerActions(w.data.Clone())
+}
+
+func (w Workspace) Styles() Styles {
+	return NewStyleActions(w.data.Clone())
+}
+
+// WMSStores manages the stores cascading remote WMS servers.
+func (w Workspace) WMSStores() CascadedStores {
+	return newCascadedStoresActions(w.data.Clone(), requester.CascadedWMS)
+}
+
+// WMSStore is shorthand for WMSStores().Use(name)
+func (w Workspace) WMSStore(name string) CascadedLayers {
+	return newCascadedStoresActions(w.data.Clone(), requester.CascadedWMS).Use(name)
+}
+
+// WMTSStores manages the stores cascading remote WMTS servers.
+func (w Workspace) WMTSStores() CascadedStores {
+	return newCascadedStoresActions(w.data.Clone(), requester.CascadedWMTS)
+}
+
+// WMTSStore is shorthand for WMTSStores().Use(name)
+func (w Workspace) WMTSStore(name string) CascadedLayers {
+	return newCascadedStoresActions(w.data.Clone(), requester.CascadedWMTS).Use(name)
+}
+
+// Services manages the settings of the OGC services overriding the global ones inside the workspace.
+func (w Workspace) Services() WorkspaceServices {
+	return WorkspaceServices{
+		workspace: w.data.Workspace,
+		services:  NewServicesActions(w.data.Clone()),
+	}
+}
+
+// Settings is shorthand for Settings().Workspace(name) on the client
+func (w Workspace) Settings() WorkspaceSettings {
+	return NewSettingsActions(w.data.Clone()).Workspace(w.data.Workspace)
+}
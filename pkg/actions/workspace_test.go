@@ -0,0 +1,78 @@
+package actions_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/canghel3/go-geoserver/pkg/geoservertest"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaces_Create(t *testing.T) {
+	tests := []struct {
+		name     string
+		_default bool
+		options  []options.WorkspaceOption
+		requests []string
+	}{
+		{
+			name:     "Without URI",
+			requests: []string{"POST /workspaces"},
+		},
+		{
+			name:     "With URI",
+			options:  []options.WorkspaceOption{options.Workspace.URI("http://roads.org"), options.Workspace.Isolated(true)},
+			requests: []string{"POST /namespaces"},
+		},
+		{
+			name:     "Default With URI",
+			_default: true,
+			options:  []options.WorkspaceOption{options.Workspace.URI("http://roads.org")},
+			requests: []string{"POST /namespaces", "PUT /workspaces/default"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := geoservertest.NewServer()
+			defer server.Close()
+			gc := server.Client()
+
+			require.NoError(t, gc.Workspaces().Create("water", false))
+
+			require.NoError(t, gc.Workspaces().Create("roads", test._default, test.options...))
+			// the first request created the water workspace
+			assert.Equal(t, test.requests, server.Requests()[1:])
+
+			namespace, err := gc.Namespaces().Get("roads")
+			require.NoError(t, err)
+			if len(test.options) > 0 {
+				assert.Equal(t, "http://roads.org", namespace.URI)
+			}
+
+			ws, err := gc.Workspaces().Default()
+			require.NoError(t, err)
+			if test._default {
+				assert.Equal(t, "roads", ws.Name)
+			} else {
+				assert.Equal(t, "water", ws.Name)
+			}
+		})
+	}
+}
+
+func TestWorkspaces_Create_DefaultFailure(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+	gc := server.Client()
+
+	server.Inject(geoservertest.Failure{Method: http.MethodPut, Path: "/workspaces/default"})
+
+	err := gc.Workspaces().Create("roads", true, options.Workspace.URI("http://roads.org"))
+	assert.Error(t, err)
+
+	_, err = gc.Workspaces().Get("roads")
+	assert.Error(t, err, "the workspace is deleted when it cannot be made the default one")
+}
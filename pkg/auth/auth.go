@@ -0,0 +1,113 @@
+// Package auth provides the authenticators used by the client to add credentials to each request sent to GeoServer.
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Authenticator adds credentials to a request before it is sent to GeoServer.
+// Implementations must be safe for concurrent use.
+type Authenticator interface {
+	Authenticate(request *http.Request) error
+}
+
+// Func adapts a function to the Authenticator interface.
+type Func func(request *http.Request) error
+
+func (f Func) Authenticate(request *http.Request) error {
+	return f(request)
+}
+
+// Basic authenticates with a username and password, the default of GeoServer.
+func Basic(username, password string) Authenticator {
+	return Func(func(request *http.Request) error {
+		request.SetBasicAuth(username, password)
+		return nil
+	})
+}
+
+// Bearer sends a static token in the Authorization header, e.g. to a GeoServer behind an OAuth2/OIDC proxy.
+func Bearer(token string) Authenticator {
+	return Header("Authorization", "Bearer "+token)
+}
+
+// AuthKey sends the key in the authkey query parameter, as expected by the GeoServer authkey module.
+func AuthKey(key string) Authenticator {
+	return AuthKeyParameter("authkey", key)
+}
+
+// AuthKeyParameter sends the key in a custom query parameter, for authkey filters configured with a different parameter name.
+func AuthKeyParameter(parameter, key string) Authenticator {
+	return Func(func(request *http.Request) error {
+		query := request.URL.Query()
+		query.Set(parameter, key)
+		request.URL.RawQuery = query.Encode()
+		return nil
+	})
+}
+
+// Header sends a fixed header, e.g. the user header of a GeoServer behind a proxy performing the authentication.
+func Header(name, value string) Authenticator {
+	return Func(func(request *http.Request) error {
+		request.Header.Set(name, value)
+		return nil
+	})
+}
+
+// TokenSource returns a new token and the moment it expires. A zero expiry means the token never expires.
+type TokenSource func() (token string, expiry time.Time, err error)
+
+// RefreshingBearer sends a bearer token obtained from source. The token is requested on the first request
+// and requested again once it is about to expire.
+func RefreshingBearer(source TokenSource) Authenticator {
+	return &refreshingBearer{
+		source: source,
+		leeway: 30 * time.Second,
+		now:    time.Now,
+	}
+}
+
+type refreshingBearer struct {
+	source TokenSource
+	leeway time.Duration
+	now    func() time.Time
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func (rb *refreshingBearer) Authenticate(request *http.Request) error {
+	token, err := rb.current()
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func (rb *refreshingBearer) current() (string, error) {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	if rb.token != "" && (rb.expiry.IsZero() || rb.now().Add(rb.leeway).Before(rb.expiry)) {
+		return rb.token, nil
+	}
+
+	token, expiry, err := rb.source()
+	if err != nil {
+		return "", err
+	}
+
+	if token == "" {
+		return "", errors.New("token source returned an empty token")
+	}
+
+	rb.token = token
+	rb.expiry = expiry
+	return rb.token, nil
+}
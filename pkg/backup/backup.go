@@ -0,0 +1,268 @@
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal/jsonutil"
+	"strconv"
+	"strings"
+)
+
+// Status is the state of a backup or restore execution, as reported by the underlying Spring Batch job.
+type Status string
+
+const (
+	StatusStarting  Status = "STARTING"
+	StatusStarted   Status = "STARTED"
+	StatusStopping  Status = "STOPPING"
+	StatusStopped   Status = "STOPPED"
+	StatusFailed    Status = "FAILED"
+	StatusCompleted Status = "COMPLETED"
+	StatusAbandoned Status = "ABANDONED"
+	StatusUnknown   Status = "UNKNOWN"
+)
+
+// Done reports whether the execution will no longer change state.
+func (s Status) Done() bool {
+	switch s {
+	case StatusCompleted, StatusFailed, StatusStopped, StatusAbandoned:
+		return true
+	default:
+		return false
+	}
+}
+
+// Execution describes a backup or restore started through the backup-restore extension.
+type Execution struct {
+	TotalNumberOfSteps int          `json:"totalNumberOfSteps"`
+	Execution          JobExecution `json:"execution"`
+	Options            Messages     `json:"options"`
+	Warnings           Messages     `json:"warningsList"`
+	ArchiveFile        ArchiveFile  `json:"archiveFile"`
+	Overwrite          bool         `json:"overwrite"`
+	WorkspaceFilter    string       `json:"wsFilter"`
+}
+
+// Err returns an *ExecutionError when the execution failed, was stopped or was abandoned, and nil otherwise.
+func (e Execution) Err() error {
+	switch e.Execution.Status {
+	case StatusFailed, StatusStopped, StatusAbandoned:
+	default:
+		return nil
+	}
+
+	ee := &ExecutionError{
+		ID:          e.Execution.ID,
+		Status:      e.Execution.Status,
+		ExitCode:    e.Execution.ExitStatus.ExitCode,
+		Description: e.Execution.ExitStatus.ExitDescription,
+	}
+
+	ee.Failures = append(ee.Failures, e.Execution.FailureExceptions...)
+	for _, step := range e.Execution.Steps.Step {
+		ee.Failures = append(ee.Failures, step.FailureExceptions...)
+	}
+
+	return ee
+}
+
+type JobExecution struct {
+	ID                int64      `json:"id"`
+	Version           int        `json:"version"`
+	Status            Status     `json:"status"`
+	StartTime         string     `json:"startTime,omitempty"`
+	EndTime           string     `json:"endTime,omitempty"`
+	LastUpdated       string     `json:"lastUpdated,omitempty"`
+	ExitStatus        ExitStatus `json:"exitStatus"`
+	Progress          Progress   `json:"progress"`
+	Steps             Steps      `json:"stepExecutions"`
+	FailureExceptions Messages   `json:"failureExceptions"`
+}
+
+type ExitStatus struct {
+	ExitCode        string `json:"exitCode"`
+	ExitDescription string `json:"exitDescription"`
+}
+
+// Steps holds the executions of the individual steps (namespaces, workspaces, stores, ...) of the job.
+type Steps struct {
+	Step []Step `json:"step"`
+}
+
+// UnmarshalJSON handles GeoServer responding with an empty string when no step has run yet.
+func (s *Steps) UnmarshalJSON(data []byte) error {
+	s.Step = nil
+
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		return nil
+	}
+
+	var raw struct {
+		Step json.RawMessage `json:"step"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return jsonutil.OneOrMany(raw.Step, &s.Step)
+}
+
+type Step struct {
+	Name              string     `json:"name"`
+	Status            Status     `json:"status"`
+	ExitStatus        ExitStatus `json:"exitStatus"`
+	StartTime         string     `json:"startTime,omitempty"`
+	EndTime           string     `json:"endTime,omitempty"`
+	LastUpdated       string     `json:"lastUpdated,omitempty"`
+	ReadCount         int        `json:"readCount"`
+	WriteCount        int        `json:"writeCount"`
+	FailureExceptions Messages   `json:"failureExceptions"`
+}
+
+// Progress is the number of completed steps out of the total, reported by GeoServer as "3/9".
+type Progress struct {
+	Completed int
+	Total     int
+}
+
+func (p *Progress) UnmarshalJSON(data []byte) error {
+	*p = Progress{}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		return nil
+	}
+
+	completed, total, found := strings.Cut(s, "/")
+	if !found {
+		return fmt.Errorf("invalid progress %q", s)
+	}
+
+	var err error
+	if p.Completed, err = strconv.Atoi(strings.TrimSpace(completed)); err != nil {
+		return fmt.Errorf("invalid progress %q", s)
+	}
+
+	if p.Total, err = strconv.Atoi(strings.TrimSpace(total)); err != nil {
+		return fmt.Errorf("invalid progress %q", s)
+	}
+
+	return nil
+}
+
+func (p Progress) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+func (p Progress) String() string {
+	return fmt.Sprintf("%d/%d", p.Completed, p.Total)
+}
+
+// ArchiveFile is the zip archive written by a backup or read by a restore, as a path on the GeoServer host.
+type ArchiveFile struct {
+	File string `json:"file"`
+}
+
+// UnmarshalJSON handles the archive file being returned either as a plain path or as a resource object.
+func (af *ArchiveFile) UnmarshalJSON(data []byte) error {
+	af.File = ""
+
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		af.File = s
+		return nil
+	}
+
+	var raw struct {
+		File string `json:"file"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	af.File = raw.File
+	return nil
+}
+
+// Messages is a list of options, warnings or failures. GeoServer serializes these as an empty string,
+// a single value, a list of values or an object wrapping such a list (e.g. {"option": [...]}).
+type Messages []string
+
+func (m *Messages) UnmarshalJSON(data []byte) error {
+	*m = nil
+	return m.collect(data)
+}
+
+func (m *Messages) collect(data json.RawMessage) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	switch data[0] {
+	case '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+
+		if s != "" {
+			*m = append(*m, s)
+		}
+	case '[':
+		var values []json.RawMessage
+		if err := json.Unmarshal(data, &values); err != nil {
+			return err
+		}
+
+		for _, value := range values {
+			if err := m.collect(value); err != nil {
+				return err
+			}
+		}
+	case '{':
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(data, &values); err != nil {
+			return err
+		}
+
+		// a single entry wraps the actual list, anything else is a structured message kept as is
+		if len(values) == 1 {
+			for _, value := range values {
+				return m.collect(value)
+			}
+		}
+
+		*m = append(*m, string(data))
+	default:
+		*m = append(*m, string(data))
+	}
+
+	return nil
+}
+
+// ExecutionError is returned when a backup or restore did not complete.
+type ExecutionError struct {
+	ID          int64
+	Status      Status
+	ExitCode    string
+	Description string
+	Failures    []string
+}
+
+func (ee *ExecutionError) Error() string {
+	msg := fmt.Sprintf("execution %d ended with status %s", ee.ID, ee.Status)
+	if ee.Description != "" {
+		msg += ": " + ee.Description
+	}
+
+	if len(ee.Failures) > 0 {
+		msg += " (" + strings.Join(ee.Failures, "; ") + ")"
+	}
+
+	return msg
+}
@@ -0,0 +1,125 @@
+// Package cascaded holds the stores cascading remote WMS and WMTS servers and the layers published from them, which
+// GeoServer describes with the same fields.
+package cascaded
+
+import (
+	"encoding/json"
+
+	"github.com/canghel3/go-geoserver/internal/jsonutil"
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/canghel3/go-geoserver/pkg/shared"
+	"github.com/canghel3/go-geoserver/pkg/workspace"
+)
+
+// NewLayer creates a layer to be published from a WMS or WMTS store. The nativeName is the name of the layer on the
+// remote server.
+func NewLayer(name, nativeName string, options ...options.CascadedLayerOption) models.CascadedLayer {
+	l := new(models.CascadedLayer)
+	l.Name = name
+	l.NativeName = nativeName
+	l.Enabled = true
+
+	for _, option := range options {
+		option(l)
+	}
+
+	return *l
+}
+
+type Stores struct {
+	Entries []Entry
+}
+
+type Layers struct {
+	Entries []Entry
+}
+
+type Entry struct {
+	Name string `json:"name"`
+	Href string `json:"href"`
+}
+
+// UnmarshalJSON reads the entries of the list, which GeoServer wraps in a key named after the kind of the store
+func (s *Stores) UnmarshalJSON(data []byte) error {
+	entries, err := unmarshalEntries(data)
+	s.Entries = entries
+	return err
+}
+
+// UnmarshalJSON reads the entries of the list, which GeoServer wraps in a key named after the kind of the store
+func (l *Layers) UnmarshalJSON(data []byte) error {
+	entries, err := unmarshalEntries(data)
+	l.Entries = entries
+	return err
+}
+
+// unmarshalEntries reads the single key holding the entries, GeoServer responding with an empty string instead of an
+// empty list and with the entry alone instead of a list of one
+func unmarshalEntries(data []byte) ([]Entry, error) {
+	var empty string
+	if err := json.Unmarshal(data, &empty); err == nil {
+		return []Entry{}, nil
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	entries := []Entry{}
+	for _, raw := range m {
+		var e []Entry
+		if err := jsonutil.OneOrMany(raw, &e); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, e...)
+	}
+
+	return entries, nil
+}
+
+type Store struct {
+	Name            string                   `json:"name,omitempty"`
+	Description     string                   `json:"description,omitempty"`
+	Type            string                   `json:"type,omitempty"`
+	Enabled         bool                     `json:"enabled"`
+	Workspace       workspace.MultiWorkspace `json:"workspace,omitempty"`
+	CapabilitiesURL string                   `json:"capabilitiesURL"`
+	User            string                   `json:"user,omitempty"`
+	Password        string                   `json:"password,omitempty"`
+	MaxConnections  int                      `json:"maxConnections,omitempty"`
+	ReadTimeout     int                      `json:"readTimeout,omitempty"`
+	ConnectTimeout  int                      `json:"connectTimeout,omitempty"`
+	DateCreated     string                   `json:"dateCreated,omitempty"`
+	DateModified    string                   `json:"dateModified,omitempty"`
+}
+
+type Layer struct {
+	Name              string              `json:"name"`
+	NativeName        string              `json:"nativeName"`
+	Namespace         Namespace           `json:"namespace"`
+	Title             string              `json:"title,omitempty"`
+	Abstract          string              `json:"abstract,omitempty"`
+	Keywords          *shared.Keywords    `json:"keywords,omitempty"`
+	NativeCRS         *shared.CRSClass    `json:"nativeCRS,omitempty"`
+	Srs               string              `json:"srs,omitempty"`
+	NativeBoundingBox *shared.BoundingBox `json:"nativeBoundingBox,omitempty"`
+	LatLonBoundingBox *shared.BoundingBox `json:"latLonBoundingBox,omitempty"`
+	ProjectionPolicy  string              `json:"projectionPolicy,omitempty"`
+	Enabled           bool                `json:"enabled"`
+	Store             StoreReference      `json:"store"`
+}
+
+type Namespace struct {
+	Name string `json:"name"`
+	Href string `json:"href"`
+}
+
+// StoreReference points a layer to its store, Class being wmsStore or wmtsStore
+type StoreReference struct {
+	Class string `json:"@class"`
+	Name  string `json:"name"`
+	Href  string `json:"href"`
+}
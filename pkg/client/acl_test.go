@@ -0,0 +1,95 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/security"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestACLIntegration_Layers(t *testing.T) {
+	addTestWorkspace(t)
+
+	layers := geoclient.Security().ACL().Layers()
+	rule := security.LayerRule(testdata.Workspace, security.Any, security.Read, "ROLE_TENANT")
+	layers.Delete(rule.Key)
+
+	t.Run("Add", func(t *testing.T) {
+		err := layers.Add(rule)
+		assert.NoError(t, err)
+
+		rules, err := layers.GetAll()
+		assert.NoError(t, err)
+		assert.Contains(t, rules, rule)
+	})
+
+	t.Run("Already Exists", func(t *testing.T) {
+		err := layers.Add(rule)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		rule.Roles = []string{"ROLE_TENANT", "ADMIN"}
+		err := layers.Update(rule)
+		assert.NoError(t, err)
+
+		rules, err := layers.GetAll()
+		assert.NoError(t, err)
+		assert.Contains(t, rules, rule)
+	})
+
+	t.Run("Invalid Key", func(t *testing.T) {
+		err := layers.Add(security.AccessRule{Key: "*.states.r", Roles: []string{"ADMIN"}})
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.InputError{}, err)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := layers.Delete(rule.Key)
+		assert.NoError(t, err)
+
+		err = layers.Delete(rule.Key)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+	})
+}
+
+func TestACLIntegration_Services(t *testing.T) {
+	services := geoclient.Security().ACL().Services()
+	rule := security.ServiceRule("wfs", "Transaction", "ADMIN")
+	services.Delete(rule.Key)
+
+	err := services.Add(rule)
+	assert.NoError(t, err)
+
+	rules, err := services.GetAll()
+	assert.NoError(t, err)
+	assert.Contains(t, rules, rule)
+
+	err = services.Delete(rule.Key)
+	assert.NoError(t, err)
+}
+
+func TestACLIntegration_CatalogMode(t *testing.T) {
+	acl := geoclient.Security().ACL()
+
+	previous, err := acl.CatalogMode()
+	assert.NoError(t, err)
+
+	err = acl.SetCatalogMode(security.Challenge)
+	assert.NoError(t, err)
+
+	mode, err := acl.CatalogMode()
+	assert.NoError(t, err)
+	assert.Equal(t, security.Challenge, *mode)
+
+	err = acl.SetCatalogMode("SHOW")
+	assert.Error(t, err)
+	assert.IsType(t, &customerrors.InputError{}, err)
+
+	err = acl.SetCatalogMode(*previous)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/backup"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupIntegration(t *testing.T) {
+	archive := testdata.GeoserverDataDir + "/backup.zip"
+
+	execution, err := geoclient.Backup().Start(archive, options.Backup.Overwrite(), options.Backup.Cleanup(), options.Backup.Workspaces(testdata.Workspace))
+	var notFound *customerrors.NotFoundError
+	if errors.As(err, &notFound) {
+		t.Skip("backup-restore extension not installed")
+	}
+
+	t.Run("Start", func(t *testing.T) {
+		assert.NoError(t, err)
+		assert.NotNil(t, execution)
+	})
+
+	t.Run("Invalid Archive", func(t *testing.T) {
+		execution, err := geoclient.Backup().Start(testdata.GeoserverDataDir + "/backup.tar")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.InputError{}, err)
+		assert.Nil(t, execution)
+	})
+
+	t.Run("Wait", func(t *testing.T) {
+		done, err := geoclient.Backup().Wait(execution.Execution.ID, 5*time.Minute, time.Second)
+		assert.NoError(t, err)
+		assert.Equal(t, backup.StatusCompleted, done.Execution.Status)
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		content, err := geoclient.Backup().Download(execution.Execution.ID)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, content)
+	})
+
+	t.Run("Restore Dry Run", func(t *testing.T) {
+		restore, err := geoclient.Backup().Restore().Start(archive, options.Restore.DryRun())
+		assert.NoError(t, err)
+
+		done, err := geoclient.Backup().Restore().Wait(restore.Execution.ID, 5*time.Minute, time.Second)
+		assert.NoError(t, err)
+		assert.Equal(t, backup.StatusCompleted, done.Execution.Status)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		execution, err := geoclient.Backup().Get(99999)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.Nil(t, execution)
+	})
+}
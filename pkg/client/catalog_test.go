@@ -0,0 +1,26 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogIntegration(t *testing.T) {
+	t.Run("Reset", func(t *testing.T) {
+		err := geoclient.Catalog().Reset()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Reload", func(t *testing.T) {
+		err := geoclient.Catalog().Reload(options.Catalog.WaitUntilHealthy(time.Minute, time.Second))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Wait Until Healthy", func(t *testing.T) {
+		err := geoclient.About().WaitUntilHealthy(time.Minute, time.Second)
+		assert.NoError(t, err)
+	})
+}
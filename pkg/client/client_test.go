@@ -4,7 +4,9 @@ import (
 	"crypto/tls"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/canghel3/go-geoserver/pkg/auth"
 	"github.com/canghel3/go-geoserver/pkg/options"
 	"github.com/stretchr/testify/assert"
 )
@@ -24,6 +26,25 @@ func ExampleNewGeoserverClient() {
 		options.Client.HttpClient(&http.Client{}),
 	)
 
+	// Initialize behind an OAuth2/OIDC proxy, the username and password are not used
+	client = NewGeoserverClient(
+		"http://localhost:8080",
+		"",
+		"",
+		options.Client.Authenticator(auth.RefreshingBearer(func() (string, time.Time, error) {
+			// request a token from the identity provider
+			return "token", time.Now().Add(time.Hour), nil
+		})),
+	)
+
+	// Initialize with the authkey module
+	client = NewGeoserverClient(
+		"http://localhost:8080",
+		"",
+		"",
+		options.Client.Authenticator(auth.AuthKey("ef426c21-8b9c-4f1f-a28d-0dd4b8b2f6a1")),
+	)
+
 	_ = client
 }
 
@@ -59,5 +80,21 @@ func TestNewGeoserverClient(t *testing.T) {
 			assert.NotNil(t, client)
 			assert.Equal(t, httpClient, client.data.Client)
 		})
+
+		t.Run("Authenticator", func(t *testing.T) {
+			client := NewGeoserverClient(
+				"http://localhost:8080",
+				"",
+				"",
+				options.Client.Authenticator(auth.Bearer("token")),
+			)
+
+			request, err := http.NewRequest(http.MethodGet, "http://localhost:8080/geoserver/rest/about/version", nil)
+			assert.NoError(t, err)
+
+			err = client.data.Authenticate(request)
+			assert.NoError(t, err)
+			assert.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+		})
 	})
 }
@@ -2,12 +2,15 @@ package client
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/canghel3/go-geoserver/internal/testdata"
 	"github.com/canghel3/go-geoserver/pkg/coverages"
 	"github.com/canghel3/go-geoserver/pkg/customerrors"
 	"github.com/canghel3/go-geoserver/pkg/formats"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/canghel3/go-geoserver/pkg/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,6 +48,33 @@ func TestCoverageIntegration_Update(t *testing.T) {
 		assert.Equal(t, testdata.CoverageGeoTiffName+"_2", cvg.Name)
 	})
 
+	t.Run("With Options", func(t *testing.T) {
+		var name = testdata.CoverageGeoTiffName + "_2"
+
+		err := geoclient.Workspace(testdata.Workspace).CoverageStore(testdata.CoverageStoreGeoTiff).Update(name, coverages.New(name, testdata.CoverageGeoTiffNativeName,
+			options.Coverage.Title("sample with options"),
+			options.Coverage.Abstract("sample abstract"),
+			options.Coverage.Keywords("raster", "sample"),
+			options.Coverage.ProjectionPolicy(types.ForceDeclared),
+			options.Coverage.InterpolationMethods(types.InterpolationBilinear, types.InterpolationNearestNeighbor, types.InterpolationBilinear),
+			options.Coverage.SupportedFormats("GEOTIFF", "PNG"),
+			options.Coverage.ResponseSRS("EPSG:4326", "EPSG:3857"),
+			options.Coverage.Band("RED_BAND", options.Band.NoData(0), options.Band.Range(0, 65535)),
+			options.Coverage.Band("GREEN_BAND", options.Band.NoData(0), options.Band.Range(0, 65535)),
+			options.Coverage.Band("BLUE_BAND", options.Band.NoData(0), options.Band.Range(math.Inf(-1), math.Inf(1))),
+		))
+		assert.NoError(t, err)
+
+		cvg, err := geoclient.Workspace(testdata.Workspace).CoverageStore(testdata.CoverageStoreGeoTiff).Get(name)
+		assert.NoError(t, err)
+		assert.Equal(t, "sample with options", *cvg.Title)
+		assert.Equal(t, "sample abstract", *cvg.Abstract)
+		assert.Equal(t, string(types.ForceDeclared), *cvg.ProjectionPolicy)
+		assert.Equal(t, types.InterpolationBilinear, *cvg.DefaultInterpolationMethod)
+		assert.Len(t, cvg.Dimensions.CoverageDimension, 3)
+		assert.Equal(t, []float64{0}, cvg.Dimensions.CoverageDimension[0].NullValues.Double)
+	})
+
 	t.Run("404 Not Found", func(t *testing.T) {
 		err := geoclient.Workspace(testdata.Workspace).CoverageStore(testdata.CoverageStoreGeoTiff).Update(testdata.CoverageGeoTiffName, coverages.New(testdata.CoverageGeoTiffName, testdata.CoverageGeoTiffNativeName))
 		assert.Error(t, err)
@@ -7,7 +7,11 @@ import (
 	"github.com/canghel3/go-geoserver/internal/testdata"
 	"github.com/canghel3/go-geoserver/pkg/customerrors"
 	"github.com/canghel3/go-geoserver/pkg/datastores"
+	"github.com/canghel3/go-geoserver/pkg/datastores/h2"
+	"github.com/canghel3/go-geoserver/pkg/datastores/mysql"
+	"github.com/canghel3/go-geoserver/pkg/datastores/oracle"
 	"github.com/canghel3/go-geoserver/pkg/datastores/postgis"
+	"github.com/canghel3/go-geoserver/pkg/datastores/sqlserver"
 	"github.com/canghel3/go-geoserver/pkg/formats"
 	"github.com/canghel3/go-geoserver/pkg/options"
 	"github.com/stretchr/testify/assert"
@@ -86,6 +90,56 @@ func TestDataStoreIntegration_Create(t *testing.T) {
 					assert.True(t, ok)
 					assert.Equal(t, "true", v)
 				})
+
+				t.Run("SCHEMA AND POOL", func(t *testing.T) {
+					var suffix = "_WITH_SCHEMA_AND_POOL"
+					err := geoclient.Workspace(testdata.Workspace).DataStores().Create().PostGIS(testdata.DatastorePostgis+suffix, postgis.ConnectionParams{
+						Host:     testdata.PostgisHost,
+						Database: testdata.PostgisDb,
+						User:     testdata.PostgisUsername,
+						Password: testdata.PostgisPassword,
+						Port:     testdata.PostgisPort,
+					},
+						options.PostGIS.Schema("public"),
+						options.PostGIS.SSLMode(postgis.SSLDisable),
+						options.PostGIS.MinConnections(2),
+						options.PostGIS.MaxConnections(20),
+						options.PostGIS.FetchSize(500),
+						options.PostGIS.ConnectionTimeout(10),
+						options.PostGIS.MaxOpenPreparedStatements(25),
+						options.PostGIS.LooseBBOX(true),
+						options.PostGIS.EstimatedExtents(false),
+						options.PostGIS.EncodeFunctions(true),
+						options.PostGIS.ExposePrimaryKeys(true),
+						options.PostGIS.PreparedStatements(true),
+					)
+					assert.NoError(t, err)
+
+					store, err := geoclient.Workspace(testdata.Workspace).DataStores().Get(testdata.DatastorePostgis + suffix)
+					assert.NoError(t, err)
+					assert.NotNil(t, store)
+
+					expected := map[string]string{
+						"schema":                       "public",
+						"SSL mode":                     "DISABLE",
+						"min connections":              "2",
+						"max connections":              "20",
+						"fetch size":                   "500",
+						"Connection timeout":           "10",
+						"Max open prepared statements": "25",
+						"Loose bbox":                   "true",
+						"Estimated extends":            "false",
+						"encode functions":             "true",
+						"Expose primary keys":          "true",
+						"preparedStatements":           "true",
+					}
+
+					for key, value := range expected {
+						v, ok := store.ConnectionParameters.Get(key)
+						assert.True(t, ok, key)
+						assert.Equal(t, value, v, key)
+					}
+				})
 			})
 		})
 
@@ -103,6 +157,37 @@ func TestDataStoreIntegration_Create(t *testing.T) {
 				err = geoclient.Workspace(testdata.Workspace).DataStores().Create().Shapefile(testdata.DatastoreShapefile, fmt.Sprintf("file:%s", testdata.FileShapefile))
 				assert.NoError(t, err)
 			})
+
+			t.Run("WITH OPTIONS", func(t *testing.T) {
+				var suffix = "_WITH_OPTIONS"
+				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().Shapefile(testdata.DatastoreShapefile+suffix, fmt.Sprintf("file:%s", testdata.FileShapefile),
+					options.Shapefile.Charset("ISO-8859-5"),
+					options.Shapefile.CreateSpatialIndex(false),
+					options.Shapefile.Memory(true),
+					options.Shapefile.CacheAndReuse(false),
+				)
+				assert.NoError(t, err)
+
+				store, err := geoclient.Workspace(testdata.Workspace).DataStores().Get(testdata.DatastoreShapefile + suffix)
+				assert.NoError(t, err)
+				assert.NotNil(t, store)
+
+				v, ok := store.ConnectionParameters.Get("charset")
+				assert.True(t, ok)
+				assert.Equal(t, "ISO-8859-5", v)
+
+				v, ok = store.ConnectionParameters.Get("create spatial index")
+				assert.True(t, ok)
+				assert.Equal(t, "false", v)
+
+				v, ok = store.ConnectionParameters.Get("memory mapped buffer")
+				assert.True(t, ok)
+				assert.Equal(t, "true", v)
+
+				v, ok = store.ConnectionParameters.Get("cache and reuse memory maps")
+				assert.True(t, ok)
+				assert.Equal(t, "false", v)
+			})
 		})
 
 		t.Run("Directory Of Shapefiles", func(t *testing.T) {
@@ -119,6 +204,28 @@ func TestDataStoreIntegration_Create(t *testing.T) {
 				err = geoclient.Workspace(testdata.Workspace).DataStores().Create().Shapefiles(testdata.DatastoreDirOfShapefiles, fmt.Sprintf("file:%s", testdata.DirShapefiles))
 				assert.NoError(t, err)
 			})
+
+			t.Run("WITH OPTIONS", func(t *testing.T) {
+				var suffix = "_WITH_OPTIONS"
+				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().Shapefiles(testdata.DatastoreDirOfShapefiles+suffix, fmt.Sprintf("file:%s", testdata.DirShapefiles),
+					options.Shapefile.Charset("windows-1253"),
+					options.Shapefile.CreateSpatialIndex(true),
+					options.Shapefile.Memory(true),
+				)
+				assert.NoError(t, err)
+
+				store, err := geoclient.Workspace(testdata.Workspace).DataStores().Get(testdata.DatastoreDirOfShapefiles + suffix)
+				assert.NoError(t, err)
+				assert.NotNil(t, store)
+
+				v, ok := store.ConnectionParameters.Get("charset")
+				assert.True(t, ok)
+				assert.Equal(t, "windows-1253", v)
+
+				v, ok = store.ConnectionParameters.Get("create spatial index")
+				assert.True(t, ok)
+				assert.Equal(t, "true", v)
+			})
 		})
 
 		t.Run("GeoPackage", func(t *testing.T) {
@@ -147,6 +254,34 @@ func TestDataStoreIntegration_Create(t *testing.T) {
 			})
 		})
 
+		t.Run("Property", func(t *testing.T) {
+			addTestDataStore(t, formats.Property)
+
+			store, err := geoclient.Workspace(testdata.Workspace).DataStores().Get(testdata.DatastoreProperty)
+			assert.NoError(t, err)
+			assert.NotNil(t, store)
+
+			v, ok := store.ConnectionParameters.Get("dbtype")
+			assert.True(t, ok)
+			assert.Equal(t, string(formats.Property), v)
+		})
+
+		t.Run("Custom", func(t *testing.T) {
+			var name = testdata.DatastoreProperty + "_CUSTOM"
+			err := geoclient.Workspace(testdata.Workspace).DataStores().Create().Custom(name, "Properties", datastores.ConnectionParams{
+				"directory": fmt.Sprintf("file:%s", testdata.DirProperties),
+			})
+			assert.NoError(t, err)
+
+			store, err := geoclient.Workspace(testdata.Workspace).DataStores().Get(name)
+			assert.NoError(t, err)
+			assert.NotNil(t, store)
+
+			v, ok := store.ConnectionParameters.Get("directory")
+			assert.True(t, ok)
+			assert.Equal(t, fmt.Sprintf("file:%s", testdata.DirProperties), v)
+		})
+
 		t.Run("WebFeatureService", func(t *testing.T) {
 			addTestDataStore(t, formats.WebFeatureService)
 
@@ -190,6 +325,20 @@ func TestDataStoreIntegration_Create(t *testing.T) {
 			})
 		})
 
+		t.Run("PostGIS JNDI", func(t *testing.T) {
+			t.Run("Store name", func(t *testing.T) {
+				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().PostGISJNDI(testdata.InvalidName, "java:comp/env/jdbc/postgis")
+				assert.IsType(t, err, &customerrors.InputError{})
+				assert.EqualError(t, err, "name can only contain alphanumerical characters")
+			})
+
+			t.Run("Reference name", func(t *testing.T) {
+				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().PostGISJNDI(testdata.DatastorePostgis, "")
+				assert.IsType(t, err, &customerrors.InputError{})
+				assert.EqualError(t, err, "empty jndi reference name")
+			})
+		})
+
 		t.Run("GeoPackage", func(t *testing.T) {
 			t.Run("Store name", func(t *testing.T) {
 				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().GeoPackage(testdata.InvalidName, testdata.FileGeoPackage)
@@ -232,6 +381,68 @@ func TestDataStoreIntegration_Create(t *testing.T) {
 			})
 		})
 
+		t.Run("Oracle", func(t *testing.T) {
+			t.Run("Store name", func(t *testing.T) {
+				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().Oracle(testdata.InvalidName, oracle.ConnectionParams{Host: "oracle", Database: "xe"})
+				assert.IsType(t, err, &customerrors.InputError{})
+				assert.EqualError(t, err, "name can only contain alphanumerical characters")
+			})
+
+			t.Run("Host", func(t *testing.T) {
+				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().Oracle("ORACLE", oracle.ConnectionParams{Database: "xe"})
+				assert.IsType(t, err, &customerrors.InputError{})
+				assert.EqualError(t, err, "empty database host")
+			})
+		})
+
+		t.Run("MySQL", func(t *testing.T) {
+			t.Run("Database", func(t *testing.T) {
+				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().MySQL("MYSQL", mysql.ConnectionParams{Host: "mysql"})
+				assert.IsType(t, err, &customerrors.InputError{})
+				assert.EqualError(t, err, "empty database name")
+			})
+		})
+
+		t.Run("SQLServer", func(t *testing.T) {
+			t.Run("Database", func(t *testing.T) {
+				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().SQLServer("SQLSERVER", sqlserver.ConnectionParams{Host: "sqlserver"})
+				assert.IsType(t, err, &customerrors.InputError{})
+				assert.EqualError(t, err, "empty database name")
+			})
+		})
+
+		t.Run("H2", func(t *testing.T) {
+			t.Run("Database", func(t *testing.T) {
+				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().H2("H2", h2.ConnectionParams{})
+				assert.IsType(t, err, &customerrors.InputError{})
+				assert.EqualError(t, err, "empty h2 database path")
+			})
+		})
+
+		t.Run("Property", func(t *testing.T) {
+			t.Run("Directory", func(t *testing.T) {
+				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().Property(testdata.DatastoreProperty, "")
+				assert.IsType(t, err, &customerrors.InputError{})
+				assert.EqualError(t, err, "empty directory path")
+			})
+		})
+
+		t.Run("AppSchema", func(t *testing.T) {
+			t.Run("Mapping file", func(t *testing.T) {
+				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().AppSchema("APP_SCHEMA", "")
+				assert.IsType(t, err, &customerrors.InputError{})
+				assert.EqualError(t, err, "empty mapping file path")
+			})
+		})
+
+		t.Run("Custom", func(t *testing.T) {
+			t.Run("Connection parameters", func(t *testing.T) {
+				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().Custom("CUSTOM", "", nil)
+				assert.IsType(t, err, &customerrors.InputError{})
+				assert.EqualError(t, err, "empty connection parameters")
+			})
+		})
+
 		t.Run("WebFeatureService", func(t *testing.T) {
 			t.Run("Store name", func(t *testing.T) {
 				err := geoclient.Workspace(testdata.Workspace).DataStores().Create().WebFeatureService(testdata.InvalidName, "", "", testdata.DatastoreWFSUrl)
@@ -372,6 +583,42 @@ func TestDataStoreIntegration_Update(t *testing.T) {
 	})
 }
 
+func TestDataStoreIntegration_UpdatePassword(t *testing.T) {
+	addTestWorkspace(t)
+	addTestDataStore(t, formats.PostGIS)
+	addTestDataStore(t, formats.Shapefile)
+
+	t.Run("PostGIS", func(t *testing.T) {
+		err := geoclient.Workspace(testdata.Workspace).DataStores().UpdatePassword(testdata.DatastorePostgis, testdata.PostgisPassword)
+		assert.NoError(t, err)
+
+		//the other connection parameters are kept
+		store, err := geoclient.Workspace(testdata.Workspace).DataStores().Get(testdata.DatastorePostgis)
+		assert.NoError(t, err)
+
+		host, ok := store.ConnectionParameters.Get("host")
+		assert.True(t, ok)
+		assert.Equal(t, testdata.PostgisHost, host)
+
+		//the store still connects with the new password
+		_, err = geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).GetAll()
+		assert.NoError(t, err)
+	})
+
+	t.Run("No Password Parameter", func(t *testing.T) {
+		err := geoclient.Workspace(testdata.Workspace).DataStores().UpdatePassword(testdata.DatastoreShapefile, "secret")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.InputError{}, err)
+		assert.EqualError(t, err, fmt.Sprintf("data store %s has no password connection parameter", testdata.DatastoreShapefile))
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		err := geoclient.Workspace(testdata.Workspace).DataStores().UpdatePassword("does-not-exist", "secret")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+	})
+}
+
 func TestDataStoreIntegration_Reset(t *testing.T) {
 	addTestWorkspace(t)
 	addTestDataStore(t, formats.PostGIS)
@@ -9,6 +9,8 @@ import (
 	"github.com/canghel3/go-geoserver/pkg/featuretypes"
 	"github.com/canghel3/go-geoserver/pkg/formats"
 	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/canghel3/go-geoserver/pkg/shared"
+	"github.com/canghel3/go-geoserver/pkg/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -51,6 +53,90 @@ func TestFeatureTypeIntegration_Create(t *testing.T) {
 				assert.Equal(t, get.NativeBoundingBox.MaxY, bbox[3])
 				assert.Equal(t, get.NativeBoundingBox.CRS.Value, bboxSrs)
 			})
+
+			t.Run("With Links", func(t *testing.T) {
+				var featureName = testdata.FeatureTypePostgis + "_WITH_LINKS"
+
+				feature := featuretypes.New(featureName, testdata.FeatureTypePostgisNativeName,
+					options.FeatureType.MetadataLink("text/xml", types.MetadataISO19115, "https://catalogue.example.com/csw?id=init"),
+					options.FeatureType.DataLink("text/html", "https://data.example.com/init"),
+					options.FeatureType.ResponseSRS("4326", "3857"),
+				)
+
+				err := geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Publish(feature)
+				assert.NoError(t, err)
+
+				get, err := geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Get(featureName)
+				assert.NoError(t, err)
+				assert.Len(t, get.MetadataLinks.MetadataLink, 1)
+				assert.Equal(t, string(types.MetadataISO19115), get.MetadataLinks.MetadataLink[0].MetadataType)
+				assert.Len(t, get.DataLinks.DataLink, 1)
+				assert.Equal(t, []string{"4326", "3857"}, get.ResponseSRS.SRS)
+			})
+
+			t.Run("As SQL View", func(t *testing.T) {
+				var featureName = testdata.FeatureTypePostgis + "_SQL_VIEW"
+
+				view := featuretypes.NewSQLView(featureName, "select id, lat, lon, geom from init where lat > %min_lat%",
+					options.SQLView.Geometry("geom", types.Point, 4326),
+					options.SQLView.KeyColumns("id"),
+					options.SQLView.Parameter("min_lat", "0", `^[\d\.]+$`),
+				)
+
+				feature := featuretypes.New(featureName, "", options.FeatureType.BBOX([4]float64{-180.0, -90.0, 180.0, 90.0}, "EPSG:4326"), options.FeatureType.SQLView(view))
+
+				err := geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Publish(feature)
+				assert.NoError(t, err)
+
+				get, err := geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Get(featureName)
+				assert.NoError(t, err)
+				assert.Equal(t, featureName, get.NativeName)
+
+				vt := get.VirtualTable()
+				assert.NotNil(t, vt)
+				assert.Equal(t, []string{"id"}, vt.KeyColumn)
+				assert.Len(t, vt.Parameter, 1)
+				assert.Equal(t, "min_lat", vt.Parameter[0].Name)
+
+				t.Run("Update", func(t *testing.T) {
+					vt.Parameter[0].DefaultValue = "45"
+					get.SetVirtualTable(*vt)
+
+					err := geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Update(featureName, *get)
+					assert.NoError(t, err)
+
+					updated, err := geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Get(featureName)
+					assert.NoError(t, err)
+					assert.Equal(t, "45", updated.VirtualTable().Parameter[0].DefaultValue)
+				})
+			})
+
+			t.Run("As New Table", func(t *testing.T) {
+				var featureName = "NEW_TABLE"
+
+				type road struct {
+					ID       int64  `geoserver:"id,required"`
+					Name     string `geoserver:"name,length=120"`
+					Lanes    int32
+					Geometry any `geoserver:"geom,binding=LineString"`
+				}
+
+				attributes, err := featuretypes.AttributesFromStruct(road{})
+				assert.NoError(t, err)
+
+				feature := featuretypes.New(featureName, "new_table",
+					options.FeatureType.BBOX([4]float64{-180.0, -90.0, 180.0, 90.0}, "EPSG:4326"),
+					options.FeatureType.Attributes(attributes...),
+				)
+
+				err = geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Publish(feature)
+				assert.NoError(t, err)
+
+				get, err := geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Get(featureName)
+				assert.NoError(t, err)
+				assert.Equal(t, "new_table", get.NativeName)
+				assert.Len(t, get.Attributes.Attribute, 4)
+			})
 		})
 	})
 }
@@ -101,6 +187,29 @@ func TestFeatureTypeIntegration_GetAll(t *testing.T) {
 	})
 }
 
+func TestFeatureTypeIntegration_Available(t *testing.T) {
+	addTestWorkspace(t)
+	addTestDataStore(t, formats.PostGIS)
+
+	t.Run("200 Ok", func(t *testing.T) {
+		available, err := geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Available()
+		assert.NoError(t, err)
+		assert.Contains(t, available, testdata.FeatureTypePostgisNativeName)
+
+		addTestFeatureType(t, formats.PostGIS)
+
+		available, err = geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Available()
+		assert.NoError(t, err)
+		assert.NotContains(t, available, testdata.FeatureTypePostgisNativeName)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		_, err := geoclient.Workspace(testdata.Workspace).DataStore("does-not-exist").Available()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+	})
+}
+
 func TestFeatureTypeIntegration_Update(t *testing.T) {
 	addTestWorkspace(t)
 	addTestDataStore(t, formats.PostGIS)
@@ -122,6 +231,32 @@ func TestFeatureTypeIntegration_Update(t *testing.T) {
 		assert.Equal(t, "changed", fts.Name)
 	})
 
+	t.Run("Elevation Dimension", func(t *testing.T) {
+		ft, err := geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Get("changed")
+		assert.NoError(t, err)
+
+		ft.SetDimension(shared.ElevationDimension,
+			options.Dimension.Attribute("lat"),
+			options.Dimension.Presentation(types.PresentationDiscreteInterval),
+			options.Dimension.Resolution(10),
+			options.Dimension.Units("EPSG:5030", "m"),
+			options.Dimension.DefaultValue(types.StrategyMinimum, ""),
+		)
+
+		err = geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Update("changed", *ft)
+		assert.NoError(t, err)
+
+		updated, err := geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Get("changed")
+		assert.NoError(t, err)
+
+		dimension := updated.Dimension(shared.ElevationDimension)
+		assert.NotNil(t, dimension)
+		assert.True(t, dimension.Enabled)
+		assert.Equal(t, "lat", dimension.Attribute)
+		assert.Equal(t, types.PresentationDiscreteInterval, dimension.Presentation)
+		assert.Equal(t, types.StrategyMinimum, dimension.DefaultValue.Strategy)
+	})
+
 	t.Run("404 Not Found", func(t *testing.T) {
 		ft, err := geoclient.Workspace(testdata.Workspace).DataStore(testdata.DatastorePostgis).Get("changed")
 		assert.NoError(t, err)
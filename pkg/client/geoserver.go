@@ -13,6 +13,8 @@ type GeoserverClient struct {
 	data internal.GeoserverData
 }
 
+// NewGeoserverClient authenticates with basic authentication using username and password,
+// unless a different authenticator is set with options.Client.Authenticator.
 func NewGeoserverClient(url, username, password string, options ...options.GeoserverClientOption) GeoserverClient {
 	gc := new(GeoserverClient)
 	gc.data = internal.GeoserverData{
@@ -53,13 +55,59 @@ func (gc GeoserverClient) Workspace(name string) actions.Workspace {
 	return actions.NewWorkspaceActions(gc.data.Clone()).Use(name)
 }
 
+// Backup creates and restores backups of the configuration, it requires the backup-restore extension.
+func (gc GeoserverClient) Backup() actions.Backup {
+	return actions.NewBackupActions(gc.data.Clone())
+}
+
+// Catalog reloads the configuration from the data directory and resets the resource caches.
+func (gc GeoserverClient) Catalog() actions.Catalog {
+	return actions.NewCatalogActions(gc.data.Clone())
+}
+
+// Importer bulk loads data through import contexts, it requires the importer extension.
+func (gc GeoserverClient) Importer() actions.Importer {
+	return actions.NewImporterActions(gc.data.Clone())
+}
+
+// Namespaces manages the namespaces associated with the workspaces.
+func (gc GeoserverClient) Namespaces() actions.Namespaces {
+	return actions.NewNamespaceActions(gc.data.Clone())
+}
+
+// Security manages users, groups and roles.
+func (gc GeoserverClient) Security() actions.Security {
+	return actions.NewSecurityActions(gc.data.Clone())
+}
+
+// Services manages the global and workspace specific settings of the OGC services.
+func (gc GeoserverClient) Services() actions.Services {
+	return actions.NewServicesActions(gc.data.Clone())
+}
+
+// Settings manages the global settings, the contact information and the workspace specific settings.
+func (gc GeoserverClient) Settings() actions.Settings {
+	return actions.NewSettingsActions(gc.data.Clone())
+}
+
 func (gc GeoserverClient) WMS(version wms.WMSVersion) actions.WMS {
 	return actions.NewWMSActions(gc.data.Clone(), version)
 }
 
-//func (gc GeoserverClient) LayerGroups() actions.LayerGroups {
-//	return actions.NewLayerGroup(gc.data.Clone())
-//}
+// LayerGroups manages the global layer groups, which do not belong to a workspace.
+func (gc GeoserverClient) LayerGroups() actions.LayerGroups {
+	return actions.NewLayerGroup(gc.data.Clone())
+}
+
+// Layers lists the layers of all the workspaces.
+func (gc GeoserverClient) Layers() actions.Layers {
+	return actions.NewLayerActions(gc.data.Clone())
+}
+
+// Styles manages the global styles, which do not belong to a workspace.
+func (gc GeoserverClient) Styles() actions.Styles {
+	return actions.NewStyleActions(gc.data.Clone())
+}
 
 func (gc GeoserverClient) Logging() actions.Logging {
 	return actions.NewLoggingActions(gc.data.Clone())
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/importer"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImporterIntegration(t *testing.T) {
+	context, err := geoclient.Importer().Create(options.Import.Workspace(testdata.Workspace))
+	var notFound *customerrors.NotFoundError
+	if errors.As(err, &notFound) {
+		t.Skip("importer extension not installed")
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		assert.NoError(t, err)
+		assert.NotNil(t, context)
+		assert.Equal(t, testdata.Workspace, context.TargetWorkspace.Workspace.Name)
+	})
+
+	imp := geoclient.Importer().Use(context.ID)
+	defer imp.Delete()
+
+	t.Run("Add Directory", func(t *testing.T) {
+		tasks, err := imp.AddDirectory(testdata.GeoserverDataDir + "/" + testdata.DirShapefiles)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, tasks)
+	})
+
+	t.Run("Invalid Directory", func(t *testing.T) {
+		tasks, err := imp.AddDirectory(testdata.DirShapefiles)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.InputError{}, err)
+		assert.Nil(t, tasks)
+	})
+
+	t.Run("Adjust Task", func(t *testing.T) {
+		task := imp.Task(0)
+
+		err := task.AddTransform(importer.Reproject("EPSG:4326"))
+		assert.NoError(t, err)
+
+		transforms, err := task.Transforms()
+		assert.NoError(t, err)
+		assert.NotEmpty(t, transforms)
+
+		err = task.UpdateLayer(options.ImportLayer.Title("imported"))
+		assert.NoError(t, err)
+
+		layer, err := task.Layer()
+		assert.NoError(t, err)
+		assert.Equal(t, "imported", layer.Title)
+	})
+
+	t.Run("Run", func(t *testing.T) {
+		err := imp.Run()
+		assert.NoError(t, err)
+
+		done, err := imp.Wait(5*time.Minute, time.Second)
+		assert.NotNil(t, done)
+		if err != nil {
+			var importError *importer.ImportError
+			assert.ErrorAs(t, err, &importError)
+		}
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		context, err := geoclient.Importer().Use(99999).Get()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.Nil(t, context)
+	})
+}
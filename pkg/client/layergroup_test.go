@@ -302,6 +302,14 @@ func TestLayerGroupIntegration_Get(t *testing.T) {
 		assert.Nil(t, group)
 		assert.IsType(t, &customerrors.NotFoundError{}, err)
 	})
+
+	t.Run("All", func(t *testing.T) {
+		groups, err := geoclient.Workspace(testdata.Workspace).LayerGroups().GetAll()
+		assert.NoError(t, err)
+		assert.NotNil(t, groups)
+		assert.Len(t, groups.Entries, 1)
+		assert.Equal(t, testdata.LayerGroupName, groups.Entries[0].Name)
+	})
 }
 
 func TestLayerGroupIntegration_Delete(t *testing.T) {
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/formats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLayersIntegration_Get(t *testing.T) {
+	addTestWorkspace(t)
+	addTestCoverageStore(t, formats.GeoTIFF)
+	addTestCoverage(t, formats.GeoTIFF)
+
+	t.Run("200 Ok", func(t *testing.T) {
+		layer, err := geoclient.Workspace(testdata.Workspace).Layers().Get(testdata.CoverageGeoTiffName)
+		assert.NoError(t, err)
+		assert.NotNil(t, layer)
+		assert.Equal(t, testdata.CoverageGeoTiffName, layer.Name)
+		assert.Equal(t, "coverage", layer.Resource.Class)
+		assert.Equal(t, testdata.CoverageStoreGeoTiff, layer.Resource.Store())
+	})
+
+	t.Run("All", func(t *testing.T) {
+		all, err := geoclient.Workspace(testdata.Workspace).Layers().GetAll()
+		assert.NoError(t, err)
+		assert.NotNil(t, all)
+		assert.Len(t, all.Entries, 1)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		layer, err := geoclient.Workspace(testdata.Workspace).Layers().Get("none")
+		assert.Error(t, err)
+		assert.Nil(t, layer)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+	})
+}
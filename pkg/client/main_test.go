@@ -29,6 +29,7 @@ func TestMain(m *testing.M) {
 	copyFileToGeoserver(testdata.FileCSVLatLon, true)
 	copyFileToGeoserver(testdata.FileCSVWkt, true)
 	copyDirToGeoserver(testdata.DirShapefiles, true)
+	copyDirToGeoserver(testdata.DirProperties, true)
 
 	//RASTERS SETUP
 	copyDirToGeoserver(testdata.DirGeoTiff, false)
@@ -115,6 +116,11 @@ func addTestDataStore(t *testing.T, type_ formats.DataStoreFormat) {
 	//		t.Fatal(err)
 	//	}
 	//	return
+	case formats.Property:
+		if err := geoclient.Workspace(testdata.Workspace).DataStores().Create().Property(testdata.DatastoreProperty, testdata.DirProperties); err != nil {
+			t.Fatal(err)
+		}
+		return
 	case formats.WebFeatureService:
 		if err := geoclient.Workspace(testdata.Workspace).DataStores().Create().WebFeatureService(testdata.DatastoreWebFeatureService, testdata.GeoserverUsername, testdata.GeoserverPassword, testdata.DatastoreWFSUrl); err != nil {
 			t.Fatal(err)
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaceIntegration(t *testing.T) {
+	geoclient.Workspaces().Delete(testdata.Workspace, true)
+
+	t.Run("Create", func(t *testing.T) {
+		err := geoclient.Namespaces().Create(testdata.Workspace, "http://example.com/playground", options.Namespace.Isolated(true))
+		assert.NoError(t, err)
+
+		//the workspace is created alongside the namespace
+		wksp, err := geoclient.Workspaces().Get(testdata.Workspace)
+		assert.NoError(t, err)
+		assert.Equal(t, testdata.Workspace, wksp.Name)
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		namespace, err := geoclient.Namespaces().Get(testdata.Workspace)
+		assert.NoError(t, err)
+		assert.Equal(t, testdata.Workspace, namespace.Prefix)
+		assert.Equal(t, "http://example.com/playground", namespace.URI)
+		assert.True(t, namespace.Isolated)
+	})
+
+	t.Run("GetAll", func(t *testing.T) {
+		all, err := geoclient.Namespaces().GetAll()
+		assert.NoError(t, err)
+		assert.NotEmpty(t, all.Entries)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		err := geoclient.Namespaces().Update(testdata.Workspace, "http://example.com/playground/v2")
+		assert.NoError(t, err)
+
+		namespace, err := geoclient.Namespaces().Get(testdata.Workspace)
+		assert.NoError(t, err)
+		assert.Equal(t, "http://example.com/playground/v2", namespace.URI)
+	})
+
+	t.Run("Invalid URI", func(t *testing.T) {
+		err := geoclient.Namespaces().Create(testdata.Workspace+"_2", "")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.InputError{}, err)
+		assert.EqualError(t, err, "empty namespace uri")
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := geoclient.Namespaces().Delete(testdata.Workspace)
+		assert.NoError(t, err)
+
+		_, err = geoclient.Namespaces().Get(testdata.Workspace)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+	})
+}
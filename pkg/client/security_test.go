@@ -0,0 +1,154 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testUser  = "tenant.a@example.com"
+	testGroup = "TENANTS"
+	testRole  = "ROLE_TENANT"
+)
+
+func TestSecurityIntegration_UserGroups(t *testing.T) {
+	userGroups := geoclient.Security().UserGroups()
+	userGroups.DeleteUser(testUser)
+	userGroups.DeleteGroup(testGroup)
+
+	t.Run("Create User", func(t *testing.T) {
+		err := userGroups.CreateUser(testUser, "secret")
+		assert.NoError(t, err)
+
+		users, err := userGroups.Users()
+		assert.NoError(t, err)
+
+		var found bool
+		for _, user := range users {
+			if user.UserName == testUser {
+				found = true
+				assert.True(t, user.Enabled)
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("Update User", func(t *testing.T) {
+		err := userGroups.UpdateUser(testUser, options.User.Password("new-secret"), options.User.Enabled(false))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Groups", func(t *testing.T) {
+		err := userGroups.CreateGroup(testGroup)
+		assert.NoError(t, err)
+
+		err = userGroups.AddToGroup(testUser, testGroup)
+		assert.NoError(t, err)
+
+		groups, err := userGroups.GroupsOf(testUser)
+		assert.NoError(t, err)
+		assert.Contains(t, groups, testGroup)
+
+		users, err := userGroups.UsersOf(testGroup)
+		assert.NoError(t, err)
+		assert.Len(t, users, 1)
+
+		err = userGroups.RemoveFromGroup(testUser, testGroup)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid Name", func(t *testing.T) {
+		err := userGroups.CreateUser("tenant/a", "secret")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.InputError{}, err)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		assert.NoError(t, userGroups.DeleteGroup(testGroup))
+		assert.NoError(t, userGroups.DeleteUser(testUser))
+	})
+}
+
+func TestSecurityIntegration_Roles(t *testing.T) {
+	userGroups := geoclient.Security().UserGroups()
+	roles := geoclient.Security().Roles()
+	roles.Delete(testRole)
+	userGroups.DeleteUser(testUser)
+
+	err := userGroups.CreateUser(testUser, "secret")
+	assert.NoError(t, err)
+
+	t.Run("Create With Parent", func(t *testing.T) {
+		err := roles.CreateWithParent(testRole, "GROUP_ADMIN")
+		assert.NoError(t, err)
+
+		all, err := roles.GetAll()
+		assert.NoError(t, err)
+		assert.Contains(t, all, testRole)
+	})
+
+	t.Run("Create With Missing Parent", func(t *testing.T) {
+		err := roles.CreateWithParent(testRole+"_ORPHAN", "ROLE_MISSING")
+		assert.Error(t, err)
+		var notFound *customerrors.NotFoundError
+		assert.ErrorAs(t, err, &notFound)
+
+		all, err := roles.GetAll()
+		assert.NoError(t, err)
+		assert.NotContains(t, all, testRole+"_ORPHAN")
+	})
+
+	t.Run("Assign To User", func(t *testing.T) {
+		err := roles.AssignToUser(testRole, testUser)
+		assert.NoError(t, err)
+
+		userRoles, err := roles.OfUser(testUser)
+		assert.NoError(t, err)
+		assert.Contains(t, userRoles, testRole)
+
+		err = roles.UnassignFromUser(testRole, testUser)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		assert.NoError(t, roles.Delete(testRole))
+		assert.NoError(t, userGroups.DeleteUser(testUser))
+	})
+}
+
+func TestSecurityIntegration_Passwords(t *testing.T) {
+	t.Run("Change Own Password", func(t *testing.T) {
+		userGroups := geoclient.Security().UserGroups()
+		userGroups.DeleteUser(testUser)
+
+		err := userGroups.CreateUser(testUser, "secret")
+		assert.NoError(t, err)
+
+		err = geoclient.Security().Roles().AssignToUser("ADMIN", testUser)
+		assert.NoError(t, err)
+
+		tenant := NewGeoserverClient(testdata.GeoserverUrl, testUser, "secret")
+		err = tenant.Security().ChangePassword("new-secret")
+		assert.NoError(t, err)
+
+		tenant = NewGeoserverClient(testdata.GeoserverUrl, testUser, "new-secret")
+		_, err = tenant.About().Version()
+		assert.NoError(t, err)
+
+		assert.NoError(t, userGroups.DeleteUser(testUser))
+	})
+
+	t.Run("Master Password", func(t *testing.T) {
+		master, err := geoclient.Security().MasterPassword()
+		assert.NoError(t, err)
+		assert.NotEmpty(t, master)
+
+		err = geoclient.Security().ChangeMasterPassword("wrong", "new-master-password")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.GeoserverError{}, err)
+	})
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServicesIntegration_Global(t *testing.T) {
+	t.Run("WMS", func(t *testing.T) {
+		settings, err := geoclient.Services().WMS().Get()
+		assert.NoError(t, err)
+		assert.NotNil(t, settings)
+		assert.Equal(t, "WMS", *settings.Name)
+
+		original := *settings.MaxBuffer
+		maxBuffer := original + 5
+		err = geoclient.Services().WMS().Update(services.WMS{MaxBuffer: &maxBuffer})
+		assert.NoError(t, err)
+
+		settings, err = geoclient.Services().WMS().Get()
+		assert.NoError(t, err)
+		assert.Equal(t, maxBuffer, *settings.MaxBuffer)
+
+		err = geoclient.Services().WMS().Update(services.WMS{MaxBuffer: &original})
+		assert.NoError(t, err)
+	})
+
+	t.Run("WFS", func(t *testing.T) {
+		settings, err := geoclient.Services().WFS().Get()
+		assert.NoError(t, err)
+		assert.NotNil(t, settings)
+		assert.Equal(t, "WFS", *settings.Name)
+	})
+
+	t.Run("WCS", func(t *testing.T) {
+		settings, err := geoclient.Services().WCS().Get()
+		assert.NoError(t, err)
+		assert.NotNil(t, settings)
+		assert.Equal(t, "WCS", *settings.Name)
+	})
+
+	t.Run("WMTS", func(t *testing.T) {
+		settings, err := geoclient.Services().WMTS().Get()
+		assert.NoError(t, err)
+		assert.NotNil(t, settings)
+		assert.Equal(t, "WMTS", *settings.Name)
+	})
+
+	t.Run("Delete Global", func(t *testing.T) {
+		err := geoclient.Services().WMS().Delete()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.InputError{}, err)
+		assert.EqualError(t, err, "global service settings cannot be deleted")
+	})
+}
+
+func TestServicesIntegration_Workspace(t *testing.T) {
+	addTestWorkspace(t)
+
+	t.Run("Create Override", func(t *testing.T) {
+		title := "Playground WFS"
+		enabled := true
+		err := geoclient.Workspace(testdata.Workspace).Services().WFS().Update(services.WFS{
+			Service: services.Service{
+				Workspace: &services.Workspace{Name: testdata.Workspace},
+				Enabled:   &enabled,
+				Name:      &title,
+				Title:     &title,
+			},
+		})
+		assert.NoError(t, err)
+
+		settings, err := geoclient.Workspace(testdata.Workspace).Services().WFS().Get()
+		assert.NoError(t, err)
+		assert.Equal(t, title, *settings.Title)
+		assert.Equal(t, testdata.Workspace, settings.Workspace.Name)
+	})
+
+	t.Run("Delete Override", func(t *testing.T) {
+		err := geoclient.Services().WFS().Workspace(testdata.Workspace).Delete()
+		assert.NoError(t, err)
+
+		_, err = geoclient.Workspace(testdata.Workspace).Services().WFS().Get()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+	})
+
+	t.Run("Invalid Workspace Name", func(t *testing.T) {
+		_, err := geoclient.Services().WMS().Workspace(testdata.InvalidName).Get()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.InputError{}, err)
+	})
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettingsIntegration_Global(t *testing.T) {
+	t.Run("Get", func(t *testing.T) {
+		global, err := geoclient.Settings().Get()
+		assert.NoError(t, err)
+		assert.NotNil(t, global)
+		assert.NotNil(t, global.Settings)
+		assert.NotNil(t, global.JAI)
+		assert.NotNil(t, global.CoverageAccess)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		global, err := geoclient.Settings().Get()
+		assert.NoError(t, err)
+
+		original := *global.Settings.NumDecimals
+		numDecimals := 4
+		global.Settings.NumDecimals = &numDecimals
+
+		err = geoclient.Settings().Update(settings.Global{Settings: global.Settings})
+		assert.NoError(t, err)
+
+		updated, err := geoclient.Settings().Get()
+		assert.NoError(t, err)
+		assert.Equal(t, numDecimals, *updated.Settings.NumDecimals)
+
+		updated.Settings.NumDecimals = &original
+		err = geoclient.Settings().Update(settings.Global{Settings: updated.Settings})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Proxy Base URL", func(t *testing.T) {
+		err := geoclient.Settings().ProxyBaseURL("https://maps.example.com/geoserver")
+		assert.NoError(t, err)
+
+		global, err := geoclient.Settings().Get()
+		assert.NoError(t, err)
+		assert.Equal(t, "https://maps.example.com/geoserver", *global.Settings.ProxyBaseURL)
+
+		err = geoclient.Settings().ProxyBaseURL("")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid Proxy Base URL", func(t *testing.T) {
+		err := geoclient.Settings().ProxyBaseURL("ftp://maps.example.com")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.InputError{}, err)
+	})
+}
+
+func TestSettingsIntegration_Contact(t *testing.T) {
+	person := "Maps Team"
+	err := geoclient.Settings().UpdateContact(settings.Contact{Person: &person})
+	assert.NoError(t, err)
+
+	contact, err := geoclient.Settings().Contact()
+	assert.NoError(t, err)
+	assert.Equal(t, person, *contact.Person)
+}
+
+func TestSettingsIntegration_Workspace(t *testing.T) {
+	addTestWorkspace(t)
+
+	t.Run("Create", func(t *testing.T) {
+		numDecimals := 4
+		err := geoclient.Workspace(testdata.Workspace).Settings().Create(settings.Settings{NumDecimals: &numDecimals})
+		assert.NoError(t, err)
+
+		workspaceSettings, err := geoclient.Settings().Workspace(testdata.Workspace).Get()
+		assert.NoError(t, err)
+		assert.Equal(t, numDecimals, *workspaceSettings.NumDecimals)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		proxy := "https://playground.example.com/geoserver"
+		err := geoclient.Workspace(testdata.Workspace).Settings().Update(settings.Settings{ProxyBaseURL: &proxy})
+		assert.NoError(t, err)
+
+		workspaceSettings, err := geoclient.Workspace(testdata.Workspace).Settings().Get()
+		assert.NoError(t, err)
+		assert.Equal(t, proxy, *workspaceSettings.ProxyBaseURL)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := geoclient.Workspace(testdata.Workspace).Settings().Delete()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid Workspace Name", func(t *testing.T) {
+		_, err := geoclient.Settings().Workspace(testdata.InvalidName).Get()
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.InputError{}, err)
+	})
+}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/canghel3/go-geoserver/internal/testdata"
+	"github.com/canghel3/go-geoserver/pkg/cascaded"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func addTestWMSStore(t *testing.T) {
+	if err := geoclient.Workspace(testdata.Workspace).WMSStores().Create(testdata.WMSStore, testdata.WMSStoreUrl); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWMSStoreIntegration_Create(t *testing.T) {
+	addTestWorkspace(t)
+
+	t.Run("201 Created", func(t *testing.T) {
+		t.Run("Without Options", func(t *testing.T) {
+			err := geoclient.Workspace(testdata.Workspace).WMSStores().Create(testdata.WMSStore, testdata.WMSStoreUrl)
+			assert.NoError(t, err)
+
+			store, err := geoclient.Workspace(testdata.Workspace).WMSStores().Get(testdata.WMSStore)
+			assert.NoError(t, err)
+			assert.Equal(t, testdata.WMSStore, store.Name)
+			assert.Equal(t, "WMS", store.Type)
+			assert.Equal(t, testdata.WMSStoreUrl, store.CapabilitiesURL)
+		})
+
+		t.Run("With Options", func(t *testing.T) {
+			var name = testdata.WMSStore + "_WITH_OPTIONS"
+
+			err := geoclient.Workspace(testdata.Workspace).WMSStores().Create(name, testdata.WMSStoreUrl,
+				options.CascadedStore.Credentials(testdata.GeoserverUsername, testdata.GeoserverPassword),
+				options.CascadedStore.MaxConnections(4),
+				options.CascadedStore.ReadTimeout(30),
+				options.CascadedStore.ConnectTimeout(10),
+			)
+			assert.NoError(t, err)
+
+			store, err := geoclient.Workspace(testdata.Workspace).WMSStores().Get(name)
+			assert.NoError(t, err)
+			assert.Equal(t, testdata.GeoserverUsername, store.User)
+			assert.Equal(t, 4, store.MaxConnections)
+			assert.Equal(t, 30, store.ReadTimeout)
+			assert.Equal(t, 10, store.ConnectTimeout)
+		})
+	})
+
+	t.Run("Invalid Capabilities URL", func(t *testing.T) {
+		err := geoclient.Workspace(testdata.Workspace).WMSStores().Create(testdata.WMSStore, "")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.InputError{}, err)
+		assert.EqualError(t, err, "empty capabilities url")
+	})
+}
+
+func TestWMSStoreIntegration_GetAll(t *testing.T) {
+	addTestWorkspace(t)
+
+	t.Run("No Stores", func(t *testing.T) {
+		stores, err := geoclient.Workspace(testdata.Workspace).WMSStores().GetAll()
+		assert.NoError(t, err)
+		assert.Nil(t, stores.Entries)
+	})
+
+	t.Run("Single Store", func(t *testing.T) {
+		addTestWMSStore(t)
+
+		stores, err := geoclient.Workspace(testdata.Workspace).WMSStores().GetAll()
+		assert.NoError(t, err)
+		assert.Len(t, stores.Entries, 1)
+	})
+}
+
+func TestWMSStoreIntegration_Update(t *testing.T) {
+	addTestWorkspace(t)
+	addTestWMSStore(t)
+
+	t.Run("200 Ok", func(t *testing.T) {
+		store, err := geoclient.Workspace(testdata.Workspace).WMSStores().Get(testdata.WMSStore)
+		assert.NoError(t, err)
+
+		store.Description = "cascaded basemaps"
+		err = geoclient.Workspace(testdata.Workspace).WMSStores().Update(testdata.WMSStore, *store)
+		assert.NoError(t, err)
+
+		updated, err := geoclient.Workspace(testdata.Workspace).WMSStores().Get(testdata.WMSStore)
+		assert.NoError(t, err)
+		assert.Equal(t, "cascaded basemaps", updated.Description)
+	})
+}
+
+func TestWMSStoreIntegration_Delete(t *testing.T) {
+	addTestWorkspace(t)
+	addTestWMSStore(t)
+
+	t.Run("200 Ok", func(t *testing.T) {
+		err := geoclient.Workspace(testdata.Workspace).WMSStores().Delete(testdata.WMSStore, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		err := geoclient.Workspace(testdata.Workspace).WMSStores().Delete(testdata.WMSStore, true)
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+		assert.EqualError(t, err, fmt.Sprintf("wmsstore %s not found", testdata.WMSStore))
+	})
+}
+
+func TestWMSLayerIntegration_Publish(t *testing.T) {
+	addTestWorkspace(t)
+	addTestWMSStore(t)
+
+	t.Run("201 Created", func(t *testing.T) {
+		layer := cascaded.NewLayer(testdata.WMSLayer, "topp:states",
+			options.CascadedLayer.Title("cascaded states"),
+			options.CascadedLayer.SRS("EPSG:4326"),
+			options.CascadedLayer.BBOX([4]float64{-124.73, 24.96, -66.97, 49.37}, "EPSG:4326"),
+		)
+
+		err := geoclient.Workspace(testdata.Workspace).WMSStore(testdata.WMSStore).Publish(layer)
+		assert.NoError(t, err)
+
+		get, err := geoclient.Workspace(testdata.Workspace).WMSStore(testdata.WMSStore).Get(testdata.WMSLayer)
+		assert.NoError(t, err)
+		assert.Equal(t, "topp:states", get.NativeName)
+		assert.Equal(t, "EPSG:4326", get.Srs)
+
+		layers, err := geoclient.Workspace(testdata.Workspace).WMSStore(testdata.WMSStore).GetAll()
+		assert.NoError(t, err)
+		assert.Len(t, layers.Entries, 1)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := geoclient.Workspace(testdata.Workspace).WMSStore(testdata.WMSStore).Delete(testdata.WMSLayer, true)
+		assert.NoError(t, err)
+	})
+}
+
+func TestWMTSStoreIntegration_Create(t *testing.T) {
+	addTestWorkspace(t)
+
+	t.Run("201 Created", func(t *testing.T) {
+		err := geoclient.Workspace(testdata.Workspace).WMTSStores().Create(testdata.WMTSStore, testdata.WMTSStoreUrl, options.CascadedStore.MaxConnections(2))
+		assert.NoError(t, err)
+
+		store, err := geoclient.Workspace(testdata.Workspace).WMTSStores().Get(testdata.WMTSStore)
+		assert.NoError(t, err)
+		assert.Equal(t, "WMTS", store.Type)
+		assert.Equal(t, 2, store.MaxConnections)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := geoclient.Workspace(testdata.Workspace).WMTSStores().Delete(testdata.WMTSStore, true)
+		assert.NoError(t, err)
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/canghel3/go-geoserver/internal/testdata"
 	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/options"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -135,3 +136,63 @@ func TestWorkspaceIntegration_GetAll(t *testing.T) {
 	err := geoclient.Workspaces().Delete(testdata.Workspace+"_2", false)
 	assert.NoError(t, err)
 }
+
+func TestWorkspaceIntegration_Options(t *testing.T) {
+	geoclient.Workspaces().Delete(testdata.Workspace, true)
+
+	t.Run("Create With URI And Isolation", func(t *testing.T) {
+		err := geoclient.Workspaces().Create(testdata.Workspace, false, options.Workspace.URI("http://example.com/playground"), options.Workspace.Isolated(true))
+		assert.NoError(t, err)
+
+		wksp, err := geoclient.Workspaces().Get(testdata.Workspace)
+		assert.NoError(t, err)
+		assert.True(t, wksp.Isolated)
+
+		namespace, err := geoclient.Namespaces().Get(testdata.Workspace)
+		assert.NoError(t, err)
+		assert.Equal(t, "http://example.com/playground", namespace.URI)
+	})
+
+	t.Run("Update URI", func(t *testing.T) {
+		err := geoclient.Workspaces().Update(testdata.Workspace, testdata.Workspace, options.Workspace.URI("urn:example:playground"), options.Workspace.Isolated(false))
+		assert.NoError(t, err)
+
+		wksp, err := geoclient.Workspaces().Get(testdata.Workspace)
+		assert.NoError(t, err)
+		assert.False(t, wksp.Isolated)
+
+		namespace, err := geoclient.Namespaces().Get(testdata.Workspace)
+		assert.NoError(t, err)
+		assert.Equal(t, "urn:example:playground", namespace.URI)
+	})
+
+	t.Run("Invalid URI", func(t *testing.T) {
+		err := geoclient.Workspaces().Update(testdata.Workspace, testdata.Workspace, options.Workspace.URI("playground"))
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.InputError{}, err)
+		assert.EqualError(t, err, "namespace uri must be absolute")
+	})
+}
+
+func TestWorkspaceIntegration_Default(t *testing.T) {
+	addTestWorkspace(t)
+
+	previous, err := geoclient.Workspaces().Default()
+	assert.NoError(t, err)
+
+	err = geoclient.Workspaces().SetDefault(testdata.Workspace)
+	assert.NoError(t, err)
+
+	wksp, err := geoclient.Workspaces().Default()
+	assert.NoError(t, err)
+	assert.Equal(t, testdata.Workspace, wksp.Name)
+
+	t.Run("404 Not Found", func(t *testing.T) {
+		err := geoclient.Workspaces().SetDefault("DOES_NOT_EXIST")
+		assert.Error(t, err)
+		assert.IsType(t, &customerrors.NotFoundError{}, err)
+	})
+
+	err = geoclient.Workspaces().SetDefault(previous.Name)
+	assert.NoError(t, err)
+}
@@ -1,6 +1,8 @@
 package coverages
 
 import (
+	"encoding/json"
+	"github.com/canghel3/go-geoserver/internal/jsonutil"
 	"github.com/canghel3/go-geoserver/internal/models"
 	"github.com/canghel3/go-geoserver/pkg/options"
 	"github.com/canghel3/go-geoserver/pkg/shared"
@@ -46,14 +48,16 @@ type Coverage struct {
 	Keywords                   *shared.Keywords      `json:"keywords,omitempty"`
 	LatLonBoundingBox          *shared.BoundingBox   `json:"latLonBoundingBox,omitempty"`
 	Metadata                   *Metadata             `json:"metadata,omitempty"`
+	MetadataLinks              *shared.MetadataLinks `json:"metadataLinks,omitempty"`
+	DataLinks                  *shared.DataLinks     `json:"dataLinks,omitempty"`
 	Name                       string                `json:"name"`
 	Namespace                  NamespaceDetails      `json:"namespace"`
 	NativeBoundingBox          *shared.BoundingBox   `json:"nativeBoundingBox,omitempty"`
 	NativeCRS                  *shared.CRSClass      `json:"nativeCRS,omitempty"`
 	NativeFormat               *string               `json:"nativeFormat,omitempty"`
 	NativeName                 string                `json:"nativeName,omitempty"`
-	RequestSRS                 *SRS                  `json:"requestSRS,omitempty"`
-	ResponseSRS                *SRS                  `json:"responseSRS,omitempty"`
+	RequestSRS                 *shared.SRSList       `json:"requestSRS,omitempty"`
+	ResponseSRS                *shared.SRSList       `json:"responseSRS,omitempty"`
 	Srs                        *string               `json:"srs,omitempty"`
 	Store                      StoreDetails          `json:"store"`
 	SupportedFormats           *SupportedFormats     `json:"supportedFormats,omitempty"`
@@ -64,12 +68,25 @@ type CoverageDimensions struct {
 	CoverageDimension []CoverageDimension `json:"coverageDimension"`
 }
 
+// UnmarshalJSON handles GeoServer responding with a single object instead of a list for single band coverages.
+func (cd *CoverageDimensions) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		CoverageDimension json.RawMessage `json:"coverageDimension"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return jsonutil.OneOrMany(raw.CoverageDimension, &cd.CoverageDimension)
+}
+
 type CoverageDimension struct {
 	Description string     `json:"description"`
 	Name        string     `json:"name"`
 	DataType    DataType   `json:"dimensionType"`
 	NullValues  NullValues `json:"nullValues"`
 	Range       Range      `json:"range"`
+	Unit        string     `json:"unit,omitempty"`
 }
 
 type DataType struct {
@@ -77,7 +94,19 @@ type DataType struct {
 }
 
 type NullValues struct {
-	Double float64 `json:"double"`
+	Double []float64 `json:"double"`
+}
+
+// UnmarshalJSON handles GeoServer responding with a single number instead of a list when the band has one nodata value.
+func (nv *NullValues) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Double json.RawMessage `json:"double"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return jsonutil.OneOrMany(raw.Double, &nv.Double)
 }
 
 type Range struct {
@@ -110,34 +139,51 @@ type InterpolationMethods struct {
 	String []string `json:"string"`
 }
 
+// Dimension returns the configuration of the dimension stored under key (e.g. shared.TimeDimension) or nil if it is not configured.
+func (c *Coverage) Dimension(key string) *DimensionInfo {
+	if c.Metadata == nil {
+		return nil
+	}
+
+	for _, entry := range c.Metadata.Entry {
+		if entry.Key == key {
+			return entry.DimensionInfo
+		}
+	}
+
+	return nil
+}
+
 type Metadata struct {
-	Entry MetadataEntry `json:"entry"`
+	Entry []MetadataEntry `json:"entry"`
 }
 
-type MetadataEntry struct {
-	Key  string `json:"@key"`
-	Text string `json:"$"`
+// UnmarshalJSON handles GeoServer responding with a single object instead of a list when there is only one entry.
+func (m *Metadata) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Entry json.RawMessage `json:"entry"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return jsonutil.OneOrMany(raw.Entry, &m.Entry)
 }
 
-type DimensionInfo struct {
-	Enabled                bool   `json:"enabled"`
-	Presentation           string `json:"presentation"`
-	Units                  string `json:"units"`
-	DefaultValue           string `json:"defaultValue"`
-	NearestMatchEnabled    bool   `json:"nearestMatchEnabled"`
-	RawNearestMatchEnabled bool   `json:"rawNearestMatchEnabled"`
-	StartValue             int    `json:"startValue"`
-	EndValue               string `json:"endValue"`
+type MetadataEntry struct {
+	Key           string         `json:"@key"`
+	Text          string         `json:"$,omitempty"`
+	DimensionInfo *DimensionInfo `json:"dimensionInfo,omitempty"`
 }
 
+type DimensionInfo = shared.DimensionInfo
+
 type NamespaceDetails struct {
 	Href string `json:"href"`
 	Name string `json:"name"`
 }
 
-type SRS struct {
-	String string `json:"string"`
-}
+type SRS = shared.SRSList
 
 type StoreDetails struct {
 	Class string `json:"@class"`
@@ -0,0 +1,473 @@
+// Package crawler walks the catalog concurrently, resolving every store, feature type, coverage and layer group
+// with the Get calls of the client.
+package crawler
+
+import (
+	"fmt"
+	"iter"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/pkg/client"
+	"github.com/canghel3/go-geoserver/pkg/coverages"
+	"github.com/canghel3/go-geoserver/pkg/coveragestores"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/datastores"
+	"github.com/canghel3/go-geoserver/pkg/featuretypes"
+	"github.com/canghel3/go-geoserver/pkg/layers"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/canghel3/go-geoserver/pkg/workspace"
+)
+
+type Kind string
+
+const (
+	KindDataStore     Kind = "datastore"
+	KindCoverageStore Kind = "coveragestore"
+	KindFeatureType   Kind = "featuretype"
+	KindCoverage      Kind = "coverage"
+	KindLayerGroup    Kind = "layergroup"
+)
+
+// Resource is a resolved resource of the catalog. Only the field matching its kind is set, and none of them
+// when the resource could not be retrieved.
+type Resource struct {
+	Kind Kind
+	// Workspace is empty for the global layer groups
+	Workspace string
+	// Store is set for the feature types and coverages
+	Store string
+	Name  string
+
+	DataStore     *datastores.DataStore
+	CoverageStore *coveragestores.CoverageStore
+	FeatureType   *featuretypes.FeatureType
+	Coverage      *coverages.Coverage
+	LayerGroup    *layers.Group
+}
+
+// Path identifies the resource as kind:workspace[/store]/name, it is the form used by checkpoints
+func (r Resource) Path() string {
+	parts := []string{r.Workspace, r.Store, r.Name}
+	if r.Store == "" {
+		parts = []string{r.Workspace, r.Name}
+	}
+
+	return fmt.Sprintf("%s:%s", r.Kind, strings.Join(parts, "/"))
+}
+
+// CrawlError is yielded for each listing or resource that could not be retrieved, the crawl goes on with the rest
+// of the catalog.
+type CrawlError struct {
+	// Resource identifies what failed, its Name is empty when listing the content of a workspace or store failed
+	Resource Resource
+	Err      error
+}
+
+func (ce *CrawlError) Error() string {
+	if ce.Resource.Kind == "" {
+		return fmt.Sprintf("crawling workspaces: %s", ce.Err)
+	}
+
+	return fmt.Sprintf("crawling %s: %s", ce.Resource.Path(), ce.Err)
+}
+
+func (ce *CrawlError) Unwrap() error {
+	return ce.Err
+}
+
+// Crawler remembers the resources it yielded, so that crawling again after errors only yields what is missing.
+// The workspaces and stores are listed and the stores retrieved again on every crawl, since their content may have
+// changed, but the feature types, coverages and layer groups already yielded are not retrieved again.
+type Crawler struct {
+	gc      client.GeoserverClient
+	options models.CrawlerOptions
+
+	mutex sync.Mutex
+	done  map[string]bool
+}
+
+func New(gc client.GeoserverClient, opts ...options.CrawlerOption) *Crawler {
+	c := &Crawler{
+		gc:   gc,
+		done: make(map[string]bool),
+	}
+
+	for _, opt := range opts {
+		opt(&c.options)
+	}
+
+	if c.options.Concurrency <= 0 {
+		c.options.Concurrency = 4
+	}
+
+	for _, p := range c.options.Checkpoint {
+		c.done[p] = true
+	}
+
+	return c
+}
+
+// Checkpoint lists the paths of the resources yielded so far, including those of the checkpoint the crawler was
+// resumed from. Passing it to options.Crawler.Resume lets a new crawler, e.g. in another process, skip them.
+func (c *Crawler) Checkpoint() []string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	checkpoint := make([]string, 0, len(c.done))
+	for p := range c.done {
+		checkpoint = append(checkpoint, p)
+	}
+	slices.Sort(checkpoint)
+
+	return checkpoint
+}
+
+// All crawls the catalog and yields the resources as soon as they are resolved, in no particular order.
+// Failures are yielded as a *CrawlError and do not stop the crawl; calling All again retries them while skipping
+// the resources already yielded. Breaking out of the loop stops the requests still pending.
+func (c *Crawler) All() iter.Seq2[Resource, error] {
+	return func(yield func(Resource, error) bool) {
+		if _, err := path.Match(c.options.Pattern, ""); err != nil {
+			yield(Resource{}, customerrors.WrapInputError(fmt.Errorf("invalid pattern %s: %w", c.options.Pattern, err)))
+			return
+		}
+
+		w := newWalk(c)
+		defer w.stop()
+
+		for r := range w.results {
+			if r.err == nil {
+				c.mutex.Lock()
+				c.done[r.resource.Path()] = true
+				c.mutex.Unlock()
+			}
+
+			if !yield(r.resource, r.err) {
+				return
+			}
+		}
+	}
+}
+
+// Crawl is shorthand for New(gc, opts...).All()
+func Crawl(gc client.GeoserverClient, opts ...options.CrawlerOption) iter.Seq2[Resource, error] {
+	return New(gc, opts...).All()
+}
+
+func (c *Crawler) skip(r Resource) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.done[r.Path()]
+}
+
+func (c *Crawler) matches(name string) bool {
+	if c.options.Pattern == "" {
+		return true
+	}
+
+	matched, _ := path.Match(c.options.Pattern, name)
+	return matched
+}
+
+func (c *Crawler) storeType(storeType string) bool {
+	if len(c.options.StoreTypes) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(c.options.StoreTypes, func(t string) bool {
+		return strings.EqualFold(t, storeType)
+	})
+}
+
+type result struct {
+	resource Resource
+	err      error
+}
+
+// walk is a single run of the crawler. Every listing and resource is handled by its own goroutine, the number of
+// requests in flight being bounded by the semaphore and their pace by the ticker.
+type walk struct {
+	crawler *Crawler
+	results chan result
+	done    chan struct{}
+	wg      sync.WaitGroup
+
+	semaphore chan struct{}
+	ticker    *time.Ticker
+}
+
+func newWalk(c *Crawler) *walk {
+	w := &walk{
+		crawler:   c,
+		results:   make(chan result),
+		done:      make(chan struct{}),
+		semaphore: make(chan struct{}, c.options.Concurrency),
+	}
+
+	if c.options.RateLimit > 0 {
+		// beyond one request per nanosecond the interval would round down to zero, which the ticker rejects
+		w.ticker = time.NewTicker(max(time.Second/time.Duration(c.options.RateLimit), time.Nanosecond))
+	}
+
+	w.spawn(w.workspaces)
+	go func() {
+		w.wg.Wait()
+		close(w.results)
+	}()
+
+	return w
+}
+
+// stop cancels the pending requests and waits for the goroutines to exit
+func (w *walk) stop() {
+	close(w.done)
+	for range w.results {
+	}
+
+	if w.ticker != nil {
+		w.ticker.Stop()
+	}
+}
+
+func (w *walk) spawn(f func()) {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		f()
+	}()
+}
+
+// call runs the request once a slot is free and the rate limit allows it, it reports false if the walk was stopped
+func (w *walk) call(request func()) bool {
+	select {
+	case w.semaphore <- struct{}{}:
+	case <-w.done:
+		return false
+	}
+	defer func() { <-w.semaphore }()
+
+	if w.ticker != nil {
+		select {
+		case <-w.ticker.C:
+		case <-w.done:
+			return false
+		}
+	}
+
+	request()
+	return true
+}
+
+func (w *walk) send(r Resource, err error) {
+	if err != nil {
+		err = &CrawlError{Resource: r, Err: err}
+	}
+
+	select {
+	case w.results <- result{resource: r, err: err}:
+	case <-w.done:
+	}
+}
+
+func (w *walk) workspaces() {
+	gc := w.crawler.gc
+
+	names := w.crawler.options.Workspaces
+	if len(names) == 0 {
+		var all []workspace.MultiWorkspace
+		var err error
+		if !w.call(func() { all, err = gc.Workspaces().GetAll() }) {
+			return
+		}
+
+		if err != nil {
+			w.send(Resource{}, err)
+			return
+		}
+
+		for _, ws := range all {
+			names = append(names, ws.Name)
+		}
+
+		if len(w.crawler.options.StoreTypes) == 0 {
+			w.spawn(func() { w.layerGroups("") })
+		}
+	}
+
+	for _, ws := range names {
+		w.spawn(func() { w.dataStores(ws) })
+		w.spawn(func() { w.coverageStores(ws) })
+		if len(w.crawler.options.StoreTypes) == 0 {
+			w.spawn(func() { w.layerGroups(ws) })
+		}
+	}
+}
+
+func (w *walk) dataStores(ws string) {
+	var list *datastores.DataStores
+	var err error
+	if !w.call(func() { list, err = w.crawler.gc.Workspace(ws).DataStores().GetAll() }) {
+		return
+	}
+
+	if err != nil {
+		w.send(Resource{Kind: KindDataStore, Workspace: ws}, err)
+		return
+	}
+
+	for _, entry := range list.Entries {
+		w.spawn(func() { w.dataStore(ws, entry.Name) })
+	}
+}
+
+func (w *walk) dataStore(ws, name string) {
+	r := Resource{Kind: KindDataStore, Workspace: ws, Name: name}
+
+	var store *datastores.DataStore
+	var err error
+	if !w.call(func() { store, err = w.crawler.gc.Workspace(ws).DataStores().Get(name) }) {
+		return
+	}
+
+	if err != nil {
+		w.send(r, err)
+		return
+	}
+
+	if !w.crawler.storeType(store.Type) {
+		return
+	}
+
+	if w.crawler.matches(name) && !w.crawler.skip(r) {
+		r.DataStore = store
+		w.send(r, nil)
+	}
+
+	var list *featuretypes.FeatureTypes
+	if !w.call(func() { list, err = w.crawler.gc.Workspace(ws).DataStore(name).GetAll() }) {
+		return
+	}
+
+	if err != nil {
+		w.send(Resource{Kind: KindFeatureType, Workspace: ws, Store: name}, err)
+		return
+	}
+
+	for _, entry := range list.Entries {
+		ft := Resource{Kind: KindFeatureType, Workspace: ws, Store: name, Name: entry.Name}
+		if !w.crawler.matches(entry.Name) || w.crawler.skip(ft) {
+			continue
+		}
+
+		w.spawn(func() {
+			var err error
+			if w.call(func() { ft.FeatureType, err = w.crawler.gc.Workspace(ws).DataStore(name).Get(entry.Name) }) {
+				w.send(ft, err)
+			}
+		})
+	}
+}
+
+func (w *walk) coverageStores(ws string) {
+	var list *coveragestores.CoverageStores
+	var err error
+	if !w.call(func() { list, err = w.crawler.gc.Workspace(ws).CoverageStores().GetAll() }) {
+		return
+	}
+
+	if err != nil {
+		w.send(Resource{Kind: KindCoverageStore, Workspace: ws}, err)
+		return
+	}
+
+	for _, entry := range list.Entries {
+		w.spawn(func() { w.coverageStore(ws, entry.Name) })
+	}
+}
+
+func (w *walk) coverageStore(ws, name string) {
+	r := Resource{Kind: KindCoverageStore, Workspace: ws, Name: name}
+
+	var store *coveragestores.CoverageStore
+	var err error
+	if !w.call(func() { store, err = w.crawler.gc.Workspace(ws).CoverageStores().Get(name) }) {
+		return
+	}
+
+	if err != nil {
+		w.send(r, err)
+		return
+	}
+
+	if !w.crawler.storeType(store.Type) {
+		return
+	}
+
+	if w.crawler.matches(name) && !w.crawler.skip(r) {
+		r.CoverageStore = store
+		w.send(r, nil)
+	}
+
+	var list *coverages.Coverages
+	if !w.call(func() { list, err = w.crawler.gc.Workspace(ws).CoverageStore(name).GetAll() }) {
+		return
+	}
+
+	if err != nil {
+		w.send(Resource{Kind: KindCoverage, Workspace: ws, Store: name}, err)
+		return
+	}
+
+	for _, entry := range list.Entries {
+		c := Resource{Kind: KindCoverage, Workspace: ws, Store: name, Name: entry.Name}
+		if !w.crawler.matches(entry.Name) || w.crawler.skip(c) {
+			continue
+		}
+
+		w.spawn(func() {
+			var err error
+			if w.call(func() { c.Coverage, err = w.crawler.gc.Workspace(ws).CoverageStore(name).Get(entry.Name) }) {
+				w.send(c, err)
+			}
+		})
+	}
+}
+
+// layerGroups crawls the layer groups of ws, or the global layer groups when ws is empty
+func (w *walk) layerGroups(ws string) {
+	groups := w.crawler.gc.Workspace(ws).LayerGroups()
+	if ws == "" {
+		groups = w.crawler.gc.LayerGroups()
+	}
+
+	var list *layers.Groups
+	var err error
+	if !w.call(func() { list, err = groups.GetAll() }) {
+		return
+	}
+
+	if err != nil {
+		w.send(Resource{Kind: KindLayerGroup, Workspace: ws}, err)
+		return
+	}
+
+	for _, entry := range list.Entries {
+		lg := Resource{Kind: KindLayerGroup, Workspace: ws, Name: entry.Name}
+		if !w.crawler.matches(entry.Name) || w.crawler.skip(lg) {
+			continue
+		}
+
+		w.spawn(func() {
+			var err error
+			if w.call(func() { lg.LayerGroup, err = groups.Get(entry.Name) }) {
+				w.send(lg, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package crawler_test
+
+import (
+	"errors"
+	"net/http"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/canghel3/go-geoserver/pkg/client"
+	"github.com/canghel3/go-geoserver/pkg/coverages"
+	"github.com/canghel3/go-geoserver/pkg/crawler"
+	"github.com/canghel3/go-geoserver/pkg/datastores"
+	"github.com/canghel3/go-geoserver/pkg/featuretypes"
+	"github.com/canghel3/go-geoserver/pkg/geoservertest"
+	"github.com/canghel3/go-geoserver/pkg/layers"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// catalog is the content of the fake GeoServer crawled by the tests
+var catalog = []string{
+	"coverage:roads/dem/dem",
+	"coveragestore:roads/dem",
+	"datastore:roads/postgis",
+	"datastore:water/files",
+	"featuretype:roads/postgis/highways",
+	"featuretype:roads/postgis/motorways",
+	"featuretype:water/files/rivers",
+	"layergroup:roads/basemap",
+}
+
+func newCatalog(t *testing.T) (*geoservertest.Server, client.GeoserverClient) {
+	t.Helper()
+
+	server := geoservertest.NewServer()
+	t.Cleanup(server.Close)
+
+	gc := server.Client()
+	require.NoError(t, gc.Workspaces().Create("roads", false))
+	require.NoError(t, gc.Workspaces().Create("water", false))
+
+	roads := gc.Workspace("roads")
+	require.NoError(t, roads.DataStores().Create().Custom("postgis", "PostGIS", datastores.ConnectionParams{"dbtype": "postgis", "host": "db"}))
+	require.NoError(t, roads.DataStore("postgis").Publish(featuretypes.New("motorways", "motorways")))
+	require.NoError(t, roads.DataStore("postgis").Publish(featuretypes.New("highways", "highways")))
+	require.NoError(t, roads.CoverageStores().Create().GeoTIFF("dem", "/data/dem.tif"))
+	require.NoError(t, roads.CoverageStore("dem").Publish(coverages.New("dem", "dem")))
+	require.NoError(t, roads.LayerGroups().Publish(layers.NewGroup("basemap", layers.ModeSingle, []layers.LayerInput{
+		{Type: layers.TypeLayer, Name: "dem"},
+		{Type: layers.TypeLayer, Name: "motorways"},
+	})))
+
+	water := gc.Workspace("water")
+	require.NoError(t, water.DataStores().Create().Custom("files", "Properties", datastores.ConnectionParams{"directory": "/data/water"}))
+	require.NoError(t, water.DataStore("files").Publish(featuretypes.New("rivers", "rivers")))
+
+	return server, gc
+}
+
+// crawl collects the paths of the resources yielded and the errors
+func crawl(t *testing.T, c *crawler.Crawler) ([]string, []error) {
+	t.Helper()
+
+	var paths []string
+	var errs []error
+	for resource, err := range c.All() {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		paths = append(paths, resource.Path())
+	}
+
+	return paths, errs
+}
+
+func TestCrawler_All(t *testing.T) {
+	_, gc := newCatalog(t)
+
+	c := crawler.New(gc, options.Crawler.Concurrency(3))
+	paths, errs := crawl(t, c)
+	assert.Empty(t, errs)
+	assert.ElementsMatch(t, catalog, paths)
+	assert.Equal(t, catalog, c.Checkpoint())
+
+	// everything was yielded, crawling again yields nothing
+	paths, errs = crawl(t, c)
+	assert.Empty(t, errs)
+	assert.Empty(t, paths)
+}
+
+func TestCrawler_Break(t *testing.T) {
+	_, gc := newCatalog(t)
+
+	for resource, err := range crawler.Crawl(gc, options.Crawler.Concurrency(2)) {
+		require.NoError(t, err)
+		assert.NotEmpty(t, resource.Path())
+		break
+	}
+
+	// stopping the crawl waits for its goroutines, none of them is left behind
+	assert.Eventually(t, func() bool {
+		buffer := make([]byte, 1<<20)
+		stacks := string(buffer[:runtime.Stack(buffer, true)])
+		return !strings.Contains(stacks, "go-geoserver/pkg/crawler.(*walk)")
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestCrawler_Retry(t *testing.T) {
+	server, gc := newCatalog(t)
+
+	server.Inject(geoservertest.Failure{
+		Method: http.MethodGet,
+		Path:   "/workspaces/roads/datastores/postgis/featuretypes/motorways",
+		Times:  1,
+	})
+
+	c := crawler.New(gc)
+	paths, errs := crawl(t, c)
+	assert.Len(t, paths, len(catalog)-1)
+	assert.NotContains(t, paths, "featuretype:roads/postgis/motorways")
+	require.Len(t, errs, 1)
+
+	var crawlError *crawler.CrawlError
+	require.True(t, errors.As(errs[0], &crawlError))
+	assert.Equal(t, "featuretype:roads/postgis/motorways", crawlError.Resource.Path())
+
+	// the failure is gone, only the resource that failed is yielded
+	paths, errs = crawl(t, c)
+	assert.Empty(t, errs)
+	assert.Equal(t, []string{"featuretype:roads/postgis/motorways"}, paths)
+}
+
+func TestCrawler_Resume(t *testing.T) {
+	_, gc := newCatalog(t)
+
+	checkpoint := []string{"datastore:roads/postgis", "featuretype:roads/postgis/motorways"}
+	paths, errs := crawl(t, crawler.New(gc, options.Crawler.Resume(checkpoint)))
+	assert.Empty(t, errs)
+	assert.ElementsMatch(t, []string{
+		"coverage:roads/dem/dem",
+		"coveragestore:roads/dem",
+		"datastore:water/files",
+		"featuretype:roads/postgis/highways",
+		"featuretype:water/files/rivers",
+		"layergroup:roads/basemap",
+	}, paths)
+
+	paths, errs = crawl(t, crawler.New(gc, options.Crawler.Resume(catalog)))
+	assert.Empty(t, errs)
+	assert.Empty(t, paths)
+}
+
+func TestCrawler_Filters(t *testing.T) {
+	_, gc := newCatalog(t)
+
+	tests := []struct {
+		name     string
+		options  []options.CrawlerOption
+		expected []string
+	}{
+		{
+			name:     "Workspaces",
+			options:  []options.CrawlerOption{options.Crawler.Workspaces("water")},
+			expected: []string{"datastore:water/files", "featuretype:water/files/rivers"},
+		},
+		{
+			name:     "StoreTypes",
+			options:  []options.CrawlerOption{options.Crawler.StoreTypes("geotiff")},
+			expected: []string{"coverage:roads/dem/dem", "coveragestore:roads/dem"},
+		},
+		{
+			name:     "Pattern",
+			options:  []options.CrawlerOption{options.Crawler.Pattern("*ways")},
+			expected: []string{"featuretype:roads/postgis/highways", "featuretype:roads/postgis/motorways"},
+		},
+		{
+			name:     "Combined",
+			options:  []options.CrawlerOption{options.Crawler.Workspaces("roads"), options.Crawler.StoreTypes("PostGIS"), options.Crawler.Pattern("motor*")},
+			expected: []string{"featuretype:roads/postgis/motorways"},
+		},
+		{
+			name:     "RateLimit",
+			options:  []options.CrawlerOption{options.Crawler.RateLimit(2_000_000_000)},
+			expected: catalog,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			paths, errs := crawl(t, crawler.New(gc, test.options...))
+			assert.Empty(t, errs)
+			assert.ElementsMatch(t, test.expected, paths)
+		})
+	}
+
+	t.Run("Invalid Pattern", func(t *testing.T) {
+		_, errs := crawl(t, crawler.New(gc, options.Crawler.Pattern("[")))
+		assert.Len(t, errs, 1)
+	})
+}
@@ -0,0 +1,43 @@
+package crawler_test
+
+import (
+	"fmt"
+
+	"github.com/canghel3/go-geoserver/pkg/client"
+	"github.com/canghel3/go-geoserver/pkg/crawler"
+	"github.com/canghel3/go-geoserver/pkg/options"
+)
+
+func ExampleCrawler_All() {
+	geoclient := client.NewGeoserverClient("http://localhost:8080", "admin", "geoserver")
+
+	c := crawler.New(geoclient,
+		options.Crawler.Concurrency(8),
+		options.Crawler.RateLimit(50),
+		options.Crawler.StoreTypes("PostGIS"),
+		options.Crawler.Pattern("roads_*"),
+	)
+
+	var failed int
+	for resource, err := range c.All() {
+		if err != nil {
+			fmt.Println("Error crawling:", err)
+			failed++
+			continue
+		}
+
+		if resource.Kind == crawler.KindFeatureType {
+			fmt.Println(resource.Path(), resource.FeatureType.Srs)
+		}
+	}
+
+	// crawling again lists the stores again but only yields what failed
+	if failed > 0 {
+		for resource, err := range c.All() {
+			fmt.Println(resource.Path(), err)
+		}
+	}
+
+	// the checkpoint can be saved to resume from another process with options.Crawler.Resume
+	fmt.Println(len(c.Checkpoint()), "resources crawled")
+}
@@ -7,6 +7,7 @@ type GenericDataStoreCreationWrapper struct {
 type GenericDataStoreCreationModel struct {
 	Name                       string               `json:"name"`
 	Description                string               `json:"description"`
+	Type                       string               `json:"type,omitempty"`
 	DisableOnConnectionFailure bool                 `json:"disableOnConnFailure"`
 	ConnectionParameters       ConnectionParameters `json:"connectionParameters"`
 }
@@ -0,0 +1,8 @@
+package h2
+
+type ConnectionParams struct {
+	// Database is the path of the database file, without the .h2.db or .mv.db extension
+	Database string
+	User     string
+	Password string
+}
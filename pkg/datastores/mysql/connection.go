@@ -0,0 +1,9 @@
+package mysql
+
+type ConnectionParams struct {
+	Host     string
+	Port     string
+	Database string
+	User     string
+	Password string
+}
@@ -0,0 +1,10 @@
+package oracle
+
+type ConnectionParams struct {
+	Host     string
+	Port     string
+	Database string
+	Schema   string
+	User     string
+	Password string
+}
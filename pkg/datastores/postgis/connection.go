@@ -6,5 +6,18 @@ type ConnectionParams struct {
 	User     string
 	Password string
 	Port     string
-	SSL      string
+	// SSL is sent upper-cased as the SSL mode of the connection when it is not empty, see SSLMode
+	SSL string
 }
+
+// SSLMode is the SSL mode used by GeoServer when connecting to PostGIS.
+type SSLMode string
+
+const (
+	SSLDisable    SSLMode = "DISABLE"
+	SSLAllow      SSLMode = "ALLOW"
+	SSLPrefer     SSLMode = "PREFER"
+	SSLRequire    SSLMode = "REQUIRE"
+	SSLVerifyCA   SSLMode = "VERIFY_CA"
+	SSLVerifyFull SSLMode = "VERIFY_FULL"
+)
@@ -0,0 +1,11 @@
+package sqlserver
+
+type ConnectionParams struct {
+	Host     string
+	Port     string
+	Instance string
+	Database string
+	Schema   string
+	User     string
+	Password string
+}
@@ -0,0 +1,232 @@
+package dependencies
+
+import (
+	"strings"
+
+	"github.com/canghel3/go-geoserver/pkg/client"
+	"github.com/canghel3/go-geoserver/pkg/layers"
+	"github.com/canghel3/go-geoserver/pkg/shared"
+)
+
+// Build resolves the graph of the given workspaces, or of every workspace when none is given, along with the
+// global layer groups. The feature types, coverages and cascaded layers are found through the layers publishing
+// them. The styles of a workspace are removed along with it, even when unused. Global styles are only recorded
+// through the layers and layer groups using them.
+func Build(gc client.GeoserverClient, workspaces ...string) (*Graph, error) {
+	if len(workspaces) == 0 {
+		all, err := gc.Workspaces().GetAll()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ws := range all {
+			workspaces = append(workspaces, ws.Name)
+		}
+	}
+
+	g := NewGraph()
+	for _, ws := range workspaces {
+		if err := g.workspace(gc, ws); err != nil {
+			return nil, err
+		}
+	}
+
+	global := gc.LayerGroups()
+	all, err := global.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range all.Entries {
+		group, err := global.Get(entry.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		g.group("", group)
+	}
+
+	return g, nil
+}
+
+// DryRunDelete builds the graph of every workspace and previews deleting r recursively. The whole catalog is
+// resolved since the layer groups of other workspaces can draw the layers removed along with r.
+func DryRunDelete(gc client.GeoserverClient, r Resource) (*Deletion, error) {
+	g, err := Build(gc)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.DryRunDelete(r)
+}
+
+func (g *Graph) workspace(gc client.GeoserverClient, ws string) error {
+	workspace := Workspace(ws)
+	g.resources[workspace] = true
+
+	dataStores, err := gc.Workspace(ws).DataStores().GetAll()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range dataStores.Entries {
+		g.Add(workspace, DataStore(ws, entry.Name))
+	}
+
+	coverageStores, err := gc.Workspace(ws).CoverageStores().GetAll()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range coverageStores.Entries {
+		g.Add(workspace, CoverageStore(ws, entry.Name))
+	}
+
+	wmsStores, err := gc.Workspace(ws).WMSStores().GetAll()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range wmsStores.Entries {
+		g.Add(workspace, WMSStore(ws, entry.Name))
+	}
+
+	wmtsStores, err := gc.Workspace(ws).WMTSStores().GetAll()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range wmtsStores.Entries {
+		g.Add(workspace, WMTSStore(ws, entry.Name))
+	}
+
+	styles, err := gc.Workspace(ws).Styles().GetAll()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range styles.Entries {
+		g.Add(workspace, Style(ws, unqualified(entry.Name)))
+	}
+
+	all, err := gc.Workspace(ws).Layers().GetAll()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range all.Entries {
+		layer, err := gc.Workspace(ws).Layers().Get(entry.Name)
+		if err != nil {
+			return err
+		}
+
+		g.layer(ws, layer)
+	}
+
+	groups, err := gc.Workspace(ws).LayerGroups().GetAll()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range groups.Entries {
+		group, err := gc.Workspace(ws).LayerGroups().Get(entry.Name)
+		if err != nil {
+			return err
+		}
+
+		g.Add(workspace, LayerGroup(ws, group.Name))
+		g.group(ws, group)
+	}
+
+	return nil
+}
+
+func (g *Graph) layer(ws string, layer *layers.Layer) {
+	var resourceKind, storeKind Kind
+	switch layer.Resource.Class {
+	case "featureType":
+		resourceKind, storeKind = KindFeatureType, KindDataStore
+	case "coverage":
+		resourceKind, storeKind = KindCoverage, KindCoverageStore
+	case "wmsLayer":
+		resourceKind, storeKind = KindWMSLayer, KindWMSStore
+	case "wmtsLayer":
+		resourceKind, storeKind = KindWMTSLayer, KindWMTSStore
+	}
+
+	name := strings.TrimPrefix(layer.Name, ws+":")
+	published := Layer(ws, name)
+	if resourceKind != "" {
+		store := Resource{Kind: storeKind, Workspace: ws, Name: layer.Resource.Store()}
+		resource := Resource{Kind: resourceKind, Workspace: ws, Store: store.Name, Name: unqualified(layer.Resource.Name)}
+
+		g.Add(Workspace(ws), store)
+		g.Add(store, resource)
+		g.Add(resource, published)
+	} else {
+		g.Add(Workspace(ws), published)
+	}
+
+	if layer.DefaultStyle != nil {
+		g.style(ws, published, *layer.DefaultStyle)
+	}
+
+	if layer.Styles != nil {
+		for _, style := range layer.Styles.Style {
+			g.style(ws, published, style)
+		}
+	}
+}
+
+// group records the members and styles of a layer group of ws, or of a global layer group when ws is empty
+func (g *Graph) group(ws string, group *layers.Group) {
+	resource := LayerGroup(ws, group.Name)
+	g.resources[resource] = true
+
+	if group.Publishables != nil {
+		for _, entry := range group.Publishables.Entries {
+			memberWorkspace, name := qualified(entry.Name, ws)
+			if entry.Type == string(layers.TypeLayerGroup) {
+				g.AddMember(resource, LayerGroup(memberWorkspace, name))
+			} else {
+				g.AddMember(resource, Layer(memberWorkspace, name))
+			}
+		}
+	}
+
+	if group.Styles != nil {
+		for _, style := range group.Styles.Style {
+			g.style(ws, resource, style)
+		}
+	}
+}
+
+// style records that r uses style, a style of ws is removed along with the workspace
+func (g *Graph) style(ws string, r Resource, style shared.Style) {
+	if style.Name == "" {
+		return
+	}
+
+	styleWorkspace, name := qualified(style.Name, "")
+	s := Style(styleWorkspace, name)
+	g.AddStyle(r, s)
+
+	if styleWorkspace != "" && styleWorkspace == ws {
+		g.Add(Workspace(ws), s)
+	}
+}
+
+// qualified splits a name prefixed with its workspace, defaulting to ws when there is no prefix
+func qualified(name, ws string) (string, string) {
+	prefix, local, found := strings.Cut(name, ":")
+	if !found {
+		return ws, name
+	}
+
+	return prefix, local
+}
+
+func unqualified(name string) string {
+	_, local := qualified(name, "")
+	return local
+}
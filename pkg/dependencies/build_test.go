@@ -0,0 +1,180 @@
+package dependencies_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/canghel3/go-geoserver/pkg/client"
+	"github.com/canghel3/go-geoserver/pkg/coverages"
+	"github.com/canghel3/go-geoserver/pkg/datastores"
+	"github.com/canghel3/go-geoserver/pkg/dependencies"
+	"github.com/canghel3/go-geoserver/pkg/featuretypes"
+	"github.com/canghel3/go-geoserver/pkg/geoservertest"
+	"github.com/canghel3/go-geoserver/pkg/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCatalog fills a fake GeoServer with two workspaces, the layer groups of roads drawing its layers with the global
+// line style, a group of roads drawing a layer of water, a style of water and a global layer group drawing a layer of
+// each workspace
+func newCatalog(t *testing.T) (*geoservertest.Server, client.GeoserverClient) {
+	t.Helper()
+
+	server := geoservertest.NewServer()
+	t.Cleanup(server.Close)
+
+	gc := server.Client()
+	require.NoError(t, gc.Workspaces().Create("roads", false))
+	require.NoError(t, gc.Workspaces().Create("water", false))
+
+	roads := gc.Workspace("roads")
+	require.NoError(t, roads.DataStores().Create().Custom("postgis", "PostGIS", datastores.ConnectionParams{"dbtype": "postgis", "host": "db"}))
+	require.NoError(t, roads.DataStore("postgis").Publish(featuretypes.New("motorways", "motorways")))
+	require.NoError(t, roads.DataStore("postgis").Publish(featuretypes.New("highways", "highways")))
+	require.NoError(t, roads.CoverageStores().Create().GeoTIFF("dem", "/data/dem.tif"))
+	require.NoError(t, roads.CoverageStore("dem").Publish(coverages.New("dem", "dem")))
+	require.NoError(t, roads.LayerGroups().Publish(layers.NewGroup("basemap", layers.ModeSingle, []layers.LayerInput{
+		{Type: layers.TypeLayer, Name: "dem"},
+		{Type: layers.TypeLayer, Name: "motorways", Style: "line"},
+	})))
+	require.NoError(t, roads.LayerGroups().Publish(layers.NewGroup("network", layers.ModeSingle, []layers.LayerInput{
+		{Type: layers.TypeLayer, Name: "motorways"},
+		{Type: layers.TypeLayer, Name: "highways"},
+	})))
+
+	water := gc.Workspace("water")
+	require.NoError(t, water.DataStores().Create().Custom("files", "Properties", datastores.ConnectionParams{"directory": "/data/water"}))
+	require.NoError(t, water.DataStore("files").Publish(featuretypes.New("rivers", "rivers")))
+
+	// the client neither publishes global layer groups, nor groups drawing the layers of other workspaces, nor creates
+	// styles, they are posted as GeoServer expects them
+	post(t, server, "/workspaces/roads/layergroups", `{"layerGroup":{"name":"crossings","publishables":{"published":[{"@type":"layer","name":"roads:highways"},{"@type":"layer","name":"water:rivers"}]}}}`)
+	post(t, server, "/layergroups", `{"layerGroup":{"name":"overview","publishables":{"published":[{"@type":"layer","name":"roads:highways"},{"@type":"layer","name":"water:rivers"}]}}}`)
+	post(t, server, "/workspaces/water/styles", `{"style":{"name":"flow"}}`)
+
+	return server, gc
+}
+
+// post sends the content to the server, path being relative to /geoserver/rest
+func post(t *testing.T, server *geoservertest.Server, path, content string) {
+	t.Helper()
+
+	request, err := http.NewRequest(http.MethodPost, server.URL+"/geoserver/rest"+path, strings.NewReader(content))
+	require.NoError(t, err)
+	request.SetBasicAuth(geoservertest.Username, geoservertest.Password)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	response.Body.Close()
+	require.Equal(t, http.StatusCreated, response.StatusCode)
+}
+
+func TestBuild(t *testing.T) {
+	_, gc := newCatalog(t)
+
+	g, err := dependencies.Build(gc)
+	require.NoError(t, err)
+
+	deletion, err := g.DryRunDelete(dependencies.Workspace("water"))
+	require.NoError(t, err)
+	assert.Equal(t, []dependencies.Resource{
+		dependencies.Layer("water", "rivers"),
+		dependencies.FeatureType("water", "files", "rivers"),
+		dependencies.DataStore("water", "files"),
+		dependencies.Style("water", "flow"),
+		dependencies.Workspace("water"),
+	}, deletion.Removed)
+	assert.Equal(t, []dependencies.Resource{
+		dependencies.LayerGroup("", "overview"),
+		dependencies.LayerGroup("roads", "crossings"),
+	}, deletion.Modified)
+}
+
+func TestDryRunDelete(t *testing.T) {
+	_, gc := newCatalog(t)
+
+	tests := []struct {
+		name     string
+		resource dependencies.Resource
+		removed  []dependencies.Resource
+		modified []dependencies.Resource
+		styles   []dependencies.Resource
+	}{
+		{
+			name:     "Data Store",
+			resource: dependencies.DataStore("roads", "postgis"),
+			removed: []dependencies.Resource{
+				dependencies.LayerGroup("roads", "network"),
+				dependencies.Layer("roads", "highways"),
+				dependencies.Layer("roads", "motorways"),
+				dependencies.FeatureType("roads", "postgis", "highways"),
+				dependencies.FeatureType("roads", "postgis", "motorways"),
+				dependencies.DataStore("roads", "postgis"),
+			},
+			modified: []dependencies.Resource{
+				dependencies.LayerGroup("", "overview"),
+				dependencies.LayerGroup("roads", "basemap"),
+				dependencies.LayerGroup("roads", "crossings"),
+			},
+			// the line style is only used by a group which is kept
+			styles: []dependencies.Resource{dependencies.Style("", "generic")},
+		},
+		{
+			name:     "Coverage Store",
+			resource: dependencies.CoverageStore("roads", "dem"),
+			removed: []dependencies.Resource{
+				dependencies.Layer("roads", "dem"),
+				dependencies.Coverage("roads", "dem", "dem"),
+				dependencies.CoverageStore("roads", "dem"),
+			},
+			modified: []dependencies.Resource{dependencies.LayerGroup("roads", "basemap")},
+			styles:   []dependencies.Resource{dependencies.Style("", "raster")},
+		},
+		{
+			// the layers of water are drawn by a layer group of roads
+			name:     "Workspace",
+			resource: dependencies.Workspace("water"),
+			removed: []dependencies.Resource{
+				dependencies.Layer("water", "rivers"),
+				dependencies.FeatureType("water", "files", "rivers"),
+				dependencies.DataStore("water", "files"),
+				dependencies.Style("water", "flow"),
+				dependencies.Workspace("water"),
+			},
+			modified: []dependencies.Resource{
+				dependencies.LayerGroup("", "overview"),
+				dependencies.LayerGroup("roads", "crossings"),
+			},
+			styles: []dependencies.Resource{dependencies.Style("", "generic")},
+		},
+		{
+			name:     "Layer Group",
+			resource: dependencies.LayerGroup("roads", "basemap"),
+			removed:  []dependencies.Resource{dependencies.LayerGroup("roads", "basemap")},
+			styles:   []dependencies.Resource{dependencies.Style("", "line")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deletion, err := dependencies.DryRunDelete(gc, test.resource)
+			require.NoError(t, err)
+			assert.Equal(t, test.removed, deletion.Removed)
+			assert.Equal(t, test.modified, deletion.Modified)
+			assert.Equal(t, test.styles, deletion.Styles)
+		})
+	}
+}
+
+func TestDryRunDelete_Error(t *testing.T) {
+	server, gc := newCatalog(t)
+
+	server.Inject(geoservertest.Failure{Method: http.MethodGet, Path: "/workspaces/roads/wmtsstores"})
+
+	deletion, err := dependencies.DryRunDelete(gc, dependencies.DataStore("roads", "postgis"))
+	assert.Error(t, err)
+	assert.Nil(t, deletion)
+}
@@ -0,0 +1,154 @@
+// Package dependencies resolves which layers, layer groups and styles depend on a store or resource, to preview
+// what a recursive delete removes before running it.
+package dependencies
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type Kind string
+
+const (
+	KindWorkspace     Kind = "workspace"
+	KindDataStore     Kind = "datastore"
+	KindCoverageStore Kind = "coveragestore"
+	KindWMSStore      Kind = "wmsstore"
+	KindWMTSStore     Kind = "wmtsstore"
+	KindFeatureType   Kind = "featuretype"
+	KindCoverage      Kind = "coverage"
+	KindWMSLayer      Kind = "wmslayer"
+	KindWMTSLayer     Kind = "wmtslayer"
+	KindLayer         Kind = "layer"
+	KindLayerGroup    Kind = "layergroup"
+	KindStyle         Kind = "style"
+)
+
+// Resource identifies a resource of the catalog
+type Resource struct {
+	Kind Kind
+	// Workspace is empty for the global layer groups and styles
+	Workspace string
+	// Store is set for the feature types, coverages and cascaded layers
+	Store string
+	Name  string
+}
+
+func Workspace(name string) Resource {
+	return Resource{Kind: KindWorkspace, Name: name}
+}
+
+func DataStore(workspace, name string) Resource {
+	return Resource{Kind: KindDataStore, Workspace: workspace, Name: name}
+}
+
+func CoverageStore(workspace, name string) Resource {
+	return Resource{Kind: KindCoverageStore, Workspace: workspace, Name: name}
+}
+
+func WMSStore(workspace, name string) Resource {
+	return Resource{Kind: KindWMSStore, Workspace: workspace, Name: name}
+}
+
+func WMTSStore(workspace, name string) Resource {
+	return Resource{Kind: KindWMTSStore, Workspace: workspace, Name: name}
+}
+
+func FeatureType(workspace, store, name string) Resource {
+	return Resource{Kind: KindFeatureType, Workspace: workspace, Store: store, Name: name}
+}
+
+func Coverage(workspace, store, name string) Resource {
+	return Resource{Kind: KindCoverage, Workspace: workspace, Store: store, Name: name}
+}
+
+func Layer(workspace, name string) Resource {
+	return Resource{Kind: KindLayer, Workspace: workspace, Name: name}
+}
+
+// LayerGroup identifies a layer group of the workspace, or a global layer group when workspace is empty
+func LayerGroup(workspace, name string) Resource {
+	return Resource{Kind: KindLayerGroup, Workspace: workspace, Name: name}
+}
+
+// Style identifies a style of the workspace, or a global style when workspace is empty
+func Style(workspace, name string) Resource {
+	return Resource{Kind: KindStyle, Workspace: workspace, Name: name}
+}
+
+// String formats the resource as "kind workspace:store/name"
+func (r Resource) String() string {
+	name := r.Name
+	if r.Store != "" {
+		name = r.Store + "/" + name
+	}
+
+	if r.Workspace != "" && r.Kind != KindWorkspace {
+		name = r.Workspace + ":" + name
+	}
+
+	return fmt.Sprintf("%s %s", r.Kind, name)
+}
+
+// rank orders the resources so that the dependents come before what they depend on
+func (r Resource) rank() int {
+	switch r.Kind {
+	case KindLayerGroup:
+		return 0
+	case KindLayer:
+		return 1
+	case KindFeatureType, KindCoverage, KindWMSLayer, KindWMTSLayer:
+		return 2
+	case KindDataStore, KindCoverageStore, KindWMSStore, KindWMTSStore:
+		return 3
+	case KindStyle:
+		return 4
+	default:
+		return 5
+	}
+}
+
+func sortResources(resources []Resource) {
+	slices.SortFunc(resources, func(a, b Resource) int {
+		if a.rank() != b.rank() {
+			return a.rank() - b.rank()
+		}
+
+		return strings.Compare(a.String(), b.String())
+	})
+}
+
+// Deletion previews the outcome of a recursive delete.
+type Deletion struct {
+	// Removed lists the resources removed, dependents first, starting with the layer groups left without layers
+	Removed []Resource
+	// Modified lists the remaining layer groups which lose some of their layers
+	Modified []Resource
+	// Styles lists the styles used by the removed layers and layer groups, which are kept
+	Styles []Resource
+}
+
+// Empty reports whether the delete only removes the resource itself
+func (d Deletion) Empty() bool {
+	return len(d.Removed) <= 1 && len(d.Modified) == 0
+}
+
+// String renders the deletion, one resource per line
+func (d Deletion) String() string {
+	var b strings.Builder
+	for _, r := range d.Removed {
+		fmt.Fprintf(&b, "- %s\n", r)
+	}
+
+	for _, r := range d.Modified {
+		fmt.Fprintf(&b, "~ %s\n", r)
+	}
+
+	for _, r := range d.Styles {
+		fmt.Fprintf(&b, "  %s (kept)\n", r)
+	}
+
+	fmt.Fprintf(&b, "Delete: %d to remove, %d to modify.", len(d.Removed), len(d.Modified))
+	return b.String()
+}
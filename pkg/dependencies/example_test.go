@@ -0,0 +1,65 @@
+package dependencies_test
+
+import (
+	"fmt"
+
+	"github.com/canghel3/go-geoserver/pkg/client"
+	"github.com/canghel3/go-geoserver/pkg/dependencies"
+)
+
+func ExampleDryRunDelete() {
+	geoclient := client.NewGeoserverClient("http://localhost:8080", "admin", "geoserver")
+
+	store := dependencies.DataStore("roads", "postgis")
+	deletion, err := dependencies.DryRunDelete(geoclient, store)
+	if err != nil {
+		fmt.Println("Error previewing the delete:", err)
+		return
+	}
+
+	fmt.Println(deletion)
+
+	// nothing is deleted until the preview has been reviewed
+	err = geoclient.Workspace("roads").DataStores().Delete("postgis", true)
+	if err != nil {
+		fmt.Println("Error deleting the data store:", err)
+		return
+	}
+}
+
+func ExampleGraph_DryRunDelete() {
+	g := dependencies.NewGraph()
+
+	store := dependencies.DataStore("roads", "postgis")
+	motorways := dependencies.FeatureType("roads", "postgis", "motorways")
+	g.Add(dependencies.Workspace("roads"), store)
+	g.Add(store, motorways)
+	g.Add(motorways, dependencies.Layer("roads", "motorways"))
+	g.AddStyle(dependencies.Layer("roads", "motorways"), dependencies.Style("", "line"))
+
+	rivers := dependencies.CoverageStore("roads", "rivers")
+	g.Add(dependencies.Workspace("roads"), rivers)
+	g.Add(rivers, dependencies.Coverage("roads", "rivers", "rivers"))
+	g.Add(dependencies.Coverage("roads", "rivers", "rivers"), dependencies.Layer("roads", "rivers"))
+
+	// the first group only draws motorways and is removed, the second one loses it
+	g.AddMember(dependencies.LayerGroup("roads", "highways"), dependencies.Layer("roads", "motorways"))
+	g.AddMember(dependencies.LayerGroup("", "basemap"), dependencies.Layer("roads", "motorways"))
+	g.AddMember(dependencies.LayerGroup("", "basemap"), dependencies.Layer("roads", "rivers"))
+
+	deletion, err := g.DryRunDelete(store)
+	if err != nil {
+		fmt.Println("Error previewing the delete:", err)
+		return
+	}
+
+	fmt.Println(deletion)
+	// Output:
+	// - layergroup roads:highways
+	// - layer roads:motorways
+	// - featuretype roads:postgis/motorways
+	// - datastore roads:postgis
+	// ~ layergroup basemap
+	//   style line (kept)
+	// Delete: 4 to remove, 1 to modify.
+}
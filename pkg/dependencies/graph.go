@@ -0,0 +1,167 @@
+package dependencies
+
+import (
+	"fmt"
+
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+)
+
+// Graph links the resources of the catalog with the resources depending on them. A resource is either owned by
+// another one, like a feature type by its store, and removed along with it by a recursive delete, or a member of
+// layer groups, which only lose it.
+type Graph struct {
+	resources map[Resource]bool
+	owned     map[Resource][]Resource
+	members   map[Resource][]Resource
+	groups    map[Resource][]Resource
+	styles    map[Resource][]Resource
+}
+
+// NewGraph returns an empty graph, use Build to resolve the graph of a live catalog.
+func NewGraph() *Graph {
+	return &Graph{
+		resources: make(map[Resource]bool),
+		owned:     make(map[Resource][]Resource),
+		members:   make(map[Resource][]Resource),
+		groups:    make(map[Resource][]Resource),
+		styles:    make(map[Resource][]Resource),
+	}
+}
+
+// Add records that resource is removed along with its owner
+func (g *Graph) Add(owner, resource Resource) {
+	g.resources[owner] = true
+	g.resources[resource] = true
+	g.owned[owner] = appendUnique(g.owned[owner], resource)
+}
+
+// AddMember records that the layer group draws the layer or nested layer group member
+func (g *Graph) AddMember(group, member Resource) {
+	g.resources[group] = true
+	g.resources[member] = true
+	g.members[group] = appendUnique(g.members[group], member)
+	g.groups[member] = appendUnique(g.groups[member], group)
+}
+
+// AddStyle records that the layer or layer group is rendered with style
+func (g *Graph) AddStyle(resource, style Resource) {
+	g.resources[resource] = true
+	g.resources[style] = true
+	g.styles[resource] = appendUnique(g.styles[resource], style)
+}
+
+// Contains reports whether the resource is part of the graph
+func (g *Graph) Contains(r Resource) bool {
+	return g.resources[r]
+}
+
+// Dependents lists the resources owned by r and the layer groups drawing r
+func (g *Graph) Dependents(r Resource) []Resource {
+	dependents := append(append([]Resource{}, g.owned[r]...), g.groups[r]...)
+	sortResources(dependents)
+
+	return dependents
+}
+
+// Styles lists the styles used by r and by the layers and layer groups it owns
+func (g *Graph) Styles(r Resource) []Resource {
+	var styles []Resource
+	for _, owned := range g.closure(r) {
+		for _, style := range g.styles[owned] {
+			styles = appendUnique(styles, style)
+		}
+	}
+	sortResources(styles)
+
+	return styles
+}
+
+// DryRunDelete computes what deleting r with recurse set to true removes, without deleting anything. Besides the
+// resources owned by r, GeoServer removes the deleted layers from the layer groups drawing them, and removes the
+// layer groups left empty.
+func (g *Graph) DryRunDelete(r Resource) (*Deletion, error) {
+	if !g.resources[r] {
+		return nil, customerrors.WrapNotFoundError(fmt.Errorf("%s not found", r))
+	}
+
+	removed := make(map[Resource]bool)
+	for _, owned := range g.closure(r) {
+		removed[owned] = true
+	}
+
+	// a layer group left without members is removed, which can in turn empty the groups nesting it
+	for changed := true; changed; {
+		changed = false
+		for group, members := range g.members {
+			if removed[group] {
+				continue
+			}
+
+			empty := true
+			for _, member := range members {
+				if !removed[member] {
+					empty = false
+					break
+				}
+			}
+
+			if empty {
+				removed[group] = true
+				changed = true
+			}
+		}
+	}
+
+	deletion := &Deletion{}
+	modified := make(map[Resource]bool)
+	styles := make(map[Resource]bool)
+	for resource := range removed {
+		deletion.Removed = append(deletion.Removed, resource)
+
+		for _, group := range g.groups[resource] {
+			if !removed[group] && !modified[group] {
+				deletion.Modified = append(deletion.Modified, group)
+				modified[group] = true
+			}
+		}
+
+		for _, style := range g.styles[resource] {
+			if !removed[style] && !styles[style] {
+				deletion.Styles = append(deletion.Styles, style)
+				styles[style] = true
+			}
+		}
+	}
+
+	sortResources(deletion.Removed)
+	sortResources(deletion.Modified)
+	sortResources(deletion.Styles)
+
+	return deletion, nil
+}
+
+// closure returns r and every resource owned by it, directly or not
+func (g *Graph) closure(r Resource) []Resource {
+	seen := map[Resource]bool{r: true}
+	queue := []Resource{r}
+	for i := 0; i < len(queue); i++ {
+		for _, owned := range g.owned[queue[i]] {
+			if !seen[owned] {
+				seen[owned] = true
+				queue = append(queue, owned)
+			}
+		}
+	}
+
+	return queue
+}
+
+func appendUnique(resources []Resource, r Resource) []Resource {
+	for _, existing := range resources {
+		if existing == r {
+			return resources
+		}
+	}
+
+	return append(resources, r)
+}
@@ -0,0 +1,146 @@
+package featuretypes
+
+import (
+	"fmt"
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/canghel3/go-geoserver/pkg/types"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NewAttribute creates an attribute definition which can be published with options.FeatureType.Attributes.
+// By default the attribute is optional and occurs at most once.
+func NewAttribute(name string, binding types.Binding, options ...options.AttributeOption) models.Attribute {
+	attribute := new(models.Attribute)
+	attribute.Name = name
+	attribute.Binding = string(binding)
+	attribute.Nillable = true
+	attribute.MinOccurs = 0
+	attribute.MaxOccurs = 1
+
+	for _, option := range options {
+		option(attribute)
+	}
+
+	return *attribute
+}
+
+// AttributesFromStruct builds the attribute definitions from the exported fields of a struct.
+// The attribute name defaults to the lowercase field name and the binding is derived from the field type.
+// Fields can be customized with the geoserver tag:
+//
+//	type Road struct {
+//		ID       int64     `geoserver:"id,required"`
+//		Name     string    `geoserver:"name,length=120"`
+//		Opened   time.Time `geoserver:"opened"`
+//		Geometry any       `geoserver:"geom,binding=LineString"`
+//		Internal string    `geoserver:"-"`
+//	}
+//
+// The binding can be either a geometry type (e.g. Point, MultiPolygon) or a fully qualified java class.
+func AttributesFromStruct(v any) ([]models.Attribute, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, customerrors.WrapInputError(fmt.Errorf("expected a struct, got %v", t))
+	}
+
+	var attributes []models.Attribute
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("geoserver")
+		if tag == "-" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+
+		name := strings.TrimSpace(parts[0])
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+
+		var attributeOptions []options.AttributeOption
+		var binding types.Binding
+		for _, part := range parts[1:] {
+			key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+			switch key {
+			case "required":
+				attributeOptions = append(attributeOptions, options.Attribute.Required())
+			case "length":
+				length, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, customerrors.WrapInputError(fmt.Errorf("invalid length %q on field %s", value, field.Name))
+				}
+				attributeOptions = append(attributeOptions, options.Attribute.Length(length))
+			case "binding":
+				if strings.Contains(value, ".") {
+					binding = types.Binding(value)
+					break
+				}
+
+				switch geometry := types.GeometryType(value); geometry {
+				case types.Geometry, types.GeometryCollection, types.Point, types.MultiPoint, types.LineString,
+					types.MultiLineString, types.Polygon, types.MultiPolygon:
+					binding = geometry.Binding()
+				default:
+					return nil, customerrors.WrapInputError(fmt.Errorf("invalid binding %q on field %s, expected a geometry type or a java class", value, field.Name))
+				}
+			default:
+				return nil, customerrors.WrapInputError(fmt.Errorf("unknown tag option %q on field %s", key, field.Name))
+			}
+		}
+
+		if binding == "" {
+			b, ok := bindingOf(field.Type)
+			if !ok {
+				return nil, customerrors.WrapInputError(fmt.Errorf("unsupported type %s of field %s", field.Type, field.Name))
+			}
+			binding = b
+		}
+
+		attributes = append(attributes, NewAttribute(name, binding, attributeOptions...))
+	}
+
+	return attributes, nil
+}
+
+func bindingOf(t reflect.Type) (types.Binding, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == reflect.TypeOf(time.Time{}) {
+		return types.Timestamp, true
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return types.String, true
+	case reflect.Bool:
+		return types.Boolean, true
+	case reflect.Int8, reflect.Int16, reflect.Uint8:
+		return types.Short, true
+	case reflect.Int32, reflect.Uint16:
+		return types.Integer, true
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return types.Long, true
+	case reflect.Float32:
+		return types.Float, true
+	case reflect.Float64:
+		return types.Double, true
+	default:
+		return "", false
+	}
+}
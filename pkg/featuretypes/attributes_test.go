@@ -0,0 +1,54 @@
+package featuretypes
+
+import (
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAttributesFromStruct_Binding(t *testing.T) {
+	t.Run("Geometry Type", func(t *testing.T) {
+		type road struct {
+			Geometry any `geoserver:"geom,binding=MultiLineString"`
+		}
+
+		attributes, err := AttributesFromStruct(road{})
+		assert.NoError(t, err)
+		assert.Len(t, attributes, 1)
+		assert.Equal(t, string(types.MultiLineString.Binding()), attributes[0].Binding)
+	})
+
+	t.Run("Java Class", func(t *testing.T) {
+		type road struct {
+			Opened any `geoserver:"opened,binding=java.sql.Date"`
+		}
+
+		attributes, err := AttributesFromStruct(road{})
+		assert.NoError(t, err)
+		assert.Len(t, attributes, 1)
+		assert.Equal(t, string(types.Date), attributes[0].Binding)
+	})
+
+	t.Run("Unknown Geometry Type", func(t *testing.T) {
+		type road struct {
+			Geometry any `geoserver:"geom,binding=Linestring"`
+		}
+
+		attributes, err := AttributesFromStruct(road{})
+		assert.Nil(t, attributes)
+		assert.IsType(t, &customerrors.InputError{}, err)
+		assert.EqualError(t, err, `invalid binding "Linestring" on field Geometry, expected a geometry type or a java class`)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		type road struct {
+			Geometry any `geoserver:"geom,binding="`
+		}
+
+		attributes, err := AttributesFromStruct(road{})
+		assert.Nil(t, attributes)
+		assert.IsType(t, &customerrors.InputError{}, err)
+		assert.EqualError(t, err, `invalid binding "" on field Geometry, expected a geometry type or a java class`)
+	})
+}
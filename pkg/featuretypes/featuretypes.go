@@ -1,10 +1,11 @@
 package featuretypes
 
 import (
+	"encoding/json"
+	"github.com/canghel3/go-geoserver/internal/jsonutil"
 	"github.com/canghel3/go-geoserver/internal/models"
 	"github.com/canghel3/go-geoserver/pkg/options"
 	"github.com/canghel3/go-geoserver/pkg/shared"
-	"time"
 )
 
 func New(name, nativeName string, options ...options.FeatureTypeOption) models.FeatureType {
@@ -19,6 +20,20 @@ func New(name, nativeName string, options ...options.FeatureTypeOption) models.F
 	return *cft
 }
 
+// NewSQLView creates the definition of a SQL view which can be published with options.FeatureType.SQLView.
+// Parameters are referenced inside the sql as %name%.
+func NewSQLView(name, sql string, options ...options.SQLViewOption) VirtualTable {
+	vt := new(VirtualTable)
+	vt.Name = name
+	vt.SQL = sql
+
+	for _, option := range options {
+		option(vt)
+	}
+
+	return *vt
+}
+
 type FeatureTypesWrapper struct {
 	FeatureTypes FeatureTypes `json:"featureTypes"`
 }
@@ -35,67 +50,138 @@ type FeatureTypeWrapper struct {
 }
 
 type FeatureType struct {
-	Name       string           `json:"name"`
-	NativeName string           `json:"nativeName"`
-	Namespace  Namespace        `json:"namespace"`
-	Title      string           `json:"title"`
-	Abstract   string           `json:"abstract"`
-	Keywords   *shared.Keywords `json:"keywords,omitempty"`
-	//TODO: because GeoServer responds with either a Links structure or just an empty fucking string,
-	// we avoid using this until its either fixed or im in the mood to implement it.
-	//MetadataLinks          *Links             `json:"metadataLinks,omitempty"`
-	//DataLinks              *Links             `json:"dataLinks,omitempty"`
-	NativeCRS         *shared.CRSClass    `json:"nativeCRS,omitempty"`
-	Srs               string              `json:"srs"`
-	NativeBoundingBox *shared.BoundingBox `json:"nativeBoundingBox,omitempty"`
-	LatLonBoundingBox *shared.BoundingBox `json:"latLonBoundingBox,omitempty"`
-	ProjectionPolicy  string              `json:"projectionPolicy"`
-	Enabled           bool                `json:"enabled"`
-	Metadata          *Metadata           `json:"metadata,omitempty"`
-	Store             Store               `json:"store"`
-	CqlFilter         string              `json:"cqlFilter"`
-	MaxFeatures       int                 `json:"maxFeatures"`
-	NumDecimals       int                 `json:"numDecimals"`
-	//TODO: GeoServer responds wit either a struct or a slice of ints (:
-	//ResponseSRS            SRS                `json:"responseSRS"`
-	OverridingServiceSRS   bool       `json:"overridingServiceSRS"`
-	SkipNumberMatched      bool       `json:"skipNumberMatched"`
-	CircularArcPresent     bool       `json:"circularArcPresent"`
-	LinearizationTolerance any        `json:"linearizationTolerance"`
-	Attributes             Attributes `json:"attributes"`
-}
-
-type Links struct {
-	MetadataLink []MetadataLink `json:"metadataLink"`
-}
-
-type MetadataLink struct {
-	Type         string `json:"type"`
-	MetadataType string `json:"metadataType"`
-	Content      string `json:"content"`
+	Name                   string                `json:"name"`
+	NativeName             string                `json:"nativeName"`
+	Namespace              Namespace             `json:"namespace"`
+	Title                  string                `json:"title"`
+	Abstract               string                `json:"abstract"`
+	Keywords               *shared.Keywords      `json:"keywords,omitempty"`
+	MetadataLinks          *shared.MetadataLinks `json:"metadataLinks,omitempty"`
+	DataLinks              *shared.DataLinks     `json:"dataLinks,omitempty"`
+	NativeCRS              *shared.CRSClass      `json:"nativeCRS,omitempty"`
+	Srs                    string                `json:"srs"`
+	NativeBoundingBox      *shared.BoundingBox   `json:"nativeBoundingBox,omitempty"`
+	LatLonBoundingBox      *shared.BoundingBox   `json:"latLonBoundingBox,omitempty"`
+	ProjectionPolicy       string                `json:"projectionPolicy"`
+	Enabled                bool                  `json:"enabled"`
+	Metadata               *Metadata             `json:"metadata,omitempty"`
+	Store                  Store                 `json:"store"`
+	CqlFilter              string                `json:"cqlFilter"`
+	MaxFeatures            int                   `json:"maxFeatures"`
+	NumDecimals            int                   `json:"numDecimals"`
+	ResponseSRS            *shared.SRSList       `json:"responseSRS,omitempty"`
+	OverridingServiceSRS   bool                  `json:"overridingServiceSRS"`
+	SkipNumberMatched      bool                  `json:"skipNumberMatched"`
+	CircularArcPresent     bool                  `json:"circularArcPresent"`
+	LinearizationTolerance any                   `json:"linearizationTolerance"`
+	Attributes             Attributes            `json:"attributes"`
+}
+
+type Links = shared.MetadataLinks
+
+type MetadataLink = shared.MetadataLink
+
+// VirtualTable returns the SQL view definition of the feature type or nil if the feature type is not a SQL view.
+func (ft *FeatureType) VirtualTable() *VirtualTable {
+	if ft.Metadata == nil {
+		return nil
+	}
+
+	for _, entry := range ft.Metadata.Entry {
+		if entry.Key == models.VirtualTableMetadataKey {
+			return entry.VirtualTable
+		}
+	}
+
+	return nil
+}
+
+// SetVirtualTable adds or replaces the SQL view definition of the feature type. Apply it with FeatureTypes.Update.
+func (ft *FeatureType) SetVirtualTable(vt VirtualTable) {
+	if ft.Metadata == nil {
+		ft.Metadata = &Metadata{}
+	}
+
+	for i := range ft.Metadata.Entry {
+		if ft.Metadata.Entry[i].Key == models.VirtualTableMetadataKey {
+			ft.Metadata.Entry[i].VirtualTable = &vt
+			return
+		}
+	}
+
+	ft.Metadata.Entry = append(ft.Metadata.Entry, Entry{
+		Key:          models.VirtualTableMetadataKey,
+		VirtualTable: &vt,
+	})
+}
+
+// Dimension returns the configuration of the dimension stored under key (e.g. shared.TimeDimension) or nil if it is not configured.
+func (ft *FeatureType) Dimension(key string) *DimensionInfo {
+	if ft.Metadata == nil {
+		return nil
+	}
+
+	for _, entry := range ft.Metadata.Entry {
+		if entry.Key == key {
+			return entry.DimensionInfo
+		}
+	}
+
+	return nil
+}
+
+// SetDimension enables and configures the dimension stored under key (e.g. shared.TimeDimension),
+// replacing any previous configuration. Apply it with FeatureTypes.Update.
+func (ft *FeatureType) SetDimension(key string, dimensionOptions ...options.DimensionOption) {
+	dimension := options.NewDimension(dimensionOptions...)
+
+	if ft.Metadata == nil {
+		ft.Metadata = &Metadata{}
+	}
+
+	for i := range ft.Metadata.Entry {
+		if ft.Metadata.Entry[i].Key == key {
+			ft.Metadata.Entry[i].DimensionInfo = &dimension
+			return
+		}
+	}
+
+	ft.Metadata.Entry = append(ft.Metadata.Entry, Entry{
+		Key:           key,
+		DimensionInfo: &dimension,
+	})
 }
 
 type Metadata struct {
 	Entry []Entry `json:"entry"`
 }
 
+// UnmarshalJSON handles GeoServer responding with a single object instead of a list when there is only one entry.
+func (m *Metadata) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Entry json.RawMessage `json:"entry"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return jsonutil.OneOrMany(raw.Entry, &m.Entry)
+}
+
 type Entry struct {
 	Key           string         `json:"@key"`
-	Value         string         `json:"$"`
-	DimensionInfo *DimensionInfo `json:"dimensionInfo"`
+	Value         string         `json:"$,omitempty"`
+	DimensionInfo *DimensionInfo `json:"dimensionInfo,omitempty"`
+	VirtualTable  *VirtualTable  `json:"virtualTable,omitempty"`
 }
 
-type DimensionInfo struct {
-	Enabled      bool      `json:"enabled"`
-	Attribute    string    `json:"attribute"`
-	Presentation string    `json:"presentation"`
-	StartValue   time.Time `json:"startValue"`
-	EndValue     time.Time `json:"endValue"`
-}
+type VirtualTable = shared.VirtualTable
 
-type SRS struct {
-	String []int `json:"string"`
-}
+type VirtualTableGeometry = shared.VirtualTableGeometry
+
+type VirtualTableParameter = shared.VirtualTableParameter
+
+type DimensionInfo = shared.DimensionInfo
 
 type Attributes struct {
 	Attribute []Attribute `json:"attribute"`
@@ -122,3 +208,32 @@ type Store struct {
 	Name  string `json:"name"`
 	Href  string `json:"href"`
 }
+
+// AvailableWrapper is the response of the featuretypes endpoint when listing the
+// native tables of a store which are not yet published.
+type AvailableWrapper struct {
+	List Available `json:"list"`
+}
+
+type Available struct {
+	Names []string `json:"string"`
+}
+
+// UnmarshalJSON handles GeoServer responding with an empty string when no tables are available.
+func (a *Available) UnmarshalJSON(data []byte) error {
+	a.Names = nil
+
+	var empty string
+	if err := json.Unmarshal(data, &empty); err == nil {
+		return nil
+	}
+
+	var raw struct {
+		Names json.RawMessage `json:"string"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return jsonutil.OneOrMany(raw.Names, &a.Names)
+}
@@ -0,0 +1,45 @@
+package featuretypes_test
+
+import (
+	"testing"
+
+	"github.com/canghel3/go-geoserver/pkg/datastores"
+	"github.com/canghel3/go-geoserver/pkg/featuretypes"
+	"github.com/canghel3/go-geoserver/pkg/geoservertest"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/canghel3/go-geoserver/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetVirtualTable_Update(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+
+	gc := server.Client()
+	require.NoError(t, gc.Workspaces().Create("roads", false))
+	require.NoError(t, gc.Workspace("roads").DataStores().Create().Custom("postgis", "PostGIS", datastores.ConnectionParams{"dbtype": "postgis"}))
+
+	store := gc.Workspace("roads").DataStore("postgis")
+	require.NoError(t, store.Publish(featuretypes.New("cities", "cities", options.FeatureType.SQLView(featuretypes.NewSQLView("cities", "select * from cities where population > %min_pop%",
+		options.SQLView.Geometry("geom", types.Point, 4326),
+		options.SQLView.Parameter("min_pop", "1000", `^\d+$`),
+	)))))
+
+	featureType, err := store.Get("cities")
+	require.NoError(t, err)
+	require.NotNil(t, featureType.VirtualTable())
+
+	view := featuretypes.NewSQLView("cities", "select * from cities where population > %min_pop% and country = '%country%'",
+		options.SQLView.Geometry("geom", types.Point, 4326),
+		options.SQLView.KeyColumns("id"),
+		options.SQLView.Parameter("min_pop", "5000", `^\d+$`),
+		options.SQLView.Parameter("country", "RO", `^[A-Z]{2}$`),
+	)
+	featureType.SetVirtualTable(view)
+	require.NoError(t, store.Update("cities", *featureType))
+
+	updated, err := store.Get("cities")
+	require.NoError(t, err)
+	assert.Equal(t, &view, updated.VirtualTable())
+}
@@ -9,4 +9,10 @@ const (
 	DirOfShapefiles   DataStoreFormat = "shape"
 	CSV               DataStoreFormat = "csv"
 	WebFeatureService DataStoreFormat = "wfs"
+	Oracle            DataStoreFormat = "oracle"
+	MySQL             DataStoreFormat = "mysql"
+	SQLServer         DataStoreFormat = "sqlserver"
+	H2                DataStoreFormat = "h2"
+	Property          DataStoreFormat = "property"
+	AppSchema         DataStoreFormat = "app-schema"
 )
@@ -0,0 +1,325 @@
+package geoservertest
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// object holds a resource as decoded from its JSON, so that the fields the fake does not know about are kept
+type object = map[string]any
+
+type catalog struct {
+	workspaces       map[string]*workspace
+	defaultWorkspace string
+	layerGroups      map[string]object
+	styles           map[string]object
+}
+
+type workspace struct {
+	name           string
+	isolated       bool
+	uri            string
+	created        string
+	dataStores     map[string]*store
+	coverageStores map[string]*store
+	layers         map[string]*layer
+	layerGroups    map[string]object
+	styles         map[string]object
+}
+
+// store is a data store or a coverage store along with its feature types or coverages
+type store struct {
+	object    object
+	resources map[string]object
+}
+
+type layer struct {
+	// class is featureType or coverage
+	class        string
+	store        string
+	defaultStyle string
+	styles       []string
+	created      string
+}
+
+func newCatalog() *catalog {
+	c := &catalog{
+		workspaces:  make(map[string]*workspace),
+		layerGroups: make(map[string]object),
+		styles:      make(map[string]object),
+	}
+
+	for _, name := range []string{"generic", "line", "point", "polygon", "raster"} {
+		c.styles[name] = object{"name": name, "format": "sld", "languageVersion": object{"version": "1.0.0"}, "filename": name + ".sld", sldKey: builtinSLD(name)}
+	}
+
+	return c
+}
+
+func newWorkspace(name string) *workspace {
+	return &workspace{
+		name:           name,
+		uri:            "http://" + name,
+		created:        now(),
+		dataStores:     make(map[string]*store),
+		coverageStores: make(map[string]*store),
+		layers:         make(map[string]*layer),
+		layerGroups:    make(map[string]object),
+		styles:         make(map[string]object),
+	}
+}
+
+func (ws *workspace) empty() bool {
+	return len(ws.dataStores) == 0 && len(ws.coverageStores) == 0 && len(ws.layerGroups) == 0 && len(ws.styles) == 0
+}
+
+// groups returns the layer groups of the workspace, or the global layer groups when ws is empty
+func (c *catalog) groups(ws string) map[string]object {
+	if ws == "" {
+		return c.layerGroups
+	}
+
+	if w, ok := c.workspaces[ws]; ok {
+		return w.layerGroups
+	}
+
+	return nil
+}
+
+// removeWorkspace removes the workspace and everything it contains
+func (c *catalog) removeWorkspace(ws *workspace) {
+	for name := range ws.layerGroups {
+		c.removeGroup(ws.name, name)
+	}
+
+	for name := range ws.layers {
+		c.removeLayer(ws.name, name)
+	}
+
+	delete(c.workspaces, ws.name)
+	if c.defaultWorkspace == ws.name {
+		c.defaultWorkspace = ""
+	}
+}
+
+// removeResource removes the feature type or coverage along with its layer
+func (c *catalog) removeResource(ws *workspace, st *store, name string) {
+	delete(st.resources, name)
+
+	if _, ok := ws.layers[name]; ok {
+		c.removeLayer(ws.name, name)
+	}
+}
+
+// removeLayer removes the layer from the layer groups drawing it, like GeoServer removes the layer groups left empty
+func (c *catalog) removeLayer(ws, name string) {
+	delete(c.workspaces[ws].layers, name)
+	c.removeMember("layer", ws+":"+name)
+}
+
+func (c *catalog) removeGroup(ws, name string) {
+	delete(c.groups(ws), name)
+
+	c.removeMember("layerGroup", qualify(ws, name))
+}
+
+func (c *catalog) removeMember(kind, qualified string) {
+	type owner struct {
+		ws   string
+		name string
+	}
+
+	var emptied []owner
+	visit := func(ws string, groups map[string]object) {
+		for name, group := range groups {
+			members := groupMembers(group, ws)
+			styles := groupStyles(group, len(members))
+
+			var keptMembers []member
+			var keptStyles []string
+			for i, m := range members {
+				if m.kind == kind && m.name == qualified {
+					continue
+				}
+
+				keptMembers = append(keptMembers, m)
+				keptStyles = append(keptStyles, styles[i])
+			}
+
+			if len(keptMembers) == len(members) {
+				continue
+			}
+
+			if len(keptMembers) == 0 {
+				emptied = append(emptied, owner{ws, name})
+				continue
+			}
+
+			setGroupMembers(group, keptMembers, keptStyles)
+		}
+	}
+
+	visit("", c.layerGroups)
+	for _, ws := range c.workspaces {
+		visit(ws.name, ws.layerGroups)
+	}
+
+	for _, group := range emptied {
+		c.removeGroup(group.ws, group.name)
+	}
+}
+
+// styleUsers lists the layers and layer groups using the style, qualified with its workspace when it has one
+func (c *catalog) styleUsers(qualified string) []string {
+	var users []string
+	for _, ws := range c.workspaces {
+		for name, l := range ws.layers {
+			if l.defaultStyle == qualified || slices.Contains(l.styles, qualified) {
+				users = append(users, ws.name+":"+name)
+			}
+		}
+
+		for name, group := range ws.layerGroups {
+			if slices.Contains(groupStyles(group, len(groupMembers(group, ws.name))), qualified) {
+				users = append(users, ws.name+":"+name)
+			}
+		}
+	}
+
+	for name, group := range c.layerGroups {
+		if slices.Contains(groupStyles(group, len(groupMembers(group, ""))), qualified) {
+			users = append(users, name)
+		}
+	}
+
+	return users
+}
+
+// exists reports whether the member of a layer group of ws is in the catalog
+func (c *catalog) exists(m member, ws string) bool {
+	prefix, name, found := strings.Cut(m.name, ":")
+	if !found {
+		prefix, name = ws, m.name
+	}
+
+	if m.kind == "layerGroup" {
+		groups := c.groups(prefix)
+		if groups == nil {
+			return false
+		}
+
+		_, ok := groups[name]
+		return ok
+	}
+
+	w, ok := c.workspaces[prefix]
+	if !ok {
+		return false
+	}
+
+	_, ok = w.layers[name]
+	return ok
+}
+
+type member struct {
+	kind string
+	// name is qualified with the workspace, except for global layer groups
+	name string
+}
+
+// groupMembers reads the publishables of a stored layer group, qualifying the names with ws
+func groupMembers(group object, ws string) []member {
+	publishables, _ := group["publishables"].(object)
+	published, _ := publishables["published"].([]any)
+
+	members := make([]member, 0, len(published))
+	for _, p := range published {
+		entry, _ := p.(object)
+		kind, _ := entry["@type"].(string)
+		name, _ := entry["name"].(string)
+		if kind == "" {
+			kind = "layer"
+		}
+
+		if ws != "" && !strings.Contains(name, ":") {
+			name = ws + ":" + name
+		}
+
+		members = append(members, member{kind: kind, name: name})
+	}
+
+	return members
+}
+
+// groupStyles reads the styles of a stored layer group, an empty name standing for the default style of the layer
+func groupStyles(group object, count int) []string {
+	styles := make([]string, count)
+
+	container, _ := group["styles"].(object)
+	list, _ := container["style"].([]any)
+	for i := 0; i < len(list) && i < count; i++ {
+		switch style := list[i].(type) {
+		case string:
+			styles[i] = style
+		case object:
+			styles[i], _ = style["name"].(string)
+		}
+	}
+
+	return styles
+}
+
+func setGroupMembers(group object, members []member, styles []string) {
+	published := make([]any, len(members))
+	for i, m := range members {
+		published[i] = object{"@type": m.kind, "name": m.name}
+	}
+
+	list := make([]any, len(styles))
+	for i, style := range styles {
+		list[i] = style
+	}
+
+	group["publishables"] = object{"published": published}
+	group["styles"] = object{"style": list}
+}
+
+// normalize turns the single elements and empty strings of a decoded object into lists, the way they are stored
+func normalize(value any) []any {
+	switch v := value.(type) {
+	case []any:
+		return v
+	case nil, string:
+		return nil
+	default:
+		return []any{v}
+	}
+}
+
+// one renders a list the way GeoServer does: an empty string when empty and the element itself when alone
+func one(list []any) any {
+	switch len(list) {
+	case 0:
+		return ""
+	case 1:
+		return list[0]
+	default:
+		return list
+	}
+}
+
+func sortedNames[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func now() string {
+	return time.Now().UTC().Format("2006-01-02 15:04:05.000 UTC")
+}
@@ -0,0 +1,96 @@
+package geoservertest_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/canghel3/go-geoserver/pkg/datastores"
+	"github.com/canghel3/go-geoserver/pkg/featuretypes"
+	"github.com/canghel3/go-geoserver/pkg/geoservertest"
+	"github.com/canghel3/go-geoserver/pkg/layers"
+)
+
+func ExampleNewServer() {
+	server := geoservertest.NewServer()
+	defer server.Close()
+
+	geoclient := server.Client()
+
+	err := geoclient.Workspaces().Create("roads", false)
+	if err != nil {
+		fmt.Println("Error creating workspace:", err)
+		return
+	}
+
+	err = geoclient.Workspace("roads").DataStores().Create().Custom("files", "Properties", datastores.ConnectionParams{"directory": "/data/roads"})
+	if err != nil {
+		fmt.Println("Error creating data store:", err)
+		return
+	}
+
+	err = geoclient.Workspace("roads").DataStore("files").Publish(featuretypes.New("motorways", "motorways"))
+	if err != nil {
+		fmt.Println("Error publishing feature type:", err)
+		return
+	}
+
+	err = geoclient.Workspace("roads").LayerGroups().Publish(layers.NewGroup("basemap", layers.ModeSingle, []layers.LayerInput{
+		{Type: layers.TypeLayer, Name: "motorways", Style: "line"},
+	}))
+	if err != nil {
+		fmt.Println("Error publishing layer group:", err)
+		return
+	}
+
+	layer, err := geoclient.Workspace("roads").Layers().Get("motorways")
+	if err != nil {
+		fmt.Println("Error getting layer:", err)
+		return
+	}
+
+	fmt.Println(layer.Name, layer.Type, layer.DefaultStyle.Name)
+
+	// deleting the feature type removes its layer, along with the layer group left empty
+	err = geoclient.Workspace("roads").DataStore("files").Delete("motorways", true)
+	if err != nil {
+		fmt.Println("Error deleting feature type:", err)
+		return
+	}
+
+	_, err = geoclient.Workspace("roads").LayerGroups().Get("basemap")
+	fmt.Println(err)
+
+	// Output:
+	// motorways VECTOR generic
+	// layer group basemap not found
+}
+
+func ExampleServer_Inject() {
+	server := geoservertest.NewServer()
+	defer server.Close()
+
+	geoclient := server.Client()
+
+	server.Inject(geoservertest.Failure{
+		Method: http.MethodPost,
+		Path:   "/workspaces",
+		Status: http.StatusServiceUnavailable,
+		Body:   "GeoServer is restarting",
+		Times:  1,
+	})
+
+	// the first request fails, the failure is then removed
+	fmt.Println(geoclient.Workspaces().Create("roads", false))
+	fmt.Println(geoclient.Workspaces().Create("roads", false))
+
+	// creating the workspace again fails like it does on GeoServer
+	fmt.Println(geoclient.Workspaces().Create("roads", false))
+
+	fmt.Println(server.Requests())
+
+	// Output:
+	// received status code 503 from geoserver: GeoServer is restarting
+	// <nil>
+	// received status code 409 from geoserver: Workspace 'roads' already exists
+	// [POST /workspaces POST /workspaces POST /workspaces]
+}
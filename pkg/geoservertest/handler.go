@@ -0,0 +1,240 @@
+package geoservertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// handler serves a single request against the catalog, the server holding its lock
+type handler struct {
+	catalog *catalog
+	base    string
+	w       http.ResponseWriter
+	r       *http.Request
+}
+
+func (h handler) route(segments []string) {
+	switch {
+	case match(segments, "about", "version"):
+		h.only(http.MethodGet, h.version)
+	case match(segments, "about", "status"):
+		h.only(http.MethodGet, h.status)
+	case match(segments, "about", "manifest"):
+		h.only(http.MethodGet, h.manifest)
+	case match(segments, "workspaces"):
+		h.workspaces()
+	case match(segments, "workspaces", "default"):
+		h.defaultWorkspace()
+	case match(segments, "workspaces", "*"):
+		h.workspace(segments[1])
+	case match(segments, "namespaces"):
+		h.namespaces()
+	case match(segments, "namespaces", "*"):
+		h.namespace(segments[1])
+	case match(segments, "workspaces", "*", "*"):
+		switch segments[2] {
+		case "datastores":
+			h.stores(segments[1], dataStores)
+		case "coveragestores":
+			h.stores(segments[1], coverageStores)
+		case "layers":
+			h.layers(segments[1])
+		case "layergroups":
+			h.groups(segments[1])
+		case "styles":
+			h.styles(segments[1])
+		case "wmsstores", "wmtsstores":
+			h.cascaded(segments[1], segments[2])
+		default:
+			h.notFound("No such resource: %s", segments[2])
+		}
+	case match(segments, "workspaces", "*", "*", "*"):
+		switch segments[2] {
+		case "datastores":
+			h.store(segments[1], dataStores, segments[3])
+		case "coveragestores":
+			h.store(segments[1], coverageStores, segments[3])
+		case "layers":
+			h.layer(segments[1], segments[3])
+		case "layergroups":
+			h.group(segments[1], segments[3])
+		case "styles":
+			h.style(segments[1], segments[3])
+		default:
+			h.notFound("No such resource: %s", segments[2])
+		}
+	case match(segments, "workspaces", "*", "datastores", "*", "reset"):
+		h.reset(segments[1], dataStores, segments[3], "")
+	case match(segments, "workspaces", "*", "coveragestores", "*", "reset"):
+		h.reset(segments[1], coverageStores, segments[3], "")
+	case match(segments, "workspaces", "*", "datastores", "*", "featuretypes"):
+		h.resources(segments[1], dataStores, segments[3])
+	case match(segments, "workspaces", "*", "coveragestores", "*", "coverages"):
+		h.resources(segments[1], coverageStores, segments[3])
+	case match(segments, "workspaces", "*", "datastores", "*", "featuretypes", "*"):
+		h.resource(segments[1], dataStores, segments[3], segments[5])
+	case match(segments, "workspaces", "*", "coveragestores", "*", "coverages", "*"):
+		h.resource(segments[1], coverageStores, segments[3], segments[5])
+	case match(segments, "workspaces", "*", "datastores", "*", "featuretypes", "*", "reset"):
+		h.reset(segments[1], dataStores, segments[3], segments[5])
+	case match(segments, "workspaces", "*", "coveragestores", "*", "coverages", "*", "reset"):
+		h.reset(segments[1], coverageStores, segments[3], segments[5])
+	case match(segments, "layers"):
+		h.layers("")
+	case match(segments, "layers", "*"):
+		ws, name, found := strings.Cut(segments[1], ":")
+		if !found {
+			h.notFound("No such layer: %s", segments[1])
+			return
+		}
+		h.layer(ws, name)
+	case match(segments, "layergroups"):
+		h.groups("")
+	case match(segments, "layergroups", "*"):
+		h.group("", segments[1])
+	case match(segments, "styles"):
+		h.styles("")
+	case match(segments, "styles", "*"):
+		h.style("", segments[1])
+	default:
+		h.notFound("No such endpoint: %s", strings.Join(segments, "/"))
+	}
+}
+
+// match reports whether the segments of the path match the pattern, a * matching any segment
+func match(segments []string, pattern ...string) bool {
+	if len(segments) != len(pattern) {
+		return false
+	}
+
+	for i, p := range pattern {
+		if segments[i] == "" || (p != "*" && p != segments[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (h handler) only(method string, serve func()) {
+	if h.r.Method != method {
+		h.methodNotAllowed()
+		return
+	}
+
+	serve()
+}
+
+func (h handler) write(status int, v any) {
+	h.w.Header().Set("Content-Type", "application/json")
+	h.w.WriteHeader(status)
+	_ = json.NewEncoder(h.w).Encode(v)
+}
+
+// created answers a POST the way GeoServer does, with the name of the new resource as a plain text body
+func (h handler) created(location, name string) {
+	h.w.Header().Set("Location", location)
+	h.w.Header().Set("Content-Type", "text/plain")
+	h.w.WriteHeader(http.StatusCreated)
+	_, _ = fmt.Fprint(h.w, name)
+}
+
+func (h handler) ok() {
+	h.w.WriteHeader(http.StatusOK)
+}
+
+func (h handler) fail(status int, format string, args ...any) {
+	h.w.Header().Set("Content-Type", "text/plain")
+	h.w.WriteHeader(status)
+	_, _ = fmt.Fprintf(h.w, format, args...)
+}
+
+func (h handler) notFound(format string, args ...any) {
+	h.fail(http.StatusNotFound, format, args...)
+}
+
+func (h handler) methodNotAllowed() {
+	h.fail(http.StatusMethodNotAllowed, "Request method '%s' not supported", h.r.Method)
+}
+
+// decode reads the body of the request, wrapped in the root key like GeoServer expects, answering 400 on failure
+func (h handler) decode(root string) (object, bool) {
+	var body map[string]object
+	if err := json.NewDecoder(h.r.Body).Decode(&body); err != nil {
+		h.fail(http.StatusBadRequest, "Could not parse the request body: %s", err)
+		return nil, false
+	}
+
+	content, ok := body[root]
+	if !ok || content == nil {
+		h.fail(http.StatusBadRequest, "Expected a %s", root)
+		return nil, false
+	}
+
+	return content, true
+}
+
+func (h handler) recurse() bool {
+	return h.r.URL.Query().Get("recurse") == "true"
+}
+
+// link returns the href GeoServer gives to the resource at the path, relative to the rest endpoint
+func (h handler) link(segments ...string) string {
+	return h.base + "/" + strings.Join(segments, "/") + ".json"
+}
+
+// list renders a list of resources, GeoServer answering an empty string instead of an empty list
+func (h handler) list(plural, singular string, names []string, link func(name string) string) object {
+	if len(names) == 0 {
+		return object{plural: ""}
+	}
+
+	entries := make([]any, len(names))
+	for i, name := range names {
+		entries[i] = object{"name": name, "href": link(name)}
+	}
+
+	return object{plural: object{singular: entries}}
+}
+
+// cascaded lists the wms or wmts stores of the workspace, which the fake does not support and are always empty
+func (h handler) cascaded(name, path string) {
+	if h.r.Method != http.MethodGet {
+		h.methodNotAllowed()
+		return
+	}
+
+	if _, ok := h.catalog.workspaces[name]; !ok {
+		h.notFound("No such workspace: '%s' found", name)
+		return
+	}
+
+	plural := map[string]string{"wmsstores": "wmsStores", "wmtsstores": "wmtsStores"}[path]
+	h.write(http.StatusOK, object{plural: ""})
+}
+
+func (h handler) version() {
+	h.write(http.StatusOK, object{"about": object{"resource": []any{
+		object{"@name": "GeoServer", "Build-Timestamp": "01-Sep-2024 10:00", "Version": "2.26.0", "Git-Revision": "0000000000000000000000000000000000000000"},
+		object{"@name": "GeoTools", "Build-Timestamp": "01-Sep-2024 09:00", "Version": "32.0", "Git-Revision": "0000000000000000000000000000000000000000"},
+		object{"@name": "GeoWebCache", "Version": "1.26.0", "Git-Revision": "0000000000000000000000000000000000000000"},
+	}}})
+}
+
+// status answers with the statuss key, a typo GeoServer has
+func (h handler) status() {
+	h.write(http.StatusOK, object{"statuss": object{"status": []any{
+		object{"module": "gs-main", "name": "GeoServer Main", "component": "GeoServer Main", "version": "2.26.0", "isEnabled": true, "isAvailable": true},
+		object{"module": "gs-wms", "name": "GeoServer Web Map Service", "component": "GeoServer Web Map Service", "version": "2.26.0", "isEnabled": true, "isAvailable": true},
+		object{"module": "gs-wfs", "name": "GeoServer Web Feature Service", "component": "GeoServer Web Feature Service", "version": "2.26.0", "isEnabled": true, "isAvailable": true},
+	}}})
+}
+
+func (h handler) manifest() {
+	h.write(http.StatusOK, object{"about": object{"resource": []any{
+		object{"@name": "gs-main-2.26.0", "Implementation-Version": "2.26.0", "Manifest-Version": 1.0},
+		object{"@name": "gs-restconfig-2.26.0", "Implementation-Version": "2.26.0", "Manifest-Version": 1.0},
+	}}})
+}
@@ -0,0 +1,388 @@
+package geoservertest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+func (h handler) layers(name string) {
+	if h.r.Method != http.MethodGet {
+		h.methodNotAllowed()
+		return
+	}
+
+	if name == "" {
+		var names []string
+		for _, ws := range h.catalog.workspaces {
+			for layer := range ws.layers {
+				names = append(names, ws.name+":"+layer)
+			}
+		}
+
+		sort.Strings(names)
+
+		h.write(http.StatusOK, h.list("layers", "layer", names, func(layer string) string {
+			return h.link("layers", layer)
+		}))
+		return
+	}
+
+	ws, ok := h.catalog.workspaces[name]
+	if !ok {
+		h.notFound("No such workspace: '%s' found", name)
+		return
+	}
+
+	h.write(http.StatusOK, h.list("layers", "layer", sortedNames(ws.layers), func(layer string) string {
+		return h.link("workspaces", ws.name, "layers", layer)
+	}))
+}
+
+func (h handler) layer(name, layerName string) {
+	ws, ok := h.catalog.workspaces[name]
+	if !ok {
+		h.notFound("No such workspace: '%s' found", name)
+		return
+	}
+
+	l, ok := ws.layers[layerName]
+	if !ok {
+		h.notFound("No such layer: %s:%s", ws.name, layerName)
+		return
+	}
+
+	switch h.r.Method {
+	case http.MethodGet:
+		h.write(http.StatusOK, object{"layer": h.renderLayer(ws, layerName, l)})
+	case http.MethodPut:
+		content, ok := h.decode("layer")
+		if !ok {
+			return
+		}
+
+		defaultStyle := l.defaultStyle
+		if name := styleName(content["defaultStyle"]); name != "" {
+			defaultStyle = name
+		}
+
+		styles := l.styles
+		if value, ok := content["styles"]; ok {
+			container, _ := value.(object)
+
+			styles = nil
+			for _, style := range normalize(container["style"]) {
+				if name := styleName(style); name != "" {
+					styles = append(styles, name)
+				}
+			}
+		}
+
+		resolved := make([]string, 0, len(styles)+1)
+		for _, style := range append([]string{defaultStyle}, styles...) {
+			name, ok := h.catalog.resolveStyle(style)
+			if !ok {
+				h.fail(http.StatusBadRequest, "No such style: %s", style)
+				return
+			}
+
+			resolved = append(resolved, name)
+		}
+		defaultStyle, styles = resolved[0], resolved[1:]
+
+		l.defaultStyle, l.styles = defaultStyle, styles
+		h.ok()
+	case http.MethodDelete:
+		if h.recurse() {
+			k := kindOf(l)
+			h.catalog.removeResource(ws, k.of(ws)[l.store], layerName)
+		} else {
+			h.catalog.removeLayer(ws.name, layerName)
+		}
+
+		h.ok()
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+// renderLayer answers the styles of the layer the way GeoServer does, omitted when there are none and as a single
+// style rather than a list when there is only one
+func (h handler) renderLayer(ws *workspace, name string, l *layer) object {
+	k := kindOf(l)
+	rendered := object{
+		"name":         name,
+		"path":         "/",
+		"type":         k.layerType,
+		"defaultStyle": h.styleReference(l.defaultStyle),
+		"resource": object{
+			"@class": k.resource,
+			"name":   ws.name + ":" + name,
+			"href":   h.link("workspaces", ws.name, k.storePath, l.store, k.resourcePath, name),
+		},
+		"queryable":   true,
+		"opaque":      false,
+		"attribution": object{"logoWidth": 0, "logoHeight": 0},
+		"dateCreated": l.created,
+	}
+
+	if len(l.styles) > 0 {
+		styles := make([]any, len(l.styles))
+		for i, style := range l.styles {
+			styles[i] = h.styleReference(style)
+		}
+
+		rendered["styles"] = object{"@class": "linked-hash-set", "style": one(styles)}
+	}
+
+	return rendered
+}
+
+func kindOf(l *layer) kind {
+	if l.class == dataStores.resource {
+		return dataStores
+	}
+
+	return coverageStores
+}
+
+func (h handler) groups(name string) {
+	if name != "" {
+		if _, ok := h.catalog.workspaces[name]; !ok {
+			h.notFound("No such workspace: '%s' found", name)
+			return
+		}
+	}
+
+	groups := h.catalog.groups(name)
+	switch h.r.Method {
+	case http.MethodGet:
+		h.write(http.StatusOK, h.list("layerGroups", "layerGroup", sortedNames(groups), func(group string) string {
+			return h.groupLink(name, group)
+		}))
+	case http.MethodPost:
+		content, ok := h.decode("layerGroup")
+		if !ok {
+			return
+		}
+
+		groupName, _ := content["name"].(string)
+		if groupName == "" {
+			h.fail(http.StatusBadRequest, "Layer group name cannot be empty")
+			return
+		}
+
+		if _, exists := groups[groupName]; exists {
+			h.fail(http.StatusInternalServerError, "Layer group named '%s' already exists", groupName)
+			return
+		}
+
+		if !h.validGroup(content, name) {
+			return
+		}
+
+		if mode, _ := content["mode"].(string); mode == "" {
+			content["mode"] = "SINGLE"
+		}
+		content["dateCreated"] = now()
+
+		groups[groupName] = content
+		h.created(h.groupLink(name, groupName), groupName)
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+func (h handler) group(name, groupName string) {
+	if name != "" {
+		if _, ok := h.catalog.workspaces[name]; !ok {
+			h.notFound("No such workspace: '%s' found", name)
+			return
+		}
+	}
+
+	groups := h.catalog.groups(name)
+	group, ok := groups[groupName]
+	if !ok {
+		h.notFound("No such layer group %s", groupName)
+		return
+	}
+
+	switch h.r.Method {
+	case http.MethodGet:
+		h.write(http.StatusOK, object{"layerGroup": h.renderGroup(name, groupName, group)})
+	case http.MethodPut:
+		content, ok := h.decode("layerGroup")
+		if !ok {
+			return
+		}
+
+		newName, _ := content["name"].(string)
+		if newName != "" && newName != groupName {
+			if _, exists := groups[newName]; exists {
+				h.fail(http.StatusInternalServerError, "Layer group named '%s' already exists", newName)
+				return
+			}
+		}
+
+		updated := object{}
+		for key, value := range group {
+			updated[key] = value
+		}
+
+		for key, value := range content {
+			switch key {
+			case "name", "workspace", "dateCreated", "dateModified":
+			default:
+				updated[key] = value
+			}
+		}
+		updated["dateModified"] = now()
+
+		if !h.validGroup(updated, name) {
+			return
+		}
+
+		groups[groupName] = updated
+		if newName != "" && newName != groupName {
+			h.catalog.renameGroup(name, groupName, newName)
+		}
+
+		h.ok()
+	case http.MethodDelete:
+		h.catalog.removeGroup(name, groupName)
+		h.ok()
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+// validGroup checks that the members of the layer group exist and stores them qualified with their workspace,
+// answering 400 otherwise
+func (h handler) validGroup(group object, ws string) bool {
+	publishables, _ := group["publishables"].(object)
+
+	var members []member
+	for _, p := range normalize(publishables["published"]) {
+		entry, _ := p.(object)
+		kind, _ := entry["@type"].(string)
+		name, _ := entry["name"].(string)
+		if kind == "" {
+			kind = "layer"
+		}
+
+		if ws != "" && !strings.Contains(name, ":") {
+			name = ws + ":" + name
+		}
+
+		m := member{kind: kind, name: name}
+		if !h.catalog.exists(m, ws) {
+			h.fail(http.StatusBadRequest, "No such %s: %s", kind, name)
+			return false
+		}
+
+		members = append(members, m)
+	}
+
+	if len(members) == 0 {
+		h.fail(http.StatusBadRequest, "Layer group has no layers")
+		return false
+	}
+
+	container, _ := group["styles"].(object)
+	posted := normalize(container["style"])
+
+	styles := make([]string, len(members))
+	for i := 0; i < len(posted) && i < len(styles); i++ {
+		name := styleName(posted[i])
+		if name == "" {
+			continue
+		}
+
+		resolved, ok := h.catalog.resolveStyle(name)
+		if !ok {
+			h.fail(http.StatusBadRequest, "No such style: %s", name)
+			return false
+		}
+		styles[i] = resolved
+	}
+
+	setGroupMembers(group, members, styles)
+	return true
+}
+
+// renderGroup answers the layer group the way GeoServer does, with a single member or style rather than a list
+// when there is only one and an empty string for the default style of a layer
+func (h handler) renderGroup(ws, name string, group object) object {
+	rendered := object{}
+	for key, value := range group {
+		rendered[key] = value
+	}
+
+	rendered["name"] = name
+	if ws != "" {
+		rendered["workspace"] = object{"name": ws}
+	}
+
+	members := groupMembers(group, ws)
+	published := make([]any, len(members))
+	for i, m := range members {
+		link := h.link("layers", m.name)
+		if m.kind == "layerGroup" {
+			prefix, local, found := strings.Cut(m.name, ":")
+			if !found {
+				prefix, local = "", m.name
+			}
+			link = h.groupLink(prefix, local)
+		}
+
+		published[i] = object{"@type": m.kind, "name": m.name, "href": link}
+	}
+
+	styles := make([]any, len(members))
+	for i, style := range groupStyles(group, len(members)) {
+		if style == "" {
+			styles[i] = ""
+		} else {
+			styles[i] = h.styleReference(style)
+		}
+	}
+
+	rendered["publishables"] = object{"published": one(published)}
+	rendered["styles"] = object{"style": one(styles)}
+
+	return rendered
+}
+
+func (h handler) groupLink(ws, name string) string {
+	if ws == "" {
+		return h.link("layergroups", name)
+	}
+
+	return h.link("workspaces", ws, "layergroups", name)
+}
+
+// renameGroup moves the layer group and rewrites the references of the layer groups nesting it
+func (c *catalog) renameGroup(ws, old, name string) {
+	groups := c.groups(ws)
+	groups[name] = groups[old]
+	delete(groups, old)
+
+	visit := func(owner string, groups map[string]object) {
+		for _, group := range groups {
+			members := groupMembers(group, owner)
+			for i := range members {
+				if members[i].kind == "layerGroup" && members[i].name == qualify(ws, old) {
+					members[i].name = qualify(ws, name)
+				}
+			}
+
+			setGroupMembers(group, members, groupStyles(group, len(members)))
+		}
+	}
+
+	visit("", c.layerGroups)
+	for _, w := range c.workspaces {
+		visit(w.name, w.layerGroups)
+	}
+}
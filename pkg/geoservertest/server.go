@@ -0,0 +1,178 @@
+// Package geoservertest provides an in-memory fake GeoServer, served by an httptest.Server, to unit test code built
+// on the client without running GeoServer.
+//
+// The fake implements the REST endpoints of the catalog used by the client: workspaces and namespaces, data stores,
+// coverage stores, feature types, coverages, layers, layer groups and styles, along with the about endpoints. It
+// mimics the status codes of GeoServer and the quirks of its JSON, such as empty lists returned as an empty string
+// and lists of a single element returned as that element. Resources are not validated against real data: any
+// connection parameters are accepted and any native name can be published. Cascaded WMS and WMTS stores are not
+// supported and are always listed as empty.
+//
+// Failures, such as errors or slow responses of GeoServer, are injected with Server.Inject.
+package geoservertest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/canghel3/go-geoserver/pkg/client"
+	"github.com/canghel3/go-geoserver/pkg/options"
+)
+
+const (
+	Username = "admin"
+	Password = "geoserver"
+)
+
+// Server is a fake GeoServer accepting the Username and Password credentials
+type Server struct {
+	*httptest.Server
+
+	mutex    sync.Mutex
+	catalog  *catalog
+	failures []*failure
+	requests []string
+}
+
+// NewServer starts a fake GeoServer with an empty catalog and the default global styles.
+// The server must be closed once done, e.g. with t.Cleanup(server.Close).
+func NewServer() *Server {
+	s := &Server{catalog: newCatalog()}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+
+	return s
+}
+
+// Client returns a client connected to the server
+func (s *Server) Client(opts ...options.GeoserverClientOption) client.GeoserverClient {
+	return client.NewGeoserverClient(s.URL, Username, Password, opts...)
+}
+
+// Failure makes the matching requests fail instead of reaching the catalog
+type Failure struct {
+	// Method matches any method when empty
+	Method string
+	// Path is a pattern, with the syntax of path.Match, matched against the path of the request relative to
+	// /geoserver/rest and without the .json extension, e.g. /workspaces/*/datastores
+	Path string
+	// Status defaults to 500
+	Status int
+	Body   string
+	// Times is the number of requests to fail, after which the failure is removed. Zero fails every request.
+	Times int
+	// Delay holds the response back, to test timeouts. A delayed failure with a zero Status lets the request
+	// through once the delay has elapsed.
+	Delay time.Duration
+}
+
+type failure struct {
+	Failure
+	remaining int
+}
+
+// Inject makes the requests matching f fail, failures are checked in the order they were injected
+func (s *Server) Inject(f Failure) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.failures = append(s.failures, &failure{Failure: f, remaining: f.Times})
+}
+
+// ClearFailures removes all the injected failures
+func (s *Server) ClearFailures() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.failures = nil
+}
+
+// Requests lists the requests received so far as "METHOD /path", the path being relative to /geoserver/rest
+func (s *Server) Requests() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	username, password, ok := r.BasicAuth()
+	if !ok || username != Username || password != Password {
+		w.Header().Set("WWW-Authenticate", `Basic realm="GeoServer Realm"`)
+		http.Error(w, "HTTP Status 401 - Bad credentials", http.StatusUnauthorized)
+		return
+	}
+
+	target, found := strings.CutPrefix(r.URL.Path, "/geoserver/rest")
+	if !found {
+		http.NotFound(w, r)
+		return
+	}
+	target = strings.TrimSuffix(target, ".json")
+
+	s.mutex.Lock()
+	s.requests = append(s.requests, r.Method+" "+target)
+	f := s.failure(r.Method, target)
+	s.mutex.Unlock()
+
+	if f != nil {
+		if f.Delay > 0 {
+			select {
+			case <-time.After(f.Delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		if f.Status != 0 || f.Delay == 0 {
+			status := f.Status
+			if status == 0 {
+				status = http.StatusInternalServerError
+			}
+
+			body := f.Body
+			if body == "" {
+				body = http.StatusText(status)
+			}
+
+			w.Header().Set("Content-Type", "text/plain")
+			w.WriteHeader(status)
+			_, _ = io.WriteString(w, body)
+			return
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	h := handler{catalog: s.catalog, base: s.URL + "/geoserver/rest", w: w, r: r}
+	h.route(strings.Split(strings.Trim(target, "/"), "/"))
+}
+
+// failure returns the first injected failure matching the request, consuming it
+func (s *Server) failure(method, target string) *Failure {
+	for i, f := range s.failures {
+		if f.Method != "" && !strings.EqualFold(f.Method, method) {
+			continue
+		}
+
+		if matched, _ := path.Match(f.Path, target); !matched {
+			continue
+		}
+
+		if f.Times > 0 {
+			f.remaining--
+			if f.remaining == 0 {
+				s.failures = append(s.failures[:i:i], s.failures[i+1:]...)
+			}
+		}
+
+		return &f.Failure
+	}
+
+	return nil
+}
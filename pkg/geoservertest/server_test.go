@@ -0,0 +1,203 @@
+package geoservertest_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/canghel3/go-geoserver/pkg/datastores"
+	"github.com/canghel3/go-geoserver/pkg/featuretypes"
+	"github.com/canghel3/go-geoserver/pkg/geoservertest"
+	"github.com/canghel3/go-geoserver/pkg/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newServer fills the fake with the roads workspace, its postgis store publishing motorways and a basemap layer group
+func newServer(t *testing.T) *geoservertest.Server {
+	t.Helper()
+
+	server := geoservertest.NewServer()
+	t.Cleanup(server.Close)
+
+	gc := server.Client()
+	require.NoError(t, gc.Workspaces().Create("roads", false))
+	require.NoError(t, gc.Workspace("roads").DataStores().Create().Custom("postgis", "PostGIS", datastores.ConnectionParams{"dbtype": "postgis"}))
+	require.NoError(t, gc.Workspace("roads").DataStore("postgis").Publish(featuretypes.New("motorways", "motorways")))
+	require.NoError(t, gc.Workspace("roads").LayerGroups().Publish(layers.NewGroup("basemap", layers.ModeSingle, []layers.LayerInput{
+		{Type: layers.TypeLayer, Name: "motorways"},
+	})))
+
+	return server
+}
+
+// do sends a request to the server, path being relative to /geoserver/rest, and returns the status and body
+func do(t *testing.T, server *geoservertest.Server, method, path, body string) (int, string) {
+	t.Helper()
+
+	request, err := http.NewRequest(method, server.URL+"/geoserver/rest"+path, strings.NewReader(body))
+	require.NoError(t, err)
+	request.SetBasicAuth(geoservertest.Username, geoservertest.Password)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	return response.StatusCode, string(content)
+}
+
+func TestServer_Routes(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		status int
+	}{
+		{name: "Version", method: http.MethodGet, path: "/about/version.json", status: http.StatusOK},
+		{name: "Version Method Not Allowed", method: http.MethodPost, path: "/about/version", status: http.StatusMethodNotAllowed},
+		{name: "Unknown Endpoint", method: http.MethodGet, path: "/unknown", status: http.StatusNotFound},
+
+		{name: "Workspaces", method: http.MethodGet, path: "/workspaces", status: http.StatusOK},
+		{name: "Workspace", method: http.MethodGet, path: "/workspaces/roads", status: http.StatusOK},
+		{name: "Workspace Not Found", method: http.MethodGet, path: "/workspaces/water", status: http.StatusNotFound},
+		{name: "Workspace Created", method: http.MethodPost, path: "/workspaces", body: `{"workspace":{"name":"water"}}`, status: http.StatusCreated},
+		{name: "Workspace Exists", method: http.MethodPost, path: "/workspaces", body: `{"workspace":{"name":"roads"}}`, status: http.StatusConflict},
+		{name: "Workspace Without Name", method: http.MethodPost, path: "/workspaces", body: `{"workspace":{}}`, status: http.StatusBadRequest},
+		{name: "Workspace Not Empty", method: http.MethodDelete, path: "/workspaces/roads", status: http.StatusForbidden},
+		{name: "Workspace Deleted Recursively", method: http.MethodDelete, path: "/workspaces/roads?recurse=true", status: http.StatusOK},
+		{name: "Namespace", method: http.MethodGet, path: "/namespaces/roads", status: http.StatusOK},
+		{name: "Namespace Not Found", method: http.MethodGet, path: "/namespaces/water", status: http.StatusNotFound},
+
+		{name: "Data Stores", method: http.MethodGet, path: "/workspaces/roads/datastores", status: http.StatusOK},
+		{name: "Data Stores Of Missing Workspace", method: http.MethodGet, path: "/workspaces/water/datastores", status: http.StatusNotFound},
+		{name: "Data Store", method: http.MethodGet, path: "/workspaces/roads/datastores/postgis", status: http.StatusOK},
+		{name: "Data Store Not Found", method: http.MethodGet, path: "/workspaces/roads/datastores/files", status: http.StatusNotFound},
+		{name: "Data Store Exists", method: http.MethodPost, path: "/workspaces/roads/datastores", body: `{"dataStore":{"name":"postgis"}}`, status: http.StatusInternalServerError},
+		{name: "Data Store Not Empty", method: http.MethodDelete, path: "/workspaces/roads/datastores/postgis", status: http.StatusUnauthorized},
+		{name: "Coverage Stores", method: http.MethodGet, path: "/workspaces/roads/coveragestores", status: http.StatusOK},
+
+		{name: "Feature Types", method: http.MethodGet, path: "/workspaces/roads/datastores/postgis/featuretypes", status: http.StatusOK},
+		{name: "Feature Type", method: http.MethodGet, path: "/workspaces/roads/datastores/postgis/featuretypes/motorways", status: http.StatusOK},
+		{name: "Feature Type Not Found", method: http.MethodGet, path: "/workspaces/roads/datastores/postgis/featuretypes/highways", status: http.StatusNotFound},
+		{name: "Feature Type Exists", method: http.MethodPost, path: "/workspaces/roads/datastores/postgis/featuretypes", body: `{"featureType":{"name":"motorways"}}`, status: http.StatusInternalServerError},
+		{name: "Feature Type Referenced", method: http.MethodDelete, path: "/workspaces/roads/datastores/postgis/featuretypes/motorways", status: http.StatusForbidden},
+
+		{name: "Layers", method: http.MethodGet, path: "/workspaces/roads/layers", status: http.StatusOK},
+		{name: "Layer", method: http.MethodGet, path: "/workspaces/roads/layers/motorways", status: http.StatusOK},
+		{name: "Qualified Layer", method: http.MethodGet, path: "/layers/roads:motorways", status: http.StatusOK},
+		{name: "Unqualified Layer", method: http.MethodGet, path: "/layers/motorways", status: http.StatusNotFound},
+
+		{name: "Layer Groups", method: http.MethodGet, path: "/workspaces/roads/layergroups", status: http.StatusOK},
+		{name: "Layer Group", method: http.MethodGet, path: "/workspaces/roads/layergroups/basemap", status: http.StatusOK},
+		{name: "Layer Group Not Found", method: http.MethodGet, path: "/layergroups/basemap", status: http.StatusNotFound},
+		{name: "Layer Group Without Layers", method: http.MethodPost, path: "/workspaces/roads/layergroups", body: `{"layerGroup":{"name":"empty"}}`, status: http.StatusBadRequest},
+		{name: "Layer Group With Missing Layer", method: http.MethodPost, path: "/workspaces/roads/layergroups", body: `{"layerGroup":{"name":"rivers","publishables":{"published":{"@type":"layer","name":"rivers"}}}}`, status: http.StatusBadRequest},
+
+		{name: "Styles", method: http.MethodGet, path: "/styles", status: http.StatusOK},
+		{name: "Global Style", method: http.MethodGet, path: "/styles/line", status: http.StatusOK},
+		{name: "Style Not Found", method: http.MethodGet, path: "/workspaces/roads/styles/line", status: http.StatusNotFound},
+
+		{name: "WMS Stores", method: http.MethodGet, path: "/workspaces/roads/wmsstores", status: http.StatusOK},
+		{name: "WMTS Stores", method: http.MethodGet, path: "/workspaces/roads/wmtsstores", status: http.StatusOK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newServer(t)
+
+			status, body := do(t, server, test.method, test.path, test.body)
+			assert.Equal(t, test.status, status, body)
+		})
+	}
+}
+
+func TestServer_Unauthorized(t *testing.T) {
+	server := newServer(t)
+
+	response, err := http.Get(server.URL + "/geoserver/rest/workspaces")
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+	assert.NotEmpty(t, response.Header.Get("WWW-Authenticate"))
+}
+
+func TestServer_Inject(t *testing.T) {
+	tests := []struct {
+		name     string
+		failure  geoservertest.Failure
+		statuses []int
+		body     string
+	}{
+		{
+			name:     "Default Status",
+			failure:  geoservertest.Failure{Path: "/workspaces/roads"},
+			statuses: []int{http.StatusInternalServerError, http.StatusInternalServerError},
+			body:     http.StatusText(http.StatusInternalServerError),
+		},
+		{
+			name:     "Status And Body",
+			failure:  geoservertest.Failure{Method: http.MethodGet, Path: "/workspaces/*", Status: http.StatusServiceUnavailable, Body: "maintenance"},
+			statuses: []int{http.StatusServiceUnavailable},
+			body:     "maintenance",
+		},
+		{
+			name:     "Times",
+			failure:  geoservertest.Failure{Path: "/workspaces/roads", Status: http.StatusBadGateway, Times: 1},
+			statuses: []int{http.StatusBadGateway, http.StatusOK},
+		},
+		{
+			name:     "Other Method",
+			failure:  geoservertest.Failure{Method: http.MethodDelete, Path: "/workspaces/roads"},
+			statuses: []int{http.StatusOK},
+		},
+		{
+			name:     "Other Path",
+			failure:  geoservertest.Failure{Path: "/workspaces/water"},
+			statuses: []int{http.StatusOK},
+		},
+		{
+			name:     "Delay",
+			failure:  geoservertest.Failure{Path: "/workspaces/roads", Delay: 10 * time.Millisecond, Times: 1},
+			statuses: []int{http.StatusOK},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newServer(t)
+			server.Inject(test.failure)
+
+			for _, expected := range test.statuses {
+				status, body := do(t, server, http.MethodGet, "/workspaces/roads.json", "")
+				assert.Equal(t, expected, status)
+				if test.body != "" && status != http.StatusOK {
+					assert.Equal(t, test.body, body)
+				}
+			}
+
+			server.ClearFailures()
+
+			status, _ := do(t, server, http.MethodGet, "/workspaces/roads", "")
+			assert.Equal(t, http.StatusOK, status)
+		})
+	}
+}
+
+func TestServer_Requests(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+
+	require.NoError(t, server.Client().Workspaces().Create("roads", false))
+	_, err := server.Client().Workspaces().Get("roads")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"POST /workspaces", "GET /workspaces/roads"}, server.Requests())
+}
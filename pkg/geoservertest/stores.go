@@ -0,0 +1,447 @@
+package geoservertest
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strings"
+)
+
+// kind describes the data stores or the coverage stores, which GeoServer serves alike
+type kind struct {
+	store        string
+	stores       string
+	storePath    string
+	resource     string
+	resources    string
+	resourcePath string
+	layerType    string
+	style        string
+}
+
+var (
+	dataStores     = kind{"dataStore", "dataStores", "datastores", "featureType", "featureTypes", "featuretypes", "VECTOR", "generic"}
+	coverageStores = kind{"coverageStore", "coverageStores", "coveragestores", "coverage", "coverages", "coverages", "RASTER", "raster"}
+)
+
+func (k kind) of(ws *workspace) map[string]*store {
+	if k == dataStores {
+		return ws.dataStores
+	}
+
+	return ws.coverageStores
+}
+
+// passwordParameters are the connection parameters GeoServer answers encrypted
+var passwordParameters = []string{"passwd", "WFSDataStoreFactory:PASSWORD"}
+
+// dataStoreTypes maps the dbtype connection parameter to the type GeoServer infers for the data store
+var dataStoreTypes = map[string]string{
+	"postgis":   "PostGIS",
+	"h2":        "H2",
+	"mysql":     "MySQL",
+	"oracle":    "Oracle NG",
+	"sqlserver": "Microsoft SQL Server",
+	"geopkg":    "GeoPackage",
+}
+
+func (h handler) stores(name string, k kind) {
+	ws, ok := h.catalog.workspaces[name]
+	if !ok {
+		h.notFound("No such workspace: '%s' found", name)
+		return
+	}
+
+	switch h.r.Method {
+	case http.MethodGet:
+		h.write(http.StatusOK, h.list(k.stores, k.store, sortedNames(k.of(ws)), func(store string) string {
+			return h.link("workspaces", ws.name, k.storePath, store)
+		}))
+	case http.MethodPost:
+		content, ok := h.decode(k.store)
+		if !ok {
+			return
+		}
+
+		storeName, _ := content["name"].(string)
+		if storeName == "" {
+			h.fail(http.StatusBadRequest, "Store name cannot be empty")
+			return
+		}
+
+		if _, exists := k.of(ws)[storeName]; exists {
+			h.fail(http.StatusInternalServerError, "Store '%s' already exists in workspace '%s'", storeName, ws.name)
+			return
+		}
+
+		if _, ok := content["enabled"]; !ok {
+			content["enabled"] = true
+		}
+		content["dateCreated"] = now()
+		if k == dataStores {
+			parameters := entries(content["connectionParameters"])
+			content["connectionParameters"] = object{"entry": parameters}
+
+			if storeType, _ := content["type"].(string); storeType == "" {
+				content["type"] = dataStoreType(parameters)
+			}
+		}
+
+		k.of(ws)[storeName] = &store{object: content, resources: make(map[string]object)}
+		h.created(h.link("workspaces", ws.name, k.storePath, storeName), storeName)
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+func (h handler) store(name string, k kind, storeName string) {
+	ws, ok := h.catalog.workspaces[name]
+	if !ok {
+		h.notFound("No such workspace: '%s' found", name)
+		return
+	}
+
+	st, ok := k.of(ws)[storeName]
+	if !ok {
+		h.notFound("No such %s: %s,%s", strings.ToLower(k.store), ws.name, storeName)
+		return
+	}
+
+	switch h.r.Method {
+	case http.MethodGet:
+		rendered := object{}
+		for key, value := range st.object {
+			rendered[key] = value
+		}
+
+		rendered["name"] = storeName
+		rendered["workspace"] = object{"name": ws.name, "href": h.link("workspaces", ws.name)}
+		rendered["_default"] = false
+		rendered[k.resources] = h.link("workspaces", ws.name, k.storePath, storeName, k.resourcePath)
+		if k == dataStores {
+			rendered["connectionParameters"] = object{"entry": encrypted(entries(st.object["connectionParameters"]))}
+		}
+
+		h.write(http.StatusOK, object{k.store: rendered})
+	case http.MethodPut:
+		content, ok := h.decode(k.store)
+		if !ok {
+			return
+		}
+
+		newName, _ := content["name"].(string)
+		if newName != "" && newName != storeName {
+			if _, exists := k.of(ws)[newName]; exists {
+				h.fail(http.StatusInternalServerError, "Store '%s' already exists in workspace '%s'", newName, ws.name)
+				return
+			}
+		}
+
+		for key, value := range content {
+			switch key {
+			case "connectionParameters":
+				st.object[key] = object{"entry": merge(entries(st.object[key]), entries(value))}
+			case "name", "workspace", "dateCreated", "dateModified", k.resources:
+			default:
+				st.object[key] = value
+			}
+		}
+		st.object["dateModified"] = now()
+
+		if newName != "" && newName != storeName {
+			delete(k.of(ws), storeName)
+			k.of(ws)[newName] = st
+			st.object["name"] = newName
+			for _, l := range ws.layers {
+				if l.class == k.resource && l.store == storeName {
+					l.store = newName
+				}
+			}
+		}
+
+		h.ok()
+	case http.MethodDelete:
+		// GeoServer answers 401 rather than 403 when the store is not empty
+		if len(st.resources) > 0 && !h.recurse() {
+			h.fail(http.StatusUnauthorized, "%s not empty", strings.ToLower(k.store))
+			return
+		}
+
+		for resource := range st.resources {
+			h.catalog.removeResource(ws, st, resource)
+		}
+		delete(k.of(ws), storeName)
+
+		h.ok()
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+// reset clears the caches of the store, or of one of its resources when resource is not empty
+func (h handler) reset(name string, k kind, storeName, resource string) {
+	if h.r.Method != http.MethodPost && h.r.Method != http.MethodPut {
+		h.methodNotAllowed()
+		return
+	}
+
+	ws, ok := h.catalog.workspaces[name]
+	if !ok {
+		h.notFound("No such workspace: '%s' found", name)
+		return
+	}
+
+	st, ok := k.of(ws)[storeName]
+	if !ok {
+		h.notFound("No such %s: %s,%s", strings.ToLower(k.store), ws.name, storeName)
+		return
+	}
+
+	if _, ok := st.resources[resource]; resource != "" && !ok {
+		h.notFound("No such %s: %s,%s", strings.ToLower(k.resource), ws.name, resource)
+		return
+	}
+
+	h.ok()
+}
+
+func (h handler) resources(name string, k kind, storeName string) {
+	ws, ok := h.catalog.workspaces[name]
+	if !ok {
+		h.notFound("No such workspace: '%s' found", name)
+		return
+	}
+
+	st, ok := k.of(ws)[storeName]
+	if !ok {
+		h.notFound("No such %s: %s,%s", strings.ToLower(k.store), ws.name, storeName)
+		return
+	}
+
+	switch h.r.Method {
+	case http.MethodGet:
+		// the native data is not known, so nothing is left to publish
+		if h.r.URL.Query().Get("list") == "available" {
+			h.write(http.StatusOK, object{"list": ""})
+			return
+		}
+
+		h.write(http.StatusOK, h.list(k.resources, k.resource, sortedNames(st.resources), func(resource string) string {
+			return h.link("workspaces", ws.name, k.storePath, storeName, k.resourcePath, resource)
+		}))
+	case http.MethodPost:
+		content, ok := h.decode(k.resource)
+		if !ok {
+			return
+		}
+
+		resource, _ := content["name"].(string)
+		if resource == "" {
+			h.fail(http.StatusBadRequest, "Resource name cannot be empty")
+			return
+		}
+
+		// layers share the namespace of the workspace, whatever their store
+		if _, exists := ws.layers[resource]; exists {
+			h.fail(http.StatusInternalServerError, "Resource named '%s' already exists in namespace: '%s'", resource, ws.name)
+			return
+		}
+
+		if nativeName, _ := content["nativeName"].(string); nativeName == "" {
+			content["nativeName"] = resource
+		}
+		if _, ok := content["enabled"]; !ok {
+			content["enabled"] = true
+		}
+		if srs, _ := content["srs"].(string); srs == "" {
+			content["srs"] = "EPSG:4326"
+		}
+		if policy, _ := content["projectionPolicy"].(string); policy == "" {
+			content["projectionPolicy"] = "FORCE_DECLARED"
+		}
+
+		created := now()
+		content["dateCreated"] = created
+		st.resources[resource] = content
+		ws.layers[resource] = &layer{class: k.resource, store: storeName, defaultStyle: k.style, created: created}
+
+		h.created(h.link("workspaces", ws.name, k.storePath, storeName, k.resourcePath, resource), resource)
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+func (h handler) resource(name string, k kind, storeName, resource string) {
+	ws, ok := h.catalog.workspaces[name]
+	if !ok {
+		h.notFound("No such workspace: '%s' found", name)
+		return
+	}
+
+	st, ok := k.of(ws)[storeName]
+	if !ok {
+		h.notFound("No such %s: %s,%s", strings.ToLower(k.store), ws.name, storeName)
+		return
+	}
+
+	content, ok := st.resources[resource]
+	if !ok {
+		h.notFound("No such %s: %s,%s", strings.ToLower(k.resource), ws.name, resource)
+		return
+	}
+
+	switch h.r.Method {
+	case http.MethodGet:
+		rendered := object{}
+		for key, value := range content {
+			rendered[key] = value
+		}
+
+		rendered["namespace"] = object{"name": ws.name, "href": h.link("namespaces", ws.name)}
+		rendered["store"] = object{"@class": k.store, "name": ws.name + ":" + storeName, "href": h.link("workspaces", ws.name, k.storePath, storeName)}
+
+		h.write(http.StatusOK, object{k.resource: rendered})
+	case http.MethodPut:
+		update, ok := h.decode(k.resource)
+		if !ok {
+			return
+		}
+
+		newName, _ := update["name"].(string)
+		if newName != "" && newName != resource {
+			if _, exists := ws.layers[newName]; exists {
+				h.fail(http.StatusInternalServerError, "Resource named '%s' already exists in namespace: '%s'", newName, ws.name)
+				return
+			}
+		}
+
+		for key, value := range update {
+			switch key {
+			case "namespace", "store", "dateCreated", "dateModified":
+			default:
+				content[key] = value
+			}
+		}
+		content["dateModified"] = now()
+
+		if newName != "" && newName != resource {
+			delete(st.resources, resource)
+			st.resources[newName] = content
+			h.catalog.renameLayer(ws, resource, newName)
+		}
+
+		h.ok()
+	case http.MethodDelete:
+		if _, ok := ws.layers[resource]; ok && !h.recurse() {
+			h.fail(http.StatusForbidden, "%s referenced by layer(s)", strings.ToLower(k.resource))
+			return
+		}
+
+		h.catalog.removeResource(ws, st, resource)
+		h.ok()
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+// renameLayer renames the layer along with the resource it publishes, GeoServer keeping both names in sync
+func (c *catalog) renameLayer(ws *workspace, old, name string) {
+	l, ok := ws.layers[old]
+	if !ok {
+		return
+	}
+
+	delete(ws.layers, old)
+	ws.layers[name] = l
+
+	visit := func(owner string, groups map[string]object) {
+		for _, group := range groups {
+			members := groupMembers(group, owner)
+			for i := range members {
+				if members[i].kind == "layer" && members[i].name == ws.name+":"+old {
+					members[i].name = ws.name + ":" + name
+				}
+			}
+
+			setGroupMembers(group, members, groupStyles(group, len(members)))
+		}
+	}
+
+	visit("", c.layerGroups)
+	for _, w := range c.workspaces {
+		visit(w.name, w.layerGroups)
+	}
+}
+
+// entries reads connection parameters, posted either as a list of entries or as a single entry
+func entries(value any) []any {
+	container, _ := value.(object)
+	return normalize(container["entry"])
+}
+
+// merge updates the entries with the updated ones, keeping the entries which are not updated
+func merge(current, updated []any) []any {
+	merged := append([]any(nil), current...)
+	for _, u := range updated {
+		key, _ := u.(object)["@key"].(string)
+
+		replaced := false
+		for i, c := range merged {
+			if k, _ := c.(object)["@key"].(string); k == key {
+				merged[i] = u
+				replaced = true
+				break
+			}
+		}
+
+		if !replaced {
+			merged = append(merged, u)
+		}
+	}
+
+	return merged
+}
+
+// encrypted hides the passwords, GeoServer answering them encrypted with its crypt1 prefix
+func encrypted(parameters []any) []any {
+	rendered := make([]any, len(parameters))
+	for i, p := range parameters {
+		entry, _ := p.(object)
+		key, _ := entry["@key"].(string)
+		value, _ := entry["$"].(string)
+
+		isPassword := false
+		for _, name := range passwordParameters {
+			isPassword = isPassword || key == name
+		}
+
+		if isPassword && !strings.HasPrefix(value, "crypt1:") {
+			value = "crypt1:" + base64.StdEncoding.EncodeToString([]byte(value))
+		}
+
+		rendered[i] = object{"@key": key, "$": value}
+	}
+
+	return rendered
+}
+
+// dataStoreType infers the type of a data store posted without one, like GeoServer does from its parameters
+func dataStoreType(parameters []any) string {
+	for _, p := range parameters {
+		entry, _ := p.(object)
+		key, _ := entry["@key"].(string)
+		value, _ := entry["$"].(string)
+
+		switch {
+		case key == "dbtype":
+			if storeType, ok := dataStoreTypes[value]; ok {
+				return storeType
+			}
+		case key == "url" && strings.HasSuffix(value, ".shp"):
+			return "Shapefile"
+		case key == "url":
+			return "Directory of spatial files (shapefiles)"
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,295 @@
+package geoservertest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// builtinStyles are the global styles GeoServer refuses to delete
+var builtinStyles = []string{"generic", "line", "point", "polygon", "raster"}
+
+const (
+	sldContentType = "application/vnd.ogc.sld+xml"
+	// sldKey holds the SLD document of a style, which is served on its own and left out of the style description
+	sldKey = "@sld"
+)
+
+func builtinSLD(name string) string {
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?><StyledLayerDescriptor version="1.0.0" xmlns="http://www.opengis.net/sld"><NamedLayer><Name>%[1]s</Name><UserStyle><Title>%[1]s</Title></UserStyle></NamedLayer></StyledLayerDescriptor>`, name)
+}
+
+// readSLD reads the SLD document of the request, GeoServer rejecting documents which are not well-formed XML
+func (h handler) readSLD() (string, bool) {
+	content, err := io.ReadAll(h.r.Body)
+	if err != nil || len(content) == 0 || xml.Unmarshal(content, new(struct{})) != nil {
+		h.fail(http.StatusBadRequest, "Invalid style document")
+		return "", false
+	}
+
+	return string(content), true
+}
+
+func (h handler) isSLD() bool {
+	return strings.HasPrefix(h.r.Header.Get("Content-Type"), sldContentType)
+}
+
+// styles returns the styles of the workspace, or the global styles when ws is empty
+func (c *catalog) stylesOf(ws string) map[string]object {
+	if ws == "" {
+		return c.styles
+	}
+
+	if w, ok := c.workspaces[ws]; ok {
+		return w.styles
+	}
+
+	return nil
+}
+
+// styleExists reports whether the style, qualified with its workspace when it has one, is in the catalog
+func (c *catalog) styleExists(qualified string) bool {
+	ws, name, found := strings.Cut(qualified, ":")
+	if !found {
+		ws, name = "", qualified
+	}
+
+	_, ok := c.stylesOf(ws)[name]
+	return ok
+}
+
+// resolveStyle finds the style referenced by name, falling back to the global style of the same name when a style
+// qualified with a workspace is not found, like GeoServer resolves the references it is given
+func (c *catalog) resolveStyle(name string) (string, bool) {
+	if c.styleExists(name) {
+		return name, true
+	}
+
+	_, local, found := strings.Cut(name, ":")
+	if found && c.styleExists(local) {
+		return local, true
+	}
+
+	return "", false
+}
+
+// styleReference renders a reference to the style, qualified with its workspace when it has one
+func (h handler) styleReference(qualified string) object {
+	ws, name, found := strings.Cut(qualified, ":")
+	if !found {
+		return object{"name": qualified, "href": h.link("styles", qualified)}
+	}
+
+	return object{"name": qualified, "href": h.link("workspaces", ws, "styles", name)}
+}
+
+// styleName reads a reference to a style, given either as its name or as an object
+func styleName(value any) string {
+	switch style := value.(type) {
+	case string:
+		return style
+	case object:
+		name, _ := style["name"].(string)
+		return name
+	default:
+		return ""
+	}
+}
+
+func (h handler) styles(ws string) {
+	if ws != "" {
+		if _, ok := h.catalog.workspaces[ws]; !ok {
+			h.notFound("No such workspace: '%s' found", ws)
+			return
+		}
+	}
+
+	styles := h.catalog.stylesOf(ws)
+	switch h.r.Method {
+	case http.MethodGet:
+		h.write(http.StatusOK, h.list("styles", "style", sortedNames(styles), func(name string) string {
+			return h.styleReference(qualify(ws, name))["href"].(string)
+		}))
+	case http.MethodPost:
+		var content object
+		if h.isSLD() {
+			sld, ok := h.readSLD()
+			if !ok {
+				return
+			}
+
+			name := h.r.URL.Query().Get("name")
+			content = object{"name": name, "format": "sld", "languageVersion": object{"version": "1.0.0"}, "filename": name + ".sld", sldKey: sld}
+		} else {
+			var ok bool
+			if content, ok = h.decode("style"); !ok {
+				return
+			}
+		}
+
+		name, _ := content["name"].(string)
+		if name == "" {
+			h.fail(http.StatusBadRequest, "Style name cannot be empty")
+			return
+		}
+
+		if _, exists := styles[name]; exists {
+			h.fail(http.StatusForbidden, "Style %s already exists.", name)
+			return
+		}
+
+		if format, _ := content["format"].(string); format == "" {
+			content["format"] = "sld"
+		}
+		if _, ok := content["languageVersion"]; !ok {
+			content["languageVersion"] = object{"version": "1.0.0"}
+		}
+		if filename, _ := content["filename"].(string); filename == "" {
+			content["filename"] = name + ".sld"
+		}
+
+		styles[name] = content
+		h.created(h.styleReference(qualify(ws, name))["href"].(string), name)
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+func (h handler) style(ws, name string) {
+	if ws != "" {
+		if _, ok := h.catalog.workspaces[ws]; !ok {
+			h.notFound("No such workspace: '%s' found", ws)
+			return
+		}
+	}
+
+	name, document := strings.CutSuffix(name, ".sld")
+
+	styles := h.catalog.stylesOf(ws)
+	style, ok := styles[name]
+	if !ok {
+		h.notFound("No such style: %s", name)
+		return
+	}
+
+	if document {
+		sld, ok := style[sldKey].(string)
+		switch {
+		case h.r.Method != http.MethodGet:
+			h.methodNotAllowed()
+		case !ok:
+			h.notFound("No such style document: %s", name)
+		default:
+			h.w.Header().Set("Content-Type", sldContentType)
+			h.w.WriteHeader(http.StatusOK)
+			_, _ = io.WriteString(h.w, sld)
+		}
+		return
+	}
+
+	switch h.r.Method {
+	case http.MethodGet:
+		rendered := object{}
+		for key, value := range style {
+			if key != sldKey {
+				rendered[key] = value
+			}
+		}
+
+		rendered["name"] = name
+		if ws != "" {
+			rendered["workspace"] = object{"name": ws}
+		}
+
+		h.write(http.StatusOK, object{"style": rendered})
+	case http.MethodPut:
+		if h.isSLD() {
+			sld, ok := h.readSLD()
+			if !ok {
+				return
+			}
+
+			style[sldKey] = sld
+			h.ok()
+			return
+		}
+
+		content, ok := h.decode("style")
+		if !ok {
+			return
+		}
+
+		for key, value := range content {
+			switch key {
+			case "name", "workspace", sldKey:
+			default:
+				style[key] = value
+			}
+		}
+
+		h.ok()
+	case http.MethodDelete:
+		if ws == "" && slices.Contains(builtinStyles, name) {
+			h.fail(http.StatusForbidden, "Unable to delete built-in style %s", name)
+			return
+		}
+
+		qualified := qualify(ws, name)
+		if users := h.catalog.styleUsers(qualified); len(users) > 0 && !h.recurse() {
+			h.fail(http.StatusForbidden, "Can't delete style referenced by existing layers.")
+			return
+		}
+
+		h.catalog.unlinkStyle(qualified)
+		delete(styles, name)
+		h.ok()
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+// unlinkStyle drops the references to the style, the layers rendered with it falling back to the default style of
+// their kind and the layer groups to the default style of their layers
+func (c *catalog) unlinkStyle(qualified string) {
+	for _, ws := range c.workspaces {
+		for _, l := range ws.layers {
+			if l.defaultStyle == qualified {
+				l.defaultStyle = kindOf(l).style
+			}
+
+			l.styles = slices.DeleteFunc(l.styles, func(style string) bool {
+				return style == qualified
+			})
+		}
+	}
+
+	visit := func(owner string, groups map[string]object) {
+		for _, group := range groups {
+			members := groupMembers(group, owner)
+			styles := groupStyles(group, len(members))
+			for i := range styles {
+				if styles[i] == qualified {
+					styles[i] = ""
+				}
+			}
+
+			setGroupMembers(group, members, styles)
+		}
+	}
+
+	visit("", c.layerGroups)
+	for _, ws := range c.workspaces {
+		visit(ws.name, ws.layerGroups)
+	}
+}
+
+func qualify(ws, name string) string {
+	if ws == "" {
+		return name
+	}
+
+	return ws + ":" + name
+}
@@ -0,0 +1,256 @@
+package geoservertest
+
+import (
+	"net/http"
+	"strings"
+)
+
+func (h handler) workspaces() {
+	switch h.r.Method {
+	case http.MethodGet:
+		h.write(http.StatusOK, h.list("workspaces", "workspace", sortedNames(h.catalog.workspaces), func(name string) string {
+			return h.link("workspaces", name)
+		}))
+	case http.MethodPost:
+		content, ok := h.decode("workspace")
+		if !ok {
+			return
+		}
+
+		name, _ := content["name"].(string)
+		if !h.create(name) {
+			return
+		}
+
+		ws := h.catalog.workspaces[name]
+		ws.isolated, _ = content["isolated"].(bool)
+		if h.r.URL.Query().Get("default") == "true" {
+			h.catalog.defaultWorkspace = name
+		}
+
+		h.created(h.link("workspaces", name), name)
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+// create adds an empty workspace, GeoServer making the first workspace the default one
+func (h handler) create(name string) bool {
+	if name == "" {
+		h.fail(http.StatusBadRequest, "Workspace name cannot be empty")
+		return false
+	}
+
+	if _, ok := h.catalog.workspaces[name]; ok {
+		h.fail(http.StatusConflict, "Workspace '%s' already exists", name)
+		return false
+	}
+
+	h.catalog.workspaces[name] = newWorkspace(name)
+	if h.catalog.defaultWorkspace == "" {
+		h.catalog.defaultWorkspace = name
+	}
+
+	return true
+}
+
+func (h handler) workspace(name string) {
+	ws, ok := h.catalog.workspaces[name]
+	if !ok {
+		h.notFound("No such workspace: '%s' found", name)
+		return
+	}
+
+	switch h.r.Method {
+	case http.MethodGet:
+		h.write(http.StatusOK, object{"workspace": h.renderWorkspace(ws)})
+	case http.MethodPut:
+		content, ok := h.decode("workspace")
+		if !ok {
+			return
+		}
+
+		if isolated, ok := content["isolated"].(bool); ok {
+			ws.isolated = isolated
+		}
+
+		newName, _ := content["name"].(string)
+		if newName != "" && newName != name {
+			if _, exists := h.catalog.workspaces[newName]; exists {
+				h.fail(http.StatusConflict, "Workspace '%s' already exists", newName)
+				return
+			}
+
+			h.catalog.renameWorkspace(ws, newName)
+		}
+
+		h.ok()
+	case http.MethodDelete:
+		if !ws.empty() && !h.recurse() {
+			h.fail(http.StatusForbidden, "Workspace not empty")
+			return
+		}
+
+		h.catalog.removeWorkspace(ws)
+		h.ok()
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+func (h handler) renderWorkspace(ws *workspace) object {
+	return object{
+		"name":           ws.name,
+		"isolated":       ws.isolated,
+		"dateCreated":    ws.created,
+		"dataStores":     h.link("workspaces", ws.name, "datastores"),
+		"coverageStores": h.link("workspaces", ws.name, "coveragestores"),
+		"wmsStores":      h.link("workspaces", ws.name, "wmsstores"),
+		"wmtsStores":     h.link("workspaces", ws.name, "wmtsstores"),
+	}
+}
+
+func (h handler) defaultWorkspace() {
+	switch h.r.Method {
+	case http.MethodGet:
+		ws, ok := h.catalog.workspaces[h.catalog.defaultWorkspace]
+		if !ok {
+			h.notFound("No default workspace is set")
+			return
+		}
+
+		h.write(http.StatusOK, object{"workspace": h.renderWorkspace(ws)})
+	case http.MethodPut:
+		content, ok := h.decode("workspace")
+		if !ok {
+			return
+		}
+
+		name, _ := content["name"].(string)
+		if _, ok := h.catalog.workspaces[name]; !ok {
+			h.notFound("No such workspace: '%s' found", name)
+			return
+		}
+
+		h.catalog.defaultWorkspace = name
+		h.ok()
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+func (h handler) namespaces() {
+	switch h.r.Method {
+	case http.MethodGet:
+		h.write(http.StatusOK, h.list("namespaces", "namespace", sortedNames(h.catalog.workspaces), func(name string) string {
+			return h.link("namespaces", name)
+		}))
+	case http.MethodPost:
+		content, ok := h.decode("namespace")
+		if !ok {
+			return
+		}
+
+		prefix, _ := content["prefix"].(string)
+		if !h.create(prefix) {
+			return
+		}
+
+		ws := h.catalog.workspaces[prefix]
+		if uri, _ := content["uri"].(string); uri != "" {
+			ws.uri = uri
+		}
+		ws.isolated, _ = content["isolated"].(bool)
+
+		h.created(h.link("namespaces", prefix), prefix)
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+// namespace serves the namespace of a workspace, which GeoServer keeps in sync with the workspace
+func (h handler) namespace(prefix string) {
+	ws, ok := h.catalog.workspaces[prefix]
+	if !ok {
+		h.notFound("No such namespace: '%s' found", prefix)
+		return
+	}
+
+	switch h.r.Method {
+	case http.MethodGet:
+		h.write(http.StatusOK, object{"namespace": object{"prefix": ws.name, "uri": ws.uri, "isolated": ws.isolated}})
+	case http.MethodPut:
+		content, ok := h.decode("namespace")
+		if !ok {
+			return
+		}
+
+		if uri, _ := content["uri"].(string); uri != "" {
+			ws.uri = uri
+		}
+
+		if isolated, ok := content["isolated"].(bool); ok {
+			ws.isolated = isolated
+		}
+
+		h.ok()
+	case http.MethodDelete:
+		if !ws.empty() {
+			h.fail(http.StatusForbidden, "Namespace not empty")
+			return
+		}
+
+		h.catalog.removeWorkspace(ws)
+		h.ok()
+	default:
+		h.methodNotAllowed()
+	}
+}
+
+// renameWorkspace moves the workspace and rewrites the references to its layers, layer groups and styles
+func (c *catalog) renameWorkspace(ws *workspace, name string) {
+	old := ws.name
+	delete(c.workspaces, old)
+	ws.name = name
+	if ws.uri == "http://"+old {
+		ws.uri = "http://" + name
+	}
+	c.workspaces[name] = ws
+
+	if c.defaultWorkspace == old {
+		c.defaultWorkspace = name
+	}
+
+	rename := func(qualified string) string {
+		if local, found := strings.CutPrefix(qualified, old+":"); found {
+			return name + ":" + local
+		}
+
+		return qualified
+	}
+
+	visit := func(owner string, groups map[string]object) {
+		for _, group := range groups {
+			members := groupMembers(group, owner)
+			styles := groupStyles(group, len(members))
+			for i := range members {
+				members[i].name = rename(members[i].name)
+				styles[i] = rename(styles[i])
+			}
+
+			setGroupMembers(group, members, styles)
+		}
+	}
+
+	visit("", c.layerGroups)
+	for _, w := range c.workspaces {
+		visit(w.name, w.layerGroups)
+
+		for _, l := range w.layers {
+			l.defaultStyle = rename(l.defaultStyle)
+			for i := range l.styles {
+				l.styles[i] = rename(l.styles[i])
+			}
+		}
+	}
+}
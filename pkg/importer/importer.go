@@ -0,0 +1,242 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// State is the state of an import context.
+type State string
+
+const (
+	StatePending    State = "PENDING"
+	StateInit       State = "INIT"
+	StateInitError  State = "INIT_ERROR"
+	StateReady      State = "READY"
+	StateRunning    State = "RUNNING"
+	StateIncomplete State = "INCOMPLETE"
+	StateComplete   State = "COMPLETE"
+)
+
+// Done reports whether the import is no longer running.
+// An import that has not been run yet is PENDING or READY and is not done.
+func (s State) Done() bool {
+	switch s {
+	case StateComplete, StateIncomplete, StateInitError:
+		return true
+	default:
+		return false
+	}
+}
+
+// TaskState is the state of a single task of an import.
+type TaskState string
+
+const (
+	TaskStatePending   TaskState = "PENDING"
+	TaskStateReady     TaskState = "READY"
+	TaskStateRunning   TaskState = "RUNNING"
+	TaskStateNoCRS     TaskState = "NO_CRS"
+	TaskStateNoBounds  TaskState = "NO_BOUNDS"
+	TaskStateNoFormat  TaskState = "NO_FORMAT"
+	TaskStateBadFormat TaskState = "BAD_FORMAT"
+	TaskStateError     TaskState = "ERROR"
+	TaskStateCanceled  TaskState = "CANCELED"
+	TaskStateComplete  TaskState = "COMPLETE"
+)
+
+// UpdateMode controls what happens when the target of a task already exists.
+type UpdateMode string
+
+const (
+	UpdateModeCreate  UpdateMode = "CREATE"
+	UpdateModeReplace UpdateMode = "REPLACE"
+	UpdateModeAppend  UpdateMode = "APPEND"
+	UpdateModeUpdate  UpdateMode = "UPDATE"
+)
+
+type ImportWrapper struct {
+	Import Import `json:"import"`
+}
+
+type Import struct {
+	ID              int              `json:"id"`
+	Href            string           `json:"href,omitempty"`
+	State           State            `json:"state"`
+	Archive         bool             `json:"archive"`
+	TargetWorkspace *TargetWorkspace `json:"targetWorkspace,omitempty"`
+	TargetStore     *TargetStore     `json:"targetStore,omitempty"`
+	Data            *Data            `json:"data,omitempty"`
+	Tasks           []Task           `json:"tasks,omitempty"`
+}
+
+// Err returns an *ImportError listing the tasks that did not complete once the import is done, and nil otherwise.
+func (i Import) Err() error {
+	if !i.State.Done() || i.State == StateComplete {
+		return nil
+	}
+
+	ie := &ImportError{ID: i.ID, State: i.State}
+	for _, task := range i.Tasks {
+		if task.State != TaskStateComplete {
+			ie.Tasks = append(ie.Tasks, task)
+		}
+	}
+
+	return ie
+}
+
+// ImportsWrapper is the response of the imports listing.
+type ImportsWrapper struct {
+	Imports []Import `json:"imports"`
+}
+
+type TargetWorkspace struct {
+	Workspace Reference `json:"workspace"`
+}
+
+type TargetStore struct {
+	DataStore     *Reference `json:"dataStore,omitempty"`
+	CoverageStore *Reference `json:"coverageStore,omitempty"`
+}
+
+type Reference struct {
+	Name string `json:"name"`
+	Href string `json:"href,omitempty"`
+}
+
+// Data describes the source of an import or of a task, such as a file, a directory or a database.
+type Data struct {
+	Type     string `json:"type"`
+	Format   string `json:"format,omitempty"`
+	File     string `json:"file,omitempty"`
+	Location string `json:"location,omitempty"`
+	Charset  string `json:"charset,omitempty"`
+	Href     string `json:"href,omitempty"`
+}
+
+// TasksWrapper is the response of the task listing and of adding tasks,
+// GeoServer replies with a single task when one file was added and with a list otherwise.
+type TasksWrapper struct {
+	Tasks []Task `json:"tasks"`
+}
+
+func (tw *TasksWrapper) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Task  *Task  `json:"task"`
+		Tasks []Task `json:"tasks"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	tw.Tasks = raw.Tasks
+	if raw.Task != nil {
+		tw.Tasks = append(tw.Tasks, *raw.Task)
+	}
+
+	return nil
+}
+
+type TaskWrapper struct {
+	Task Task `json:"task"`
+}
+
+type Task struct {
+	ID             int             `json:"id"`
+	Href           string          `json:"href,omitempty"`
+	State          TaskState       `json:"state,omitempty"`
+	UpdateMode     UpdateMode      `json:"updateMode,omitempty"`
+	Data           *Data           `json:"data,omitempty"`
+	Target         *TargetStore    `json:"target,omitempty"`
+	Progress       string          `json:"progress,omitempty"`
+	Layer          *Reference      `json:"layer,omitempty"`
+	TransformChain *TransformChain `json:"transformChain,omitempty"`
+	ErrorMessage   string          `json:"errorMessage,omitempty"`
+}
+
+type TransformChain struct {
+	Type       string      `json:"type"`
+	Transforms []Transform `json:"transforms"`
+}
+
+type LayerWrapper struct {
+	Layer Layer `json:"layer"`
+}
+
+// Layer is the layer that a task will publish.
+type Layer struct {
+	Name         string `json:"name,omitempty"`
+	Href         string `json:"href,omitempty"`
+	Title        string `json:"title,omitempty"`
+	Abstract     string `json:"abstract,omitempty"`
+	OriginalName string `json:"originalName,omitempty"`
+	NativeName   string `json:"nativeName,omitempty"`
+	SRS          string `json:"srs,omitempty"`
+}
+
+type TransformsWrapper struct {
+	Transforms []Transform `json:"transforms"`
+}
+
+// Transform is applied to the data of a task before it is imported, use the constructors to build one.
+type Transform struct {
+	Type   string `json:"type"`
+	Href   string `json:"href,omitempty"`
+	Source string `json:"source,omitempty"`
+	Target string `json:"target,omitempty"`
+	Field  string `json:"field,omitempty"`
+	Format string `json:"format,omitempty"`
+}
+
+// Reproject transforms the geometries of the data to the target srs (e.g. EPSG:4326).
+func Reproject(target string) Transform {
+	return Transform{Type: "ReprojectTransform", Target: target}
+}
+
+// AttributeRemap changes the type of field to the target java class (e.g. java.lang.Integer).
+func AttributeRemap(field, target string) Transform {
+	return Transform{Type: "AttributeRemapTransform", Field: field, Target: target}
+}
+
+// DateFormat parses the text of field into a date. An empty format lets GeoServer guess it.
+func DateFormat(field, format string) Transform {
+	return Transform{Type: "DateFormatTransform", Field: field, Format: format}
+}
+
+type ProgressWrapper struct {
+	Progress Progress `json:"progress"`
+}
+
+// Progress is the number of features or bytes processed by a running task.
+type Progress struct {
+	Progress int       `json:"progress"`
+	Total    int       `json:"total"`
+	State    TaskState `json:"state"`
+	Message  string    `json:"message,omitempty"`
+}
+
+// ImportError is returned when an import is done but some of its tasks did not complete.
+type ImportError struct {
+	ID    int
+	State State
+	Tasks []Task
+}
+
+func (ie *ImportError) Error() string {
+	tasks := make([]string, len(ie.Tasks))
+	for i, task := range ie.Tasks {
+		tasks[i] = fmt.Sprintf("task %d %s", task.ID, task.State)
+		if task.ErrorMessage != "" {
+			tasks[i] += ": " + task.ErrorMessage
+		}
+	}
+
+	msg := fmt.Sprintf("import %d ended with state %s", ie.ID, ie.State)
+	if len(tasks) > 0 {
+		msg += " (" + strings.Join(tasks, "; ") + ")"
+	}
+
+	return msg
+}
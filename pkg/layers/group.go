@@ -2,6 +2,7 @@ package layers
 
 import (
 	"encoding/json"
+	"github.com/canghel3/go-geoserver/internal/jsonutil"
 	"github.com/canghel3/go-geoserver/internal/models"
 	"github.com/canghel3/go-geoserver/internal/validator"
 	"github.com/canghel3/go-geoserver/pkg/options"
@@ -70,6 +71,17 @@ type LayerInput struct {
 	Style string
 }
 
+type GroupsWrapper struct {
+	Groups Groups `json:"layerGroups"`
+}
+
+type Groups struct {
+	Entries []struct {
+		Name string `json:"name"`
+		Href string `json:"href"`
+	} `json:"layerGroup"`
+}
+
 type GroupWrapper struct {
 	Group Group `json:"layerGroup"`
 }
@@ -78,16 +90,17 @@ type GroupWrapper struct {
 // geoserver parses it to an actual number and returns it,
 // which will cause panics when decoding the name here.
 type Group struct {
-	Name         string              `json:"name"`
-	Mode         GroupMode           `json:"mode"`
-	Title        string              `json:"title"`
-	Workspace    *workspace.Creation `json:"workspace,omitempty"`
-	Publishables *Publishables       `json:"publishables"`
-	Bounds       *shared.BoundingBox `json:"bounds,omitempty"`
-	Keywords     *shared.Keywords    `json:"keywords,omitempty"`
-	Styles       *GroupStyles        `json:"styles,omitempty"`
-	DateCreated  string              `json:"dateCreated"`
-	DateModified string              `json:"dateModified"`
+	Name          string                `json:"name"`
+	Mode          GroupMode             `json:"mode"`
+	Title         string                `json:"title"`
+	Workspace     *workspace.Creation   `json:"workspace,omitempty"`
+	Publishables  *Publishables         `json:"publishables"`
+	Bounds        *shared.BoundingBox   `json:"bounds,omitempty"`
+	Keywords      *shared.Keywords      `json:"keywords,omitempty"`
+	Styles        *GroupStyles          `json:"styles,omitempty"`
+	MetadataLinks *shared.MetadataLinks `json:"metadataLinks,omitempty"`
+	DateCreated   string                `json:"dateCreated"`
+	DateModified  string                `json:"dateModified"`
 }
 
 func (g *Group) AddPublishables(layers ...LayerInput) {
@@ -132,31 +145,23 @@ type GroupStyles struct {
 	Style []shared.Style `json:"style"`
 }
 
+// UnmarshalJSON handles GeoServer responding with a single style when the group contains a single layer, given by
+// its name alone when it is the default style
 func (gs *GroupStyles) UnmarshalJSON(data []byte) error {
-	var m map[string]json.RawMessage
-	if err := json.Unmarshal(data, &m); err != nil {
-		return err
+	var raw struct {
+		Style json.RawMessage `json:"style"`
 	}
-
-	type alias GroupStyles
-	var temp alias
-	if err := json.Unmarshal(m["style"], &temp.Style); err == nil {
-		*gs = GroupStyles(temp)
-		return nil
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
 	}
 
-	//geoserver responds with a single string when the group contains a single layer with the default style
-	var s string
-	if err := json.Unmarshal(m["style"], &s); err == nil {
-		gs.Style = []shared.Style{
-			{
-				Name: s,
-			},
-		}
+	//the empty string is the default style of the single layer, not an empty list
+	if string(raw.Style) == `""` {
+		gs.Style = []shared.Style{{}}
 		return nil
 	}
 
-	return nil
+	return jsonutil.OneOrMany(raw.Style, &gs.Style)
 }
 
 type Publishables struct {
@@ -1 +1,82 @@
 package layers
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/canghel3/go-geoserver/internal/jsonutil"
+	"github.com/canghel3/go-geoserver/pkg/shared"
+)
+
+type LayersWrapper struct {
+	Layers Layers `json:"layers"`
+}
+
+type Layers struct {
+	Entries []struct {
+		Name string `json:"name"`
+		Href string `json:"href"`
+	} `json:"layer"`
+}
+
+type LayerWrapper struct {
+	Layer Layer `json:"layer"`
+}
+
+// Layer is the published view of a feature type, coverage or cascaded layer, holding the styles used to render it.
+type Layer struct {
+	Name         string        `json:"name"`
+	Path         string        `json:"path,omitempty"`
+	Type         string        `json:"type"`
+	DefaultStyle *shared.Style `json:"defaultStyle,omitempty"`
+	Styles       *LayerStyles  `json:"styles,omitempty"`
+	Resource     Resource      `json:"resource"`
+	Queryable    bool          `json:"queryable,omitempty"`
+	Opaque       bool          `json:"opaque,omitempty"`
+	DateCreated  string        `json:"dateCreated,omitempty"`
+	DateModified string        `json:"dateModified,omitempty"`
+}
+
+// Resource links the layer to the feature type or coverage it publishes
+type Resource struct {
+	// Class is one of featureType, coverage, wmsLayer or wmtsLayer
+	Class string `json:"@class"`
+	// Name is prefixed with the workspace
+	Name string `json:"name"`
+	Link string `json:"href"`
+}
+
+// Store returns the name of the store of the resource, parsed from its link
+func (r Resource) Store() string {
+	for _, stores := range []string{"/datastores/", "/coveragestores/", "/wmsstores/", "/wmtsstores/"} {
+		_, after, found := strings.Cut(r.Link, stores)
+		if found {
+			store, _, _ := strings.Cut(after, "/")
+			return store
+		}
+	}
+
+	return ""
+}
+
+// LayerStyles lists the alternative styles of a layer, besides its default style
+type LayerStyles struct {
+	Style []shared.Style `json:"style"`
+}
+
+// UnmarshalJSON handles GeoServer responding with an empty string when the layer has no alternative styles
+func (ls *LayerStyles) UnmarshalJSON(data []byte) error {
+	ls.Style = nil
+	if string(data) == `""` {
+		return nil
+	}
+
+	var raw struct {
+		Style json.RawMessage `json:"style"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return jsonutil.OneOrMany(raw.Style, &ls.Style)
+}
@@ -0,0 +1,937 @@
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/pkg/actions"
+	"github.com/canghel3/go-geoserver/pkg/client"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/datastores"
+	"github.com/canghel3/go-geoserver/pkg/formats"
+	"github.com/canghel3/go-geoserver/pkg/layers"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/canghel3/go-geoserver/pkg/shared"
+)
+
+// Diff compares the manifest with the live catalog, using the Get and GetAll calls of the client,
+// and returns the plan reconciling them. Nothing is changed until the plan is applied.
+func Diff(gc client.GeoserverClient, desired Manifest, opts ...options.ManifestOption) (*Plan, error) {
+	var o models.ManifestOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if err := desired.Validate(); err != nil {
+		return nil, err
+	}
+
+	d := differ{gc: gc, options: o, desired: desired}
+	for _, ws := range desired.Workspaces {
+		if err := d.workspace(ws); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := d.globals(desired); err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{}
+	plan.Changes = append(plan.Changes, d.workspaces...)
+	plan.Changes = append(plan.Changes, d.styles...)
+	plan.Changes = append(plan.Changes, d.stores...)
+	plan.Changes = append(plan.Changes, d.resources...)
+	plan.Changes = append(plan.Changes, d.groups...)
+	plan.Changes = append(plan.Changes, d.deletedGroups...)
+	plan.Changes = append(plan.Changes, d.deletedResources...)
+	plan.Changes = append(plan.Changes, d.deletedStores...)
+	plan.Changes = append(plan.Changes, d.deletedStyles...)
+
+	return plan, nil
+}
+
+// differ collects the changes by phase, so that they can be applied in dependency order
+type differ struct {
+	gc      client.GeoserverClient
+	options models.ManifestOptions
+	desired Manifest
+
+	// existingStyles caches the styles found by workspace, since they are shared by the layer groups
+	existingStyles map[string]bool
+
+	workspaces       []Change
+	styles           []Change
+	stores           []Change
+	resources        []Change
+	groups           []Change
+	deletedGroups    []Change
+	deletedResources []Change
+	deletedStores    []Change
+	deletedStyles    []Change
+}
+
+func (d *differ) workspace(ws Workspace) error {
+	live, err := d.gc.Workspaces().Get(ws.Name)
+	switch {
+	case isNotFound(err):
+		d.workspaces = append(d.workspaces, Change{
+			Action: ActionCreate,
+			Kind:   KindWorkspace,
+			Path:   ws.Name,
+			apply: func() error {
+				return d.gc.Workspaces().Create(ws.Name, false, options.Workspace.Isolated(ws.Isolated))
+			},
+		})
+
+		// nothing exists below a missing workspace
+		for _, style := range ws.Styles {
+			d.createStyle(ws.Name, style)
+		}
+
+		for _, ds := range ws.DataStores {
+			d.createDataStore(ws.Name, ds)
+		}
+
+		for _, cs := range ws.CoverageStores {
+			d.createCoverageStore(ws.Name, cs)
+		}
+
+		for _, lg := range ws.LayerGroups {
+			d.createLayerGroup(ws, lg)
+		}
+
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if live.Isolated != ws.Isolated {
+		d.workspaces = append(d.workspaces, Change{
+			Action: ActionUpdate,
+			Kind:   KindWorkspace,
+			Path:   ws.Name,
+			Fields: []string{"isolated"},
+			apply: func() error {
+				return d.gc.Workspaces().Update(ws.Name, ws.Name, options.Workspace.Isolated(ws.Isolated))
+			},
+		})
+	}
+
+	if err = d.workspaceStyles(ws); err != nil {
+		return err
+	}
+
+	if err = d.dataStores(ws); err != nil {
+		return err
+	}
+
+	if err = d.coverageStores(ws); err != nil {
+		return err
+	}
+
+	return d.layerGroups(ws)
+}
+
+// globals compares the global styles and layer groups like those of a workspace without a name, for which the client
+// uses the global endpoints. They are only compared when the manifest declares some, and never pruned.
+func (d *differ) globals(m Manifest) error {
+	if len(m.Styles) == 0 && len(m.LayerGroups) == 0 {
+		return nil
+	}
+
+	global := Workspace{Styles: m.Styles, LayerGroups: m.LayerGroups}
+	if err := d.workspaceStyles(global); err != nil {
+		return err
+	}
+
+	return d.layerGroups(global)
+}
+
+func (d *differ) workspaceStyles(ws Workspace) error {
+	styles := d.gc.Workspace(ws.Name).Styles()
+	all, err := styles.GetAll()
+	if err != nil {
+		return err
+	}
+
+	live := make(map[string]bool)
+	for _, entry := range all.Entries {
+		live[strings.TrimPrefix(entry.Name, ws.Name+":")] = true
+	}
+
+	for _, style := range ws.Styles {
+		if !live[style.Name] {
+			d.createStyle(ws.Name, style)
+			continue
+		}
+		delete(live, style.Name)
+
+		current, err := styles.SLD(style.Name)
+		if err != nil {
+			return err
+		}
+
+		if strings.TrimSpace(string(current)) != strings.TrimSpace(style.SLD) {
+			d.styles = append(d.styles, Change{
+				Action: ActionUpdate,
+				Kind:   KindStyle,
+				Path:   path(ws.Name, style.Name),
+				Fields: []string{"sld"},
+				apply: func() error {
+					return d.gc.Workspace(ws.Name).Styles().Update(style.Name, []byte(style.SLD))
+				},
+			})
+		}
+	}
+
+	if d.options.Prune && ws.Name != "" {
+		// the styles go last, once the layers and groups using them are deleted, and are kept while still in use
+		for _, name := range sortedKeys(live) {
+			d.deletedStyles = append(d.deletedStyles, Change{
+				Action: ActionDelete,
+				Kind:   KindStyle,
+				Path:   path(ws.Name, name),
+				apply: func() error {
+					return d.gc.Workspace(ws.Name).Styles().Delete(name, false)
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+func (d *differ) createStyle(workspace string, style Style) {
+	d.styles = append(d.styles, Change{
+		Action: ActionCreate,
+		Kind:   KindStyle,
+		Path:   path(workspace, style.Name),
+		apply: func() error {
+			return d.gc.Workspace(workspace).Styles().Create(style.Name, []byte(style.SLD))
+		},
+	})
+}
+
+func (d *differ) dataStores(ws Workspace) error {
+	stores := d.gc.Workspace(ws.Name).DataStores()
+	all, err := stores.GetAll()
+	if err != nil {
+		return err
+	}
+
+	live := make(map[string]bool)
+	for _, entry := range all.Entries {
+		live[entry.Name] = true
+	}
+
+	for _, ds := range ws.DataStores {
+		if !live[ds.Name] {
+			d.createDataStore(ws.Name, ds)
+			continue
+		}
+		delete(live, ds.Name)
+
+		if err = d.dataStore(ws.Name, ds); err != nil {
+			return err
+		}
+	}
+
+	if d.options.Prune {
+		for _, name := range sortedKeys(live) {
+			d.deletedStores = append(d.deletedStores, Change{
+				Action: ActionDelete,
+				Kind:   KindDataStore,
+				Path:   path(ws.Name, name),
+				apply: func() error {
+					return d.gc.Workspace(ws.Name).DataStores().Delete(name, true)
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+func (d *differ) createDataStore(workspace string, ds DataStore) {
+	d.stores = append(d.stores, Change{
+		Action: ActionCreate,
+		Kind:   KindDataStore,
+		Path:   path(workspace, ds.Name),
+		apply: func() error {
+			return d.gc.Workspace(workspace).DataStores().Create(options.GenericStore.Description(ds.Description)).Custom(ds.Name, ds.Type, ds.ConnectionParameters)
+		},
+	})
+
+	for _, ft := range ds.FeatureTypes {
+		d.createFeatureType(workspace, ds.Name, ft)
+	}
+}
+
+func (d *differ) dataStore(workspace string, ds DataStore) error {
+	stores := d.gc.Workspace(workspace).DataStores()
+	live, err := stores.Get(ds.Name)
+	if err != nil {
+		return err
+	}
+
+	if ds.Type != "" && live.Type != "" && !strings.EqualFold(ds.Type, live.Type) {
+		return customerrors.WrapInputError(fmt.Errorf("cannot change the type of data store %s from %s to %s, delete it first", path(workspace, ds.Name), live.Type, ds.Type))
+	}
+
+	fields := differences(field{"description", ds.Description, live.Description})
+
+	params := make(datastores.ConnectionParams)
+	for _, key := range sortedKeys(ds.ConnectionParameters) {
+		if isPassword(key) {
+			continue
+		}
+
+		value, ok := live.ConnectionParameters.Get(key)
+		if !ok || value != ds.ConnectionParameters[key] {
+			params[key] = ds.ConnectionParameters[key]
+			fields = append(fields, key)
+		}
+	}
+
+	if len(fields) > 0 {
+		d.stores = append(d.stores, Change{
+			Action: ActionUpdate,
+			Kind:   KindDataStore,
+			Path:   path(workspace, ds.Name),
+			Fields: fields,
+			apply: func() error {
+				stores := d.gc.Workspace(workspace).DataStores()
+				if len(params) > 0 {
+					if err := stores.UpdateConnectionParameters(ds.Name, params); err != nil {
+						return err
+					}
+				}
+
+				if ds.Description == "" || ds.Description == live.Description {
+					return nil
+				}
+
+				current, err := stores.Get(ds.Name)
+				if err != nil {
+					return err
+				}
+
+				current.Description = ds.Description
+				return stores.Update(ds.Name, *current)
+			},
+		})
+	}
+
+	return d.featureTypes(workspace, ds)
+}
+
+func (d *differ) featureTypes(workspace string, ds DataStore) error {
+	featureTypes := d.gc.Workspace(workspace).DataStore(ds.Name)
+	all, err := featureTypes.GetAll()
+	if err != nil {
+		return err
+	}
+
+	live := make(map[string]bool)
+	for _, entry := range all.Entries {
+		live[entry.Name] = true
+	}
+
+	for _, ft := range ds.FeatureTypes {
+		if !live[ft.Name] {
+			d.createFeatureType(workspace, ds.Name, ft)
+			continue
+		}
+		delete(live, ft.Name)
+
+		current, err := featureTypes.Get(ft.Name)
+		if err != nil {
+			return err
+		}
+
+		fields := differences(
+			field{"title", ft.Title, current.Title},
+			field{"abstract", ft.Abstract, current.Abstract},
+			field{"srs", ft.SRS, current.Srs},
+		)
+
+		if len(fields) > 0 {
+			d.resources = append(d.resources, Change{
+				Action: ActionUpdate,
+				Kind:   KindFeatureType,
+				Path:   path(workspace, ds.Name, ft.Name),
+				Fields: fields,
+				apply: func() error {
+					featureTypes := d.gc.Workspace(workspace).DataStore(ds.Name)
+					current, err := featureTypes.Get(ft.Name)
+					if err != nil {
+						return err
+					}
+
+					current.Title = or(ft.Title, current.Title)
+					current.Abstract = or(ft.Abstract, current.Abstract)
+					current.Srs = or(ft.SRS, current.Srs)
+					return featureTypes.Update(ft.Name, *current)
+				},
+			})
+		}
+	}
+
+	if d.options.Prune {
+		for _, name := range sortedKeys(live) {
+			d.deletedResources = append(d.deletedResources, Change{
+				Action: ActionDelete,
+				Kind:   KindFeatureType,
+				Path:   path(workspace, ds.Name, name),
+				apply: func() error {
+					return d.gc.Workspace(workspace).DataStore(ds.Name).Delete(name, true)
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+func (d *differ) createFeatureType(workspace, store string, ft FeatureType) {
+	d.resources = append(d.resources, Change{
+		Action: ActionCreate,
+		Kind:   KindFeatureType,
+		Path:   path(workspace, store, ft.Name),
+		apply: func() error {
+			featureType := models.FeatureType{
+				Name:       ft.Name,
+				NativeName: or(ft.NativeName, ft.Name),
+				Title:      optional(ft.Title),
+				Abstract:   optional(ft.Abstract),
+				Srs:        optional(ft.SRS),
+			}
+
+			return d.gc.Workspace(workspace).DataStore(store).Publish(featureType)
+		},
+	})
+}
+
+func (d *differ) coverageStores(ws Workspace) error {
+	stores := d.gc.Workspace(ws.Name).CoverageStores()
+	all, err := stores.GetAll()
+	if err != nil {
+		return err
+	}
+
+	live := make(map[string]bool)
+	for _, entry := range all.Entries {
+		live[entry.Name] = true
+	}
+
+	for _, cs := range ws.CoverageStores {
+		if !live[cs.Name] {
+			d.createCoverageStore(ws.Name, cs)
+			continue
+		}
+		delete(live, cs.Name)
+
+		if err = d.coverageStore(ws.Name, cs); err != nil {
+			return err
+		}
+	}
+
+	if d.options.Prune {
+		for _, name := range sortedKeys(live) {
+			d.deletedStores = append(d.deletedStores, Change{
+				Action: ActionDelete,
+				Kind:   KindCoverageStore,
+				Path:   path(ws.Name, name),
+				apply: func() error {
+					return d.gc.Workspace(ws.Name).CoverageStores().Delete(name, true)
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+// createCoverageStore plans the creation of the store even for the formats that the client cannot create, such as
+// exported mosaics, which can only be compared with an existing store. Applying the change fails for them instead, so
+// that the rest of the plan can still be applied.
+func (d *differ) createCoverageStore(workspace string, cs CoverageStore) {
+	d.stores = append(d.stores, Change{
+		Action: ActionCreate,
+		Kind:   KindCoverageStore,
+		Path:   path(workspace, cs.Name),
+		apply: func() error {
+			list := d.gc.Workspace(workspace).CoverageStores().Create(options.GenericStore.Description(cs.Description))
+			return createCoverageStore(list, cs)
+		},
+	})
+
+	for _, c := range cs.Coverages {
+		d.createCoverage(workspace, cs.Name, c)
+	}
+}
+
+func (d *differ) coverageStore(workspace string, cs CoverageStore) error {
+	stores := d.gc.Workspace(workspace).CoverageStores()
+	live, err := stores.Get(cs.Name)
+	if err != nil {
+		return err
+	}
+
+	if live.Type != "" && !strings.EqualFold(string(cs.Type), live.Type) {
+		return customerrors.WrapInputError(fmt.Errorf("cannot change the type of coverage store %s from %s to %s, delete it first", path(workspace, cs.Name), live.Type, cs.Type))
+	}
+
+	var fields []string
+	if strings.TrimPrefix(cs.URL, "file:") != strings.TrimPrefix(live.URL, "file:") {
+		fields = append(fields, "url")
+	}
+
+	fields = append(fields, differences(field{"description", cs.Description, live.Description})...)
+
+	if len(fields) > 0 {
+		d.stores = append(d.stores, Change{
+			Action: ActionUpdate,
+			Kind:   KindCoverageStore,
+			Path:   path(workspace, cs.Name),
+			Fields: fields,
+			apply: func() error {
+				stores := d.gc.Workspace(workspace).CoverageStores()
+				current, err := stores.Get(cs.Name)
+				if err != nil {
+					return err
+				}
+
+				current.Description = or(cs.Description, current.Description)
+				if strings.TrimPrefix(cs.URL, "file:") != strings.TrimPrefix(current.URL, "file:") {
+					current.URL = "file:" + strings.TrimPrefix(cs.URL, "file:")
+				}
+
+				return stores.Update(cs.Name, *current)
+			},
+		})
+	}
+
+	return d.coverages(workspace, cs)
+}
+
+func (d *differ) coverages(workspace string, cs CoverageStore) error {
+	coverages := d.gc.Workspace(workspace).CoverageStore(cs.Name)
+	all, err := coverages.GetAll()
+	if err != nil {
+		return err
+	}
+
+	live := make(map[string]bool)
+	for _, entry := range all.Entries {
+		live[entry.Name] = true
+	}
+
+	for _, c := range cs.Coverages {
+		if !live[c.Name] {
+			d.createCoverage(workspace, cs.Name, c)
+			continue
+		}
+		delete(live, c.Name)
+
+		current, err := coverages.Get(c.Name)
+		if err != nil {
+			return err
+		}
+
+		fields := differences(
+			field{"title", c.Title, value(current.Title)},
+			field{"abstract", c.Abstract, value(current.Abstract)},
+			field{"srs", c.SRS, value(current.Srs)},
+		)
+
+		if len(fields) > 0 {
+			d.resources = append(d.resources, Change{
+				Action: ActionUpdate,
+				Kind:   KindCoverage,
+				Path:   path(workspace, cs.Name, c.Name),
+				Fields: fields,
+				apply: func() error {
+					coverage := models.Coverage{
+						Name:       c.Name,
+						NativeName: current.NativeName,
+						Title:      optional(c.Title),
+						Abstract:   optional(c.Abstract),
+						Srs:        optional(c.SRS),
+					}
+
+					return d.gc.Workspace(workspace).CoverageStore(cs.Name).Update(c.Name, coverage)
+				},
+			})
+		}
+	}
+
+	if d.options.Prune {
+		for _, name := range sortedKeys(live) {
+			d.deletedResources = append(d.deletedResources, Change{
+				Action: ActionDelete,
+				Kind:   KindCoverage,
+				Path:   path(workspace, cs.Name, name),
+				apply: func() error {
+					return d.gc.Workspace(workspace).CoverageStore(cs.Name).Delete(name, true)
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+func (d *differ) createCoverage(workspace, store string, c Coverage) {
+	d.resources = append(d.resources, Change{
+		Action: ActionCreate,
+		Kind:   KindCoverage,
+		Path:   path(workspace, store, c.Name),
+		apply: func() error {
+			coverage := models.Coverage{
+				Name:       c.Name,
+				NativeName: or(c.NativeName, c.Name),
+				Title:      optional(c.Title),
+				Abstract:   optional(c.Abstract),
+				Srs:        optional(c.SRS),
+			}
+
+			return d.gc.Workspace(workspace).CoverageStore(store).Publish(coverage)
+		},
+	})
+}
+
+func (d *differ) layerGroups(ws Workspace) error {
+	groups := d.gc.Workspace(ws.Name).LayerGroups()
+	all, err := groups.GetAll()
+	if err != nil {
+		return err
+	}
+
+	live := make(map[string]bool)
+	for _, entry := range all.Entries {
+		live[entry.Name] = true
+	}
+
+	for _, lg := range ws.LayerGroups {
+		if !live[lg.Name] {
+			d.createLayerGroup(ws, lg)
+			continue
+		}
+		delete(live, lg.Name)
+
+		current, err := groups.Get(lg.Name)
+		if err != nil {
+			return err
+		}
+
+		var currentLayers []string
+		if current.Publishables != nil {
+			for _, entry := range current.Publishables.Entries {
+				currentLayers = append(currentLayers, strings.TrimPrefix(entry.Name, ws.Name+":"))
+			}
+		}
+
+		desiredLayers := make([]string, len(lg.Layers))
+		for i, layer := range lg.Layers {
+			desiredLayers[i] = strings.TrimPrefix(layer, ws.Name+":")
+		}
+
+		fields := differences(
+			field{"title", lg.Title, current.Title},
+			field{"mode", string(lg.Mode), string(current.Mode)},
+		)
+
+		if !slices.Equal(desiredLayers, currentLayers) {
+			fields = append(fields, "layers")
+		}
+
+		if len(lg.Styles) > 0 {
+			var currentStyles []string
+			if current.Styles != nil {
+				for _, style := range current.Styles.Style {
+					currentStyles = append(currentStyles, strings.TrimPrefix(style.Name, ws.Name+":"))
+				}
+			}
+
+			if !slices.Equal(styleNames(ws.Name, lg), pad(currentStyles, len(lg.Layers))) {
+				fields = append(fields, "styles")
+			}
+		}
+
+		if len(fields) > 0 {
+			d.groups = append(d.groups, Change{
+				Action: ActionUpdate,
+				Kind:   KindLayerGroup,
+				Path:   path(ws.Name, lg.Name),
+				Fields: fields,
+				apply: func() error {
+					if err := d.groupStyles(ws, lg); err != nil {
+						return err
+					}
+
+					groups := d.gc.Workspace(ws.Name).LayerGroups()
+					current, err := groups.Get(lg.Name)
+					if err != nil {
+						return err
+					}
+
+					current.Title = or(lg.Title, current.Title)
+					if lg.Mode != "" {
+						current.Mode = lg.Mode
+					}
+
+					if slices.Contains(fields, "layers") || slices.Contains(fields, "styles") {
+						current.Publishables = &layers.Publishables{}
+						for _, input := range d.layerInputs(ws, lg) {
+							current.Publishables.Entries = append(current.Publishables.Entries, layers.Entries{Type: string(input.Type), Name: input.Name})
+						}
+
+						// the styles are matched with the layers by position, GeoServer uses the default ones when there are none
+						current.Styles = nil
+						if len(lg.Styles) > 0 {
+							current.Styles = &layers.GroupStyles{}
+							for _, name := range styleNames(ws.Name, lg) {
+								current.Styles.Style = append(current.Styles.Style, shared.Style{Name: name})
+							}
+						}
+					}
+
+					return groups.Update(lg.Name, *current)
+				},
+			})
+		}
+	}
+
+	if d.options.Prune && ws.Name != "" {
+		for _, name := range sortedKeys(live) {
+			d.deletedGroups = append(d.deletedGroups, Change{
+				Action: ActionDelete,
+				Kind:   KindLayerGroup,
+				Path:   path(ws.Name, name),
+				apply: func() error {
+					return d.gc.Workspace(ws.Name).LayerGroups().Delete(name)
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+func (d *differ) createLayerGroup(ws Workspace, lg LayerGroup) {
+	d.groups = append(d.groups, Change{
+		Action: ActionCreate,
+		Kind:   KindLayerGroup,
+		Path:   path(ws.Name, lg.Name),
+		apply: func() error {
+			if err := d.groupStyles(ws, lg); err != nil {
+				return err
+			}
+
+			mode := lg.Mode
+			if mode == "" {
+				mode = layers.ModeSingle
+			}
+
+			var opts []options.LayerGroupOption
+			if lg.Title != "" {
+				opts = append(opts, options.LayerGroup.Title(lg.Title))
+			}
+
+			group := layers.NewGroup(lg.Name, mode, d.layerInputs(ws, lg), opts...)
+
+			// NewGroup skips the empty styles, which would shift the others onto the wrong layers
+			group.Styles = nil
+			if len(lg.Styles) > 0 {
+				group.Styles = &models.GroupStyles{}
+				for _, name := range styleNames(ws.Name, lg) {
+					group.Styles.Style = append(group.Styles.Style, shared.Style{Name: name})
+				}
+			}
+
+			return d.gc.Workspace(ws.Name).LayerGroups().Publish(group)
+		},
+	})
+}
+
+// groupStyles checks that the styles of the layer group exist in the workspace or globally. A style named with another
+// workspace, as in the global groups, is looked up in that workspace only. It runs when the change of the group is
+// applied, once the styles of the manifest are created, so that a missing style only fails that group.
+func (d *differ) groupStyles(ws Workspace, lg LayerGroup) error {
+	for _, name := range styleNames(ws.Name, lg) {
+		if name == "" || d.existingStyles[path(ws.Name, name)] {
+			continue
+		}
+
+		workspace, local, qualified := strings.Cut(name, ":")
+		if !qualified {
+			workspace, local = ws.Name, name
+		}
+
+		_, err := d.gc.Workspace(workspace).Styles().Get(local)
+		if isNotFound(err) && !qualified && workspace != "" {
+			_, err = d.gc.Styles().Get(local)
+		}
+
+		switch {
+		case isNotFound(err):
+			return customerrors.WrapInputError(fmt.Errorf("style %s of layer group %s does not exist", name, path(ws.Name, lg.Name)))
+		case err != nil:
+			return err
+		}
+
+		if d.existingStyles == nil {
+			d.existingStyles = make(map[string]bool)
+		}
+		d.existingStyles[path(ws.Name, name)] = true
+	}
+
+	return nil
+}
+
+// styleNames returns the style of every layer of the group, without the workspace prefix
+func styleNames(workspace string, lg LayerGroup) []string {
+	names := make([]string, len(lg.Styles))
+	for i, name := range lg.Styles {
+		names[i] = strings.TrimPrefix(name, workspace+":")
+	}
+
+	return pad(names, len(lg.Layers))
+}
+
+func pad(values []string, length int) []string {
+	for len(values) < length {
+		values = append(values, "")
+	}
+
+	return values
+}
+
+// layerInputs resolves the layers of the group, nesting the other layer groups of the workspace, or the groups of the
+// manifest named with their workspace, as the global groups do
+func (d *differ) layerInputs(ws Workspace, lg LayerGroup) []layers.LayerInput {
+	inputs := make([]layers.LayerInput, len(lg.Layers))
+	for i, layer := range lg.Layers {
+		name := strings.TrimPrefix(layer, ws.Name+":")
+		inputs[i] = layers.LayerInput{Type: layers.TypeLayer, Name: name}
+
+		groups := ws.LayerGroups
+		if workspace, local, qualified := strings.Cut(name, ":"); qualified {
+			groups = nil
+			for _, other := range d.desired.Workspaces {
+				if other.Name == workspace {
+					groups = other.LayerGroups
+				}
+			}
+			name = local
+		}
+
+		for _, other := range groups {
+			if other.Name == name {
+				inputs[i].Type = layers.TypeLayerGroup
+			}
+		}
+	}
+
+	return inputs
+}
+
+// createCoverageStore creates the store with the constructor of its format, failing for the formats the client
+// cannot create
+func createCoverageStore(list actions.CoverageStoreList, cs CoverageStore) error {
+	switch cs.Type {
+	case formats.GeoTIFF:
+		return list.GeoTIFF(cs.Name, cs.URL)
+	case formats.EHdr:
+		return list.EHdr(cs.Name, cs.URL)
+	case formats.ENVIHdr:
+		return list.ENVIHdr(cs.Name, cs.URL)
+	case formats.ERDASImg:
+		return list.ERDASImg(cs.Name, cs.URL)
+	case formats.NITF:
+		return list.NITF(cs.Name, cs.URL)
+	case formats.RST:
+		return list.RST(cs.Name, cs.URL)
+	case formats.VRT:
+		return list.VRT(cs.Name, cs.URL)
+	default:
+		return customerrors.WrapInputError(fmt.Errorf("unsupported coverage store format %s", cs.Type))
+	}
+}
+
+type field struct {
+	name    string
+	desired string
+	live    string
+}
+
+// differences lists the fields set in the manifest which differ from the live value
+func differences(fields ...field) []string {
+	var names []string
+	for _, f := range fields {
+		if f.desired != "" && f.desired != f.live {
+			names = append(names, f.name)
+		}
+	}
+
+	return names
+}
+
+// isPassword tells whether the connection parameter holds a password, which GeoServer returns encrypted
+func isPassword(key string) bool {
+	return strings.Contains(strings.ToLower(key), "passw")
+}
+
+func isNotFound(err error) bool {
+	var notFound *customerrors.NotFoundError
+	return errors.As(err, &notFound)
+}
+
+// path joins the parts of the path, leaving out the missing workspace of the global styles and groups
+func path(parts ...string) string {
+	if len(parts) > 0 && parts[0] == "" {
+		parts = parts[1:]
+	}
+
+	return strings.Join(parts, "/")
+}
+
+func or(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+
+	return value
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
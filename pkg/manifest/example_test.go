@@ -0,0 +1,182 @@
+package manifest_test
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/canghel3/go-geoserver/pkg/client"
+	"github.com/canghel3/go-geoserver/pkg/formats"
+	"github.com/canghel3/go-geoserver/pkg/manifest"
+	"github.com/canghel3/go-geoserver/pkg/options"
+)
+
+func ExampleDiff() {
+	geoclient := client.NewGeoserverClient("http://localhost:8080", "admin", "geoserver")
+
+	desired := manifest.Manifest{
+		Workspaces: []manifest.Workspace{
+			{
+				Name: "roads",
+				DataStores: []manifest.DataStore{
+					{
+						Name: "postgis",
+						ConnectionParameters: map[string]string{
+							"dbtype":   "postgis",
+							"host":     "db",
+							"port":     "5432",
+							"database": "roads",
+							"user":     "geoserver",
+							"passwd":   "secret",
+						},
+						FeatureTypes: []manifest.FeatureType{{Name: "motorways", Title: "Motorways", SRS: "EPSG:4326"}},
+					},
+				},
+				CoverageStores: []manifest.CoverageStore{
+					{
+						Name:      "dem",
+						Type:      formats.GeoTIFF,
+						URL:       "/data/dem.tif",
+						Coverages: []manifest.Coverage{{Name: "dem"}},
+					},
+				},
+				// the styles must already exist, the default style of dem is kept
+				LayerGroups: []manifest.LayerGroup{{Name: "basemap", Layers: []string{"dem", "motorways"}, Styles: []string{"", "line"}}},
+			},
+		},
+	}
+
+	// the plan can also be computed from a JSON file with manifest.Load
+	plan, err := manifest.Diff(geoclient, desired, options.Manifest.Prune())
+	if err != nil {
+		fmt.Println("Error comparing the manifest:", err)
+		return
+	}
+
+	// dry run
+	fmt.Println(plan)
+
+	err = plan.Apply()
+	if err != nil {
+		fmt.Println("Error applying the plan:", err)
+		return
+	}
+}
+
+func ExampleParse() {
+	m, err := manifest.Parse([]byte(`{
+		"workspaces": [
+			{
+				"name": "roads",
+				"isolated": true,
+				"layerGroups": [{"name": "basemap", "title": "Basemap", "layers": ["roads:motorways"]}]
+			}
+		]
+	}`))
+	if err != nil {
+		fmt.Println("Error parsing the manifest:", err)
+		return
+	}
+
+	fmt.Println(m.Workspaces[0].LayerGroups[0].Title)
+	// Output: Basemap
+}
+
+func ExampleExport() {
+	geoclient := client.NewGeoserverClient("http://localhost:8080", "admin", "geoserver")
+
+	snapshot, err := manifest.Export(geoclient, options.Export.Workspaces("roads"))
+	if err != nil {
+		fmt.Println("Error exporting the catalog:", err)
+		return
+	}
+
+	// the snapshot directory can be committed and later applied to another GeoServer with ReadDir and Diff
+	err = snapshot.WriteDir("snapshot")
+	if err != nil {
+		fmt.Println("Error writing the snapshot:", err)
+		return
+	}
+}
+
+func ExampleManifest_WriteDir() {
+	dir, err := os.MkdirTemp("", "snapshot")
+	if err != nil {
+		fmt.Println("Error creating the directory:", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	m := manifest.Manifest{
+		Workspaces: []manifest.Workspace{
+			{
+				Name: "roads",
+				DataStores: []manifest.DataStore{
+					{
+						Name:                 "shapefiles",
+						ConnectionParameters: map[string]string{"url": "file:data/roads"},
+						FeatureTypes:         []manifest.FeatureType{{Name: "motorways"}},
+					},
+				},
+				LayerGroups: []manifest.LayerGroup{{Name: "basemap", Layers: []string{"motorways"}}},
+			},
+		},
+	}
+
+	err = m.WriteDir(dir)
+	if err != nil {
+		fmt.Println("Error writing the snapshot:", err)
+		return
+	}
+
+	_ = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() {
+			name, _ := filepath.Rel(dir, path)
+			fmt.Println(filepath.ToSlash(name))
+		}
+		return err
+	})
+
+	read, err := manifest.ReadDir(dir)
+	if err != nil {
+		fmt.Println("Error reading the snapshot:", err)
+		return
+	}
+
+	fmt.Println(read.Workspaces[0].DataStores[0].FeatureTypes[0].Name)
+	// Output:
+	// roads/datastores/shapefiles.json
+	// roads/layergroups/basemap.json
+	// roads/workspace.json
+	// motorways
+}
+
+func ExampleMigrate() {
+	staging := client.NewGeoserverClient("http://staging:8080", "admin", "geoserver")
+	production := client.NewGeoserverClient("http://production:8080", "admin", "geoserver")
+
+	migration, err := manifest.Migrate(staging, production, "roads",
+		options.Migrate.Rename("roads_v2"),
+		options.Migrate.RewriteConnectionParameters(func(store string, params map[string]string) {
+			if params["dbtype"] == "postgis" {
+				params["host"] = "production-db"
+				params["passwd"] = os.Getenv("PRODUCTION_DB_PASSWORD")
+			}
+		}),
+		options.Migrate.RewriteURL(func(store, url string) string {
+			return strings.Replace(url, "/staging/", "/production/", 1)
+		}),
+	)
+	if err != nil {
+		fmt.Println("Error migrating the workspace:", err)
+		if migration == nil {
+			return
+		}
+
+		for _, failed := range migration.Report.Failed {
+			fmt.Println("Failed:", failed.Change.Path)
+		}
+	}
+}
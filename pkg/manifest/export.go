@@ -0,0 +1,307 @@
+package manifest
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/pkg/actions"
+	"github.com/canghel3/go-geoserver/pkg/client"
+	"github.com/canghel3/go-geoserver/pkg/formats"
+	"github.com/canghel3/go-geoserver/pkg/options"
+)
+
+// Export walks the live catalog and returns it as a manifest, which Diff reports as matching the catalog.
+// Everything is sorted by name, except the layers of the layer groups which keep their drawing order, so that
+// exporting an unchanged catalog always yields the same manifest.
+//
+// The passwords of the data stores are left out, since GeoServer only returns them encrypted, and so is the
+// namespace connection parameter, which GeoServer derives from the workspace. Only the styles written in SLD 1.0 are
+// exported, and the layer groups left without any layer are not. The global styles and layer groups are exported
+// along with all the workspaces, but not when the export is limited to some of them.
+func Export(gc client.GeoserverClient, opts ...options.ExportOption) (*Manifest, error) {
+	var o models.ExportOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	names := o.Workspaces
+	if len(names) == 0 {
+		all, err := gc.Workspaces().GetAll()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ws := range all {
+			names = append(names, ws.Name)
+		}
+	}
+
+	names = slices.Clone(names)
+	sort.Strings(names)
+	names = slices.Compact(names)
+
+	m := &Manifest{Workspaces: make([]Workspace, 0, len(names)), partial: len(o.Workspaces) > 0}
+	for _, name := range names {
+		ws, err := exportWorkspace(gc, name)
+		if err != nil {
+			return nil, err
+		}
+
+		m.Workspaces = append(m.Workspaces, *ws)
+	}
+
+	if m.partial {
+		return m, nil
+	}
+
+	var err error
+	m.Styles, err = exportStyles(gc.Styles())
+	if err != nil {
+		return nil, err
+	}
+
+	m.LayerGroups, err = exportLayerGroups(gc.LayerGroups(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func exportWorkspace(gc client.GeoserverClient, name string) (*Workspace, error) {
+	live, err := gc.Workspaces().Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ws := &Workspace{Name: name, Isolated: live.Isolated}
+
+	dataStores, err := gc.Workspace(name).DataStores().GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range dataStores.Entries {
+		ds, err := exportDataStore(gc, name, entry.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		ws.DataStores = append(ws.DataStores, *ds)
+	}
+
+	coverageStores, err := gc.Workspace(name).CoverageStores().GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range coverageStores.Entries {
+		cs, err := exportCoverageStore(gc, name, entry.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		ws.CoverageStores = append(ws.CoverageStores, *cs)
+	}
+
+	ws.Styles, err = exportStyles(gc.Workspace(name).Styles())
+	if err != nil {
+		return nil, err
+	}
+
+	ws.LayerGroups, err = exportLayerGroups(gc.Workspace(name).LayerGroups(), name)
+	if err != nil {
+		return nil, err
+	}
+
+	sortByName(ws.DataStores, func(ds DataStore) string { return ds.Name })
+	sortByName(ws.CoverageStores, func(cs CoverageStore) string { return cs.Name })
+
+	return ws, nil
+}
+
+// exportStyles returns the SLD 1.0 styles, the only ones the manifest can create again
+func exportStyles(styles actions.Styles) ([]Style, error) {
+	all, err := styles.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var exported []Style
+	for _, entry := range all.Entries {
+		name := entry.Name
+		if _, local, ok := strings.Cut(name, ":"); ok {
+			name = local
+		}
+
+		style, err := styles.Get(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if style.Format != "sld" || (style.LanguageVersion.Version != "" && style.LanguageVersion.Version != "1.0.0") {
+			continue
+		}
+
+		sld, err := styles.SLD(name)
+		if err != nil {
+			return nil, err
+		}
+
+		exported = append(exported, Style{Name: name, SLD: string(sld)})
+	}
+
+	sortByName(exported, func(style Style) string { return style.Name })
+
+	return exported, nil
+}
+
+// exportLayerGroups returns the layer groups of the workspace, or the global ones when workspace is empty
+func exportLayerGroups(groups actions.LayerGroups, workspace string) ([]LayerGroup, error) {
+	all, err := groups.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var exported []LayerGroup
+	for _, entry := range all.Entries {
+		group, err := groups.Get(entry.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		// a group left without layers, once they were deleted, cannot be created again
+		if group.Publishables == nil || len(group.Publishables.Entries) == 0 {
+			continue
+		}
+
+		lg := LayerGroup{Name: group.Name, Title: group.Title, Mode: group.Mode}
+		for _, publishable := range group.Publishables.Entries {
+			lg.Layers = append(lg.Layers, strings.TrimPrefix(publishable.Name, workspace+":"))
+		}
+
+		if group.Styles != nil {
+			for _, style := range group.Styles.Style {
+				lg.Styles = append(lg.Styles, strings.TrimPrefix(style.Name, workspace+":"))
+			}
+		}
+
+		// the default styles at the end are implied by the layers
+		for len(lg.Styles) > 0 && lg.Styles[len(lg.Styles)-1] == "" {
+			lg.Styles = lg.Styles[:len(lg.Styles)-1]
+		}
+
+		if len(lg.Styles) == 0 {
+			lg.Styles = nil
+		}
+
+		exported = append(exported, lg)
+	}
+
+	sortByName(exported, func(lg LayerGroup) string { return lg.Name })
+
+	return exported, nil
+}
+
+func exportDataStore(gc client.GeoserverClient, workspace, name string) (*DataStore, error) {
+	live, err := gc.Workspace(workspace).DataStores().Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := &DataStore{
+		Name:                 live.Name,
+		Type:                 live.Type,
+		Description:          live.Description,
+		ConnectionParameters: make(map[string]string),
+	}
+
+	for _, entry := range live.ConnectionParameters.Entry {
+		if isPassword(entry.Key) || entry.Key == "namespace" {
+			continue
+		}
+
+		ds.ConnectionParameters[entry.Key] = entry.Value
+	}
+
+	featureTypes := gc.Workspace(workspace).DataStore(name)
+	all, err := featureTypes.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range all.Entries {
+		ft, err := featureTypes.Get(entry.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		ds.FeatureTypes = append(ds.FeatureTypes, FeatureType{
+			Name:       ft.Name,
+			NativeName: nativeName(ft.Name, ft.NativeName),
+			Title:      ft.Title,
+			Abstract:   ft.Abstract,
+			SRS:        ft.Srs,
+		})
+	}
+
+	sortByName(ds.FeatureTypes, func(ft FeatureType) string { return ft.Name })
+
+	return ds, nil
+}
+
+func exportCoverageStore(gc client.GeoserverClient, workspace, name string) (*CoverageStore, error) {
+	live, err := gc.Workspace(workspace).CoverageStores().Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	cs := &CoverageStore{
+		Name:        live.Name,
+		Type:        formats.CoverageStoreFormat(live.Type),
+		URL:         strings.TrimPrefix(live.URL, "file:"),
+		Description: live.Description,
+	}
+
+	coverages := gc.Workspace(workspace).CoverageStore(name)
+	all, err := coverages.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range all.Entries {
+		c, err := coverages.Get(entry.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		cs.Coverages = append(cs.Coverages, Coverage{
+			Name:       c.Name,
+			NativeName: nativeName(c.Name, c.NativeName),
+			Title:      value(c.Title),
+			Abstract:   value(c.Abstract),
+			SRS:        value(c.Srs),
+		})
+	}
+
+	sortByName(cs.Coverages, func(c Coverage) string { return c.Name })
+
+	return cs, nil
+}
+
+// nativeName omits the native name when it defaults to the name, to keep the manifest short
+func nativeName(name, native string) string {
+	if native == name {
+		return ""
+	}
+
+	return native
+}
+
+func sortByName[T any](items []T, name func(T) string) {
+	slices.SortFunc(items, func(a, b T) int {
+		return strings.Compare(name(a), name(b))
+	})
+}
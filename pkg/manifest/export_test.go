@@ -0,0 +1,128 @@
+package manifest_test
+
+import (
+	"testing"
+
+	"github.com/canghel3/go-geoserver/pkg/client"
+	"github.com/canghel3/go-geoserver/pkg/geoservertest"
+	"github.com/canghel3/go-geoserver/pkg/layers"
+	"github.com/canghel3/go-geoserver/pkg/manifest"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport_RoundTrip(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+	gc := server.Client()
+
+	desired := roads()
+	desired.Workspaces[0].Styles = []manifest.Style{{Name: "motorway", SLD: motorwaySLD}}
+	desired.LayerGroups = []manifest.LayerGroup{
+		{Name: "overview", Mode: layers.ModeSingle, Layers: []string{"roads:basemap", "roads:highways"}, Styles: []string{"", "roads:motorway"}},
+	}
+
+	plan, err := manifest.Diff(gc, desired)
+	require.NoError(t, err)
+	require.NoError(t, plan.Apply())
+
+	exported, err := manifest.Export(gc)
+	require.NoError(t, err)
+
+	require.Len(t, exported.Workspaces, 1)
+	require.Len(t, exported.Workspaces[0].LayerGroups, 1)
+	group := exported.Workspaces[0].LayerGroups[0]
+	assert.Equal(t, []string{"dem", "motorways"}, group.Layers)
+	assert.Equal(t, []string{"", "line"}, group.Styles)
+	assert.Equal(t, desired.Workspaces[0].Styles, exported.Workspaces[0].Styles)
+
+	// the global styles and layer groups are exported as well
+	assert.Equal(t, desired.LayerGroups, exported.LayerGroups)
+	assert.Contains(t, exported.Styles, manifest.Style{Name: "line", SLD: sld(t, gc, "line")})
+
+	dir := t.TempDir()
+	require.NoError(t, exported.WriteDir(dir))
+
+	read, err := manifest.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, exported, read)
+
+	// the catalog is exactly what was exported, even the resources Prune would delete
+	plan, err = manifest.Diff(gc, *read, options.Manifest.Prune())
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+}
+
+func TestExport_DefaultStyles(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+	gc := server.Client()
+
+	desired := roads()
+	desired.Workspaces[0].LayerGroups[0].Styles = nil
+
+	plan, err := manifest.Diff(gc, desired)
+	require.NoError(t, err)
+	require.NoError(t, plan.Apply())
+
+	exported, err := manifest.Export(gc, options.Export.Workspaces("roads"))
+	require.NoError(t, err)
+	assert.Empty(t, exported.Workspaces[0].LayerGroups[0].Styles)
+
+	plan, err = manifest.Diff(gc, *exported, options.Manifest.Prune())
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+}
+
+func TestManifest_WriteDir_Partial(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+	gc := server.Client()
+
+	desired := roads()
+	desired.Workspaces = append(desired.Workspaces, manifest.Workspace{Name: "water"})
+	desired.LayerGroups = []manifest.LayerGroup{{Name: "overview", Layers: []string{"roads:motorways"}}}
+
+	plan, err := manifest.Diff(gc, desired)
+	require.NoError(t, err)
+	require.NoError(t, plan.Apply())
+
+	dir := t.TempDir()
+	full, err := manifest.Export(gc)
+	require.NoError(t, err)
+	require.NoError(t, full.WriteDir(dir))
+
+	require.NoError(t, gc.Workspace("roads").LayerGroups().Delete("basemap"))
+
+	partial, err := manifest.Export(gc, options.Export.Workspaces("roads"))
+	require.NoError(t, err)
+	assert.Empty(t, partial.LayerGroups)
+	require.NoError(t, partial.WriteDir(dir))
+
+	// only the exported workspace is replaced, the other one and the global resources are kept
+	read, err := manifest.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, read.Workspaces, 2)
+	assert.Empty(t, read.Workspaces[0].LayerGroups)
+	assert.Equal(t, "water", read.Workspaces[1].Name)
+	assert.Equal(t, full.LayerGroups, read.LayerGroups)
+	assert.Equal(t, full.Styles, read.Styles)
+
+	// while a full export removes what is gone
+	require.NoError(t, gc.Workspaces().Delete("water", true))
+	full, err = manifest.Export(gc)
+	require.NoError(t, err)
+	require.NoError(t, full.WriteDir(dir))
+
+	read, err = manifest.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, read.Workspaces, 1)
+}
+
+func sld(t *testing.T, gc client.GeoserverClient, name string) string {
+	content, err := gc.Styles().SLD(name)
+	require.NoError(t, err)
+
+	return string(content)
+}
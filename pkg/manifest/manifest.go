@@ -0,0 +1,292 @@
+// Package manifest describes the desired state of the catalog (workspaces, styles, stores, feature types, coverages
+// and layer groups) and reconciles a GeoServer with it, similar to terraform plan and apply.
+//
+// A manifest is written in JSON or YAML, or built from Go structs. Layer groups reference the styles of the manifest,
+// or styles which already exist in their workspace or globally, by name.
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/canghel3/go-geoserver/internal/validator"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/formats"
+	"github.com/canghel3/go-geoserver/pkg/layers"
+	"gopkg.in/yaml.v3"
+)
+
+type Manifest struct {
+	Workspaces []Workspace `json:"workspaces" yaml:"workspaces"`
+	// Styles and LayerGroups hold the global styles and layer groups, which do not belong to a workspace.
+	// The layers of a global layer group are named with their workspace, as <workspace>:<layer>.
+	Styles      []Style      `json:"styles,omitempty" yaml:"styles,omitempty"`
+	LayerGroups []LayerGroup `json:"layerGroups,omitempty" yaml:"layerGroups,omitempty"`
+
+	// partial is set by Export when only some workspaces are exported, so that WriteDir leaves the others alone
+	partial bool
+}
+
+type Workspace struct {
+	Name           string          `json:"name" yaml:"name"`
+	Isolated       bool            `json:"isolated,omitempty" yaml:"isolated,omitempty"`
+	DataStores     []DataStore     `json:"dataStores,omitempty" yaml:"dataStores,omitempty"`
+	CoverageStores []CoverageStore `json:"coverageStores,omitempty" yaml:"coverageStores,omitempty"`
+	Styles         []Style         `json:"styles,omitempty" yaml:"styles,omitempty"`
+	LayerGroups    []LayerGroup    `json:"layerGroups,omitempty" yaml:"layerGroups,omitempty"`
+}
+
+// Style is written as an SLD 1.0 document, which is compared with the live one without its surrounding whitespace.
+type Style struct {
+	Name string `json:"name" yaml:"name"`
+	SLD  string `json:"sld" yaml:"sld"`
+}
+
+// DataStore is created from its raw connection parameters, as expected by the datastore factory of its type.
+// Only the listed connection parameters are compared with the live store, and passwords are never compared
+// because GeoServer returns them encrypted.
+type DataStore struct {
+	Name                 string            `json:"name" yaml:"name"`
+	Type                 string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Description          string            `json:"description,omitempty" yaml:"description,omitempty"`
+	ConnectionParameters map[string]string `json:"connectionParameters" yaml:"connectionParameters"`
+	FeatureTypes         []FeatureType     `json:"featureTypes,omitempty" yaml:"featureTypes,omitempty"`
+}
+
+// FeatureType is published from the native table or file NativeName of its store, which defaults to Name.
+// Empty fields are left to GeoServer and not compared.
+type FeatureType struct {
+	Name       string `json:"name" yaml:"name"`
+	NativeName string `json:"nativeName,omitempty" yaml:"nativeName,omitempty"`
+	Title      string `json:"title,omitempty" yaml:"title,omitempty"`
+	Abstract   string `json:"abstract,omitempty" yaml:"abstract,omitempty"`
+	SRS        string `json:"srs,omitempty" yaml:"srs,omitempty"`
+}
+
+// CoverageStore points to a raster file on the GeoServer host. Only the formats supported by the client can be created,
+// stores of other formats (e.g. exported mosaics) must already exist.
+type CoverageStore struct {
+	Name        string                      `json:"name" yaml:"name"`
+	Type        formats.CoverageStoreFormat `json:"type" yaml:"type"`
+	URL         string                      `json:"url" yaml:"url"`
+	Description string                      `json:"description,omitempty" yaml:"description,omitempty"`
+	Coverages   []Coverage                  `json:"coverages,omitempty" yaml:"coverages,omitempty"`
+}
+
+// Coverage is published from the native coverage NativeName of its store, which defaults to Name.
+// Empty fields are left to GeoServer and not compared.
+type Coverage struct {
+	Name       string `json:"name" yaml:"name"`
+	NativeName string `json:"nativeName,omitempty" yaml:"nativeName,omitempty"`
+	Title      string `json:"title,omitempty" yaml:"title,omitempty"`
+	Abstract   string `json:"abstract,omitempty" yaml:"abstract,omitempty"`
+	SRS        string `json:"srs,omitempty" yaml:"srs,omitempty"`
+}
+
+// LayerGroup lists its layers in drawing order, by name, either plain or prefixed with the workspace.
+// A name matching another layer group of the workspace is added as a nested group.
+// Styles names the style of each layer by position, from the workspace or the global styles, an empty name keeping
+// the default style of the layer. The styles are left to GeoServer and not compared when Styles is empty.
+type LayerGroup struct {
+	Name   string           `json:"name" yaml:"name"`
+	Title  string           `json:"title,omitempty" yaml:"title,omitempty"`
+	Mode   layers.GroupMode `json:"mode,omitempty" yaml:"mode,omitempty"`
+	Layers []string         `json:"layers" yaml:"layers"`
+	Styles []string         `json:"styles,omitempty" yaml:"styles,omitempty"`
+}
+
+// Parse decodes a JSON manifest, rejecting unknown fields so that typos do not go unnoticed.
+func Parse(data []byte) (*Manifest, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var m Manifest
+	if err := decoder.Decode(&m); err != nil {
+		return nil, customerrors.WrapInputError(fmt.Errorf("invalid manifest: %w", err))
+	}
+
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// ParseYAML decodes a YAML manifest, rejecting unknown fields like Parse.
+func ParseYAML(data []byte) (*Manifest, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var m Manifest
+	if err := decoder.Decode(&m); err != nil {
+		return nil, customerrors.WrapInputError(fmt.Errorf("invalid manifest: %w", err))
+	}
+
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Load reads and parses the manifest at path, as YAML when its extension is .yaml or .yml and as JSON otherwise.
+func Load(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ParseYAML(data)
+	default:
+		return Parse(data)
+	}
+}
+
+// Validate checks the names of the resources and styles and that none of them is declared twice.
+func (m Manifest) Validate() error {
+	workspaces := make(map[string]bool)
+	for _, ws := range m.Workspaces {
+		if err := validator.Name(ws.Name); err != nil {
+			return err
+		}
+
+		if workspaces[ws.Name] {
+			return customerrors.WrapInputError(fmt.Errorf("workspace %s declared twice", ws.Name))
+		}
+		workspaces[ws.Name] = true
+
+		stores := make(map[string]bool)
+		resources := make(map[string]bool)
+		for _, ds := range ws.DataStores {
+			if err := unique(stores, ws.Name, ds.Name, "store"); err != nil {
+				return err
+			}
+
+			if len(ds.ConnectionParameters) == 0 {
+				return customerrors.WrapInputError(fmt.Errorf("data store %s:%s has no connection parameters", ws.Name, ds.Name))
+			}
+
+			for _, ft := range ds.FeatureTypes {
+				if err := unique(resources, ws.Name, ft.Name, "layer"); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, cs := range ws.CoverageStores {
+			if err := unique(stores, ws.Name, cs.Name, "store"); err != nil {
+				return err
+			}
+
+			if cs.Type == "" {
+				return customerrors.WrapInputError(fmt.Errorf("coverage store %s:%s has no type", ws.Name, cs.Name))
+			}
+
+			for _, c := range cs.Coverages {
+				if err := unique(resources, ws.Name, c.Name, "layer"); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := validateStyles(ws.Name, ws.Styles); err != nil {
+			return err
+		}
+
+		if err := validateGroups(ws.Name, ws.LayerGroups); err != nil {
+			return err
+		}
+	}
+
+	if err := validateStyles("", m.Styles); err != nil {
+		return err
+	}
+
+	if err := validateGroups("", m.LayerGroups); err != nil {
+		return err
+	}
+
+	// without a workspace to resolve them in, the layers of the global groups are qualified, except for the other
+	// global groups
+	for _, lg := range m.LayerGroups {
+		for _, layer := range lg.Layers {
+			if slices.ContainsFunc(m.LayerGroups, func(other LayerGroup) bool { return other.Name == layer }) {
+				continue
+			}
+
+			if err := validator.WorkspaceLayerFormat("", layer); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateStyles(workspace string, styles []Style) error {
+	seen := make(map[string]bool)
+	for _, style := range styles {
+		if err := validator.Style.Name(style.Name); err != nil {
+			return err
+		}
+
+		if seen[style.Name] {
+			return customerrors.WrapInputError(fmt.Errorf("style %s declared twice", qualify(workspace, style.Name)))
+		}
+		seen[style.Name] = true
+
+		if strings.TrimSpace(style.SLD) == "" {
+			return customerrors.WrapInputError(fmt.Errorf("style %s has no sld", qualify(workspace, style.Name)))
+		}
+	}
+
+	return nil
+}
+
+func validateGroups(workspace string, groups []LayerGroup) error {
+	seen := make(map[string]bool)
+	for _, lg := range groups {
+		if err := unique(seen, workspace, lg.Name, "layer group"); err != nil {
+			return err
+		}
+
+		if len(lg.Layers) == 0 {
+			return customerrors.WrapInputError(fmt.Errorf("layer group %s has no layers", qualify(workspace, lg.Name)))
+		}
+
+		if len(lg.Styles) > len(lg.Layers) {
+			return customerrors.WrapInputError(fmt.Errorf("layer group %s has more styles than layers", qualify(workspace, lg.Name)))
+		}
+	}
+
+	return nil
+}
+
+func unique(seen map[string]bool, workspace, name, kind string) error {
+	if err := validator.Name(name); err != nil {
+		return err
+	}
+
+	if seen[name] {
+		return customerrors.WrapInputError(fmt.Errorf("%s %s declared twice", kind, qualify(workspace, name)))
+	}
+	seen[name] = true
+
+	return nil
+}
+
+// qualify prefixes the name with its workspace, global names having none
+func qualify(workspace, name string) string {
+	if workspace == "" {
+		return name
+	}
+
+	return workspace + ":" + name
+}
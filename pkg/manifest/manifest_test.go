@@ -0,0 +1,504 @@
+package manifest_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/canghel3/go-geoserver/pkg/coverages"
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+	"github.com/canghel3/go-geoserver/pkg/formats"
+	"github.com/canghel3/go-geoserver/pkg/geoservertest"
+	"github.com/canghel3/go-geoserver/pkg/manifest"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roads is the manifest the tests start from
+func roads() manifest.Manifest {
+	return manifest.Manifest{
+		Workspaces: []manifest.Workspace{
+			{
+				Name: "roads",
+				DataStores: []manifest.DataStore{
+					{
+						Name:                 "postgis",
+						Type:                 "PostGIS",
+						ConnectionParameters: map[string]string{"dbtype": "postgis", "host": "db", "passwd": "secret"},
+						FeatureTypes: []manifest.FeatureType{
+							{Name: "motorways", Title: "Motorways", SRS: "EPSG:4326"},
+							{Name: "highways"},
+						},
+					},
+				},
+				CoverageStores: []manifest.CoverageStore{
+					{
+						Name:      "dem",
+						Type:      formats.GeoTIFF,
+						URL:       "file:/data/dem.tif",
+						Coverages: []manifest.Coverage{{Name: "dem", SRS: "EPSG:3857"}},
+					},
+				},
+				LayerGroups: []manifest.LayerGroup{
+					{Name: "basemap", Title: "Basemap", Layers: []string{"dem", "motorways"}, Styles: []string{"", "line"}},
+				},
+			},
+		},
+	}
+}
+
+const motorwaySLD = `<?xml version="1.0" encoding="UTF-8"?>
+<StyledLayerDescriptor version="1.0.0" xmlns="http://www.opengis.net/sld">
+  <NamedLayer>
+    <Name>motorway</Name>
+    <UserStyle>
+      <FeatureTypeStyle>
+        <Rule>
+          <LineSymbolizer>
+            <Stroke>
+              <CssParameter name="stroke">#FF0000</CssParameter>
+            </Stroke>
+          </LineSymbolizer>
+        </Rule>
+      </FeatureTypeStyle>
+    </UserStyle>
+  </NamedLayer>
+</StyledLayerDescriptor>`
+
+func paths(plan *manifest.Plan) []string {
+	changes := make([]string, len(plan.Changes))
+	for i, change := range plan.Changes {
+		changes[i] = change.String()
+	}
+
+	return changes
+}
+
+func TestDiff_Create(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+	gc := server.Client()
+
+	plan, err := manifest.Diff(gc, roads())
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"+ workspace roads",
+		"+ datastore roads/postgis",
+		"+ coveragestore roads/dem",
+		"+ featuretype roads/postgis/motorways",
+		"+ featuretype roads/postgis/highways",
+		"+ coverage roads/dem/dem",
+		"+ layergroup roads/basemap",
+	}, paths(plan))
+
+	require.NoError(t, plan.Apply())
+
+	group, err := gc.Workspace("roads").LayerGroups().Get("basemap")
+	require.NoError(t, err)
+	require.Len(t, group.Styles.Style, 2)
+	assert.Equal(t, "", group.Styles.Style[0].Name)
+	assert.Equal(t, "line", group.Styles.Style[1].Name)
+
+	// applying the plan again is not needed, the catalog matches the manifest
+	plan, err = manifest.Diff(gc, roads(), options.Manifest.Prune())
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+}
+
+func TestDiff_Update(t *testing.T) {
+	tests := []struct {
+		name     string
+		change   func(m *manifest.Manifest)
+		expected []string
+	}{
+		{
+			name: "Title",
+			change: func(m *manifest.Manifest) {
+				m.Workspaces[0].DataStores[0].FeatureTypes[0].Title = "Major Roads"
+			},
+			expected: []string{"~ featuretype roads/postgis/motorways (title)"},
+		},
+		{
+			name: "SRS",
+			change: func(m *manifest.Manifest) {
+				m.Workspaces[0].CoverageStores[0].Coverages[0].SRS = "EPSG:4326"
+			},
+			expected: []string{"~ coverage roads/dem/dem (srs)"},
+		},
+		{
+			name: "Connection Parameters",
+			change: func(m *manifest.Manifest) {
+				m.Workspaces[0].DataStores[0].ConnectionParameters["host"] = "replica"
+				m.Workspaces[0].DataStores[0].ConnectionParameters["passwd"] = "changed"
+			},
+			expected: []string{"~ datastore roads/postgis (host)"},
+		},
+		{
+			name: "Layers",
+			change: func(m *manifest.Manifest) {
+				m.Workspaces[0].LayerGroups[0].Layers = []string{"dem", "highways", "roads:motorways"}
+				m.Workspaces[0].LayerGroups[0].Styles = nil
+			},
+			expected: []string{"~ layergroup roads/basemap (layers)"},
+		},
+		{
+			name: "Styles",
+			change: func(m *manifest.Manifest) {
+				m.Workspaces[0].LayerGroups[0].Styles = []string{"raster", "roads:line"}
+			},
+			expected: []string{"~ layergroup roads/basemap (styles)"},
+		},
+		{
+			name: "Several Fields",
+			change: func(m *manifest.Manifest) {
+				m.Workspaces[0].LayerGroups[0].Title = "Roads"
+				m.Workspaces[0].LayerGroups[0].Layers = []string{"motorways"}
+				m.Workspaces[0].LayerGroups[0].Styles = []string{"line"}
+			},
+			expected: []string{"~ layergroup roads/basemap (title, layers, styles)"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := geoservertest.NewServer()
+			defer server.Close()
+			gc := server.Client()
+
+			plan, err := manifest.Diff(gc, roads())
+			require.NoError(t, err)
+			require.NoError(t, plan.Apply())
+
+			desired := roads()
+			test.change(&desired)
+
+			plan, err = manifest.Diff(gc, desired)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, paths(plan))
+
+			require.NoError(t, plan.Apply())
+
+			plan, err = manifest.Diff(gc, desired)
+			require.NoError(t, err)
+			assert.True(t, plan.Empty(), plan.String())
+		})
+	}
+}
+
+func TestDiff_UpdateDisabledCoverage(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+	gc := server.Client()
+
+	desired := roads()
+	desired.Workspaces[0].CoverageStores[0].Coverages = nil
+	desired.Workspaces[0].LayerGroups = nil
+
+	plan, err := manifest.Diff(gc, desired)
+	require.NoError(t, err)
+	require.NoError(t, plan.Apply())
+
+	store := gc.Workspace("roads").CoverageStore("dem")
+	require.NoError(t, store.Publish(coverages.New("dem", "dem", options.Coverage.Enabled(false))))
+
+	desired = roads()
+	desired.Workspaces[0].LayerGroups = nil
+
+	plan, err = manifest.Diff(gc, desired)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"~ coverage roads/dem/dem (srs)"}, paths(plan))
+	require.NoError(t, plan.Apply())
+
+	// the coverage is updated without being enabled again
+	coverage, err := store.Get("dem")
+	require.NoError(t, err)
+	assert.Equal(t, "EPSG:3857", *coverage.Srs)
+	assert.False(t, coverage.Enabled)
+
+	// and so is a coverage updated through the client
+	require.NoError(t, store.Update("dem", coverages.New("dem", "dem", options.Coverage.Title("DEM"))))
+	coverage, err = store.Get("dem")
+	require.NoError(t, err)
+	assert.False(t, coverage.Enabled)
+
+	require.NoError(t, store.Update("dem", coverages.New("dem", "dem", options.Coverage.Enabled(true))))
+	coverage, err = store.Get("dem")
+	require.NoError(t, err)
+	assert.True(t, coverage.Enabled)
+}
+
+func TestDiff_EmptyDescription(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+	gc := server.Client()
+
+	described := roads()
+	described.Workspaces[0].DataStores[0].Description = "Road network"
+	described.Workspaces[0].CoverageStores[0].Description = "Elevation"
+
+	plan, err := manifest.Diff(gc, described)
+	require.NoError(t, err)
+	require.NoError(t, plan.Apply())
+
+	// an empty description is left to GeoServer, like the other empty fields
+	plan, err = manifest.Diff(gc, roads())
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+
+	described.Workspaces[0].DataStores[0].Description = "Roads"
+	plan, err = manifest.Diff(gc, described)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"~ datastore roads/postgis (description)"}, paths(plan))
+	require.NoError(t, plan.Apply())
+
+	store, err := gc.Workspace("roads").DataStores().Get("postgis")
+	require.NoError(t, err)
+	assert.Equal(t, "Roads", store.Description)
+}
+
+func TestDiff_Styles(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+	gc := server.Client()
+
+	desired := roads()
+	desired.Workspaces[0].Styles = []manifest.Style{{Name: "motorway", SLD: motorwaySLD}}
+	desired.Workspaces[0].LayerGroups[0].Styles = []string{"", "motorway"}
+
+	plan, err := manifest.Diff(gc, desired)
+	require.NoError(t, err)
+	assert.Equal(t, "+ style roads/motorway", paths(plan)[1], "the styles are created before the groups using them")
+	require.NoError(t, plan.Apply())
+
+	sld, err := gc.Workspace("roads").Styles().SLD("motorway")
+	require.NoError(t, err)
+	assert.Equal(t, motorwaySLD, string(sld))
+
+	plan, err = manifest.Diff(gc, desired, options.Manifest.Prune())
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+
+	desired.Workspaces[0].Styles[0].SLD = strings.Replace(motorwaySLD, "#FF0000", "#0000FF", 1)
+	plan, err = manifest.Diff(gc, desired)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"~ style roads/motorway (sld)"}, paths(plan))
+	require.NoError(t, plan.Apply())
+
+	sld, err = gc.Workspace("roads").Styles().SLD("motorway")
+	require.NoError(t, err)
+	assert.Contains(t, string(sld), "#0000FF")
+
+	// the style is deleted after the group using it
+	desired.Workspaces[0].Styles = nil
+	desired.Workspaces[0].LayerGroups = nil
+	plan, err = manifest.Diff(gc, desired, options.Manifest.Prune())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"- layergroup roads/basemap", "- style roads/motorway"}, paths(plan))
+	require.NoError(t, plan.Apply())
+
+	_, err = gc.Workspace("roads").Styles().Get("motorway")
+	assert.IsType(t, &customerrors.NotFoundError{}, err)
+}
+
+func TestDiff_Prune(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+	gc := server.Client()
+
+	plan, err := manifest.Diff(gc, roads())
+	require.NoError(t, err)
+	require.NoError(t, plan.Apply())
+
+	desired := manifest.Manifest{Workspaces: []manifest.Workspace{{Name: "roads"}}}
+
+	plan, err = manifest.Diff(gc, desired)
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), "nothing is deleted without Prune")
+
+	plan, err = manifest.Diff(gc, desired, options.Manifest.Prune())
+	require.NoError(t, err)
+
+	// the layer groups go first since they reference the layers, the stores last since they hold the resources
+	assert.Equal(t, []string{
+		"- layergroup roads/basemap",
+		"- datastore roads/postgis",
+		"- coveragestore roads/dem",
+	}, paths(plan))
+
+	require.NoError(t, plan.Apply())
+
+	plan, err = manifest.Diff(gc, desired, options.Manifest.Prune())
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+
+	// the resources of a store that is kept are deleted before their store would be
+	desired = roads()
+	plan, err = manifest.Diff(gc, desired)
+	require.NoError(t, err)
+	require.NoError(t, plan.Apply())
+
+	desired.Workspaces[0].DataStores[0].FeatureTypes = desired.Workspaces[0].DataStores[0].FeatureTypes[1:]
+	desired.Workspaces[0].CoverageStores = nil
+	desired.Workspaces[0].LayerGroups = nil
+
+	plan, err = manifest.Diff(gc, desired, options.Manifest.Prune())
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"- layergroup roads/basemap",
+		"- featuretype roads/postgis/motorways",
+		"- coveragestore roads/dem",
+	}, paths(plan))
+}
+
+func TestPlan_ApplyAll(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+	gc := server.Client()
+
+	server.Inject(geoservertest.Failure{Method: http.MethodPost, Path: "/workspaces/roads/datastores"})
+
+	plan, err := manifest.Diff(gc, roads())
+	require.NoError(t, err)
+
+	report := plan.ApplyAll()
+	assert.Error(t, report.Err())
+
+	var failed []string
+	for _, applyError := range report.Failed {
+		failed = append(failed, applyError.Change.String())
+	}
+
+	var skipped []string
+	for _, change := range report.Skipped {
+		skipped = append(skipped, change.String())
+	}
+
+	// the group fails on its own since one of its layers is missing
+	assert.Equal(t, []string{"+ datastore roads/postgis", "+ layergroup roads/basemap"}, failed)
+	assert.Equal(t, []string{"+ featuretype roads/postgis/motorways", "+ featuretype roads/postgis/highways"}, skipped)
+	assert.Len(t, report.Applied, 3)
+
+	server.ClearFailures()
+
+	plan, err = manifest.Diff(gc, roads())
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"+ datastore roads/postgis",
+		"+ featuretype roads/postgis/motorways",
+		"+ featuretype roads/postgis/highways",
+		"+ layergroup roads/basemap",
+	}, paths(plan))
+	assert.NoError(t, plan.ApplyAll().Err())
+}
+
+func TestPlan_ApplyAll_MissingStyle(t *testing.T) {
+	server := geoservertest.NewServer()
+	defer server.Close()
+	gc := server.Client()
+
+	desired := roads()
+	desired.Workspaces[0].LayerGroups[0].Styles = []string{"contours"}
+
+	plan, err := manifest.Diff(gc, desired)
+	require.NoError(t, err)
+
+	// the missing style only fails the group using it
+	report := plan.ApplyAll()
+	require.Len(t, report.Failed, 1)
+	assert.Equal(t, "+ layergroup roads/basemap", report.Failed[0].Change.String())
+	assert.IsType(t, &customerrors.InputError{}, report.Failed[0].Err)
+	assert.EqualError(t, report.Failed[0].Err, "style contours of layer group roads/basemap does not exist")
+	assert.Len(t, report.Applied, len(plan.Changes)-1)
+}
+
+func TestDiff_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(m *manifest.Manifest)
+		err    string
+	}{
+		{
+			name: "Empty Style",
+			change: func(m *manifest.Manifest) {
+				m.Workspaces[0].Styles = []manifest.Style{{Name: "motorway", SLD: " "}}
+			},
+			err: "style roads:motorway has no sld",
+		},
+		{
+			name: "More Styles Than Layers",
+			change: func(m *manifest.Manifest) {
+				m.Workspaces[0].LayerGroups[0].Styles = []string{"raster", "line", "point"}
+			},
+			err: "layer group roads:basemap has more styles than layers",
+		},
+		{
+			name: "Duplicate Layer",
+			change: func(m *manifest.Manifest) {
+				m.Workspaces[0].CoverageStores[0].Coverages[0].Name = "motorways"
+			},
+			err: "layer roads:motorways declared twice",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := geoservertest.NewServer()
+			defer server.Close()
+
+			desired := roads()
+			test.change(&desired)
+
+			plan, err := manifest.Diff(server.Client(), desired)
+			assert.Nil(t, plan)
+			assert.IsType(t, &customerrors.InputError{}, err)
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestLoad_YAML(t *testing.T) {
+	content := `
+workspaces:
+  - name: roads
+    dataStores:
+      - name: postgis
+        type: PostGIS
+        connectionParameters:
+          dbtype: postgis
+          host: db
+          passwd: secret
+        featureTypes:
+          - name: motorways
+            title: Motorways
+            srs: EPSG:4326
+          - name: highways
+    coverageStores:
+      - name: dem
+        type: GeoTIFF
+        url: file:/data/dem.tif
+        coverages:
+          - name: dem
+            srs: EPSG:3857
+    layerGroups:
+      - name: basemap
+        title: Basemap
+        layers: [dem, motorways]
+        styles: ["", line]
+`
+
+	dir := t.TempDir()
+	for _, name := range []string{"manifest.yaml", "manifest.yml"} {
+		file := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+
+		m, err := manifest.Load(file)
+		require.NoError(t, err)
+		assert.Equal(t, roads(), *m)
+	}
+
+	_, err := manifest.ParseYAML([]byte("workspaces:\n  - name: roads\n    isolate: true\n"))
+	assert.IsType(t, &customerrors.InputError{}, err)
+}
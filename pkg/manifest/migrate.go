@@ -0,0 +1,68 @@
+package manifest
+
+import (
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/pkg/client"
+	"github.com/canghel3/go-geoserver/pkg/options"
+)
+
+// Migration is the outcome of Migrate.
+type Migration struct {
+	// Plan holds the changes made, or to be made for a dry run, on the target
+	Plan *Plan
+	// Report is empty for a dry run
+	Report Report
+}
+
+// Migrate recreates a workspace of src on dst: its styles, stores, feature types, coverages and layer groups. It
+// exports the workspace, applies the rename and rewrite options and reconciles dst with the result, so migrating again
+// only applies what changed since. Resources of dst that are not on src are kept.
+//
+// The export leaves the passwords of the data stores out, since GeoServer only returns them encrypted. The stores are
+// therefore created on dst without their password, unless the RewriteConnectionParameters hook adds it back.
+//
+// Every change is attempted even if some fail; the failed ones are listed in the report of the returned migration
+// and joined in the returned error. Coverage stores of a format the client cannot create, such as mosaics, fail that
+// way too, and their coverages are skipped. The global styles are not migrated, a layer group using one which does not
+// exist on dst fails on its own.
+func Migrate(src, dst client.GeoserverClient, workspace string, opts ...options.MigrateOption) (*Migration, error) {
+	var o models.MigrateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	exported, err := Export(src, options.Export.Workspaces(workspace))
+	if err != nil {
+		return nil, err
+	}
+
+	ws := exported.Workspaces[0]
+	if o.Name != "" {
+		ws.Name = o.Name
+	}
+
+	for i := range ws.DataStores {
+		if o.ConnectionParameters != nil {
+			o.ConnectionParameters(ws.DataStores[i].Name, ws.DataStores[i].ConnectionParameters)
+		}
+	}
+
+	for i := range ws.CoverageStores {
+		if o.URL != nil {
+			ws.CoverageStores[i].URL = o.URL(ws.CoverageStores[i].Name, ws.CoverageStores[i].URL)
+		}
+	}
+
+	plan, err := Diff(dst, Manifest{Workspaces: []Workspace{ws}})
+	if err != nil {
+		return nil, err
+	}
+
+	migration := &Migration{Plan: plan}
+	if o.DryRun {
+		return migration, nil
+	}
+
+	migration.Report = plan.ApplyAll()
+	return migration, migration.Report.Err()
+}
@@ -0,0 +1,223 @@
+package manifest_test
+
+import (
+	"encoding/base64"
+	"maps"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/canghel3/go-geoserver/pkg/coverages"
+	"github.com/canghel3/go-geoserver/pkg/geoservertest"
+	"github.com/canghel3/go-geoserver/pkg/manifest"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staging fills the server with the roads manifest, its stores pointing to the staging database and directory
+func staging(t *testing.T) *geoservertest.Server {
+	t.Helper()
+
+	server := geoservertest.NewServer()
+	t.Cleanup(server.Close)
+
+	desired := roads()
+	desired.Workspaces[0].DataStores[0].ConnectionParameters["host"] = "staging-db"
+	desired.Workspaces[0].CoverageStores[0].URL = "file:/staging/dem.tif"
+
+	plan, err := manifest.Diff(server.Client(), desired)
+	require.NoError(t, err)
+	require.NoError(t, plan.Apply())
+
+	return server
+}
+
+func migrateOptions() []options.MigrateOption {
+	return []options.MigrateOption{
+		options.Migrate.Rename("roads_v2"),
+		options.Migrate.RewriteConnectionParameters(func(store string, params map[string]string) {
+			params["host"] = "production-db"
+			params["passwd"] = "secret"
+		}),
+		options.Migrate.RewriteURL(func(store, url string) string {
+			return strings.Replace(url, "/staging/", "/production/", 1)
+		}),
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	src := staging(t).Client()
+
+	production := geoservertest.NewServer()
+	defer production.Close()
+	dst := production.Client()
+
+	migration, err := manifest.Migrate(src, dst, "roads", migrateOptions()...)
+	require.NoError(t, err)
+	assert.Len(t, migration.Report.Applied, len(migration.Plan.Changes))
+
+	_, err = dst.Workspaces().Get("roads")
+	assert.Error(t, err, "the workspace is only created under its new name")
+
+	ws := dst.Workspace("roads_v2")
+
+	store, err := ws.DataStores().Get("postgis")
+	require.NoError(t, err)
+	host, _ := store.ConnectionParameters.Get("host")
+	assert.Equal(t, "production-db", host)
+
+	featureType, err := ws.DataStore("postgis").Get("motorways")
+	require.NoError(t, err)
+	assert.Equal(t, "Motorways", featureType.Title)
+	assert.Equal(t, "EPSG:4326", featureType.Srs)
+
+	coverageStore, err := ws.CoverageStores().Get("dem")
+	require.NoError(t, err)
+	assert.Equal(t, "file:/production/dem.tif", coverageStore.URL)
+
+	_, err = ws.CoverageStore("dem").Get("dem")
+	require.NoError(t, err)
+
+	group, err := ws.LayerGroups().Get("basemap")
+	require.NoError(t, err)
+	require.Len(t, group.Publishables.Entries, 2)
+	assert.Equal(t, "roads_v2:dem", group.Publishables.Entries[0].Name)
+	assert.Equal(t, "roads_v2:motorways", group.Publishables.Entries[1].Name)
+	require.Len(t, group.Styles.Style, 2)
+	assert.Equal(t, "line", group.Styles.Style[1].Name)
+
+	// nothing changed on the source, migrating again has nothing to do
+	migration, err = manifest.Migrate(src, dst, "roads", migrateOptions()...)
+	require.NoError(t, err)
+	assert.True(t, migration.Plan.Empty(), migration.Plan.String())
+}
+
+func TestMigrate_DryRun(t *testing.T) {
+	src := staging(t).Client()
+
+	production := geoservertest.NewServer()
+	defer production.Close()
+
+	migration, err := manifest.Migrate(src, production.Client(), "roads", append(migrateOptions(), options.Migrate.DryRun())...)
+	require.NoError(t, err)
+	assert.Len(t, migration.Plan.Changes, 7)
+	assert.Empty(t, migration.Report.Applied)
+
+	_, err = production.Client().Workspaces().Get("roads_v2")
+	assert.Error(t, err)
+}
+
+func TestMigrate_UnsupportedFormat(t *testing.T) {
+	server := staging(t)
+	src := server.Client()
+
+	// the client cannot create mosaics, the store is posted as GeoServer would have created it
+	request, err := http.NewRequest(http.MethodPost, server.URL+"/geoserver/rest/workspaces/roads/coveragestores", strings.NewReader(`{"coverageStore":{"name":"mosaic","type":"ImageMosaic","url":"file:/staging/mosaic"}}`))
+	require.NoError(t, err)
+	request.SetBasicAuth(geoservertest.Username, geoservertest.Password)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	response.Body.Close()
+	require.Equal(t, http.StatusCreated, response.StatusCode)
+
+	require.NoError(t, src.Workspace("roads").CoverageStore("mosaic").Publish(coverages.New("mosaic", "mosaic")))
+
+	production := geoservertest.NewServer()
+	defer production.Close()
+	dst := production.Client()
+
+	migration, err := manifest.Migrate(src, dst, "roads", migrateOptions()...)
+	require.Error(t, err)
+	require.NotNil(t, migration)
+
+	require.Len(t, migration.Report.Failed, 1)
+	assert.Equal(t, "+ coveragestore roads_v2/mosaic", migration.Report.Failed[0].Change.String())
+	assert.ErrorContains(t, migration.Report.Failed[0], "unsupported coverage store format ImageMosaic")
+
+	require.Len(t, migration.Report.Skipped, 1)
+	assert.Equal(t, "+ coverage roads_v2/mosaic/mosaic", migration.Report.Skipped[0].String())
+
+	// the rest of the workspace is migrated
+	_, err = dst.Workspace("roads_v2").LayerGroups().Get("basemap")
+	assert.NoError(t, err)
+}
+
+func TestMigrate_Styles(t *testing.T) {
+	src := staging(t).Client()
+
+	require.NoError(t, src.Styles().Create("relief", []byte(motorwaySLD)))
+
+	desired := roads()
+	desired.Workspaces[0].DataStores[0].ConnectionParameters["host"] = "staging-db"
+	desired.Workspaces[0].CoverageStores[0].URL = "file:/staging/dem.tif"
+	desired.Workspaces[0].Styles = []manifest.Style{{Name: "motorway", SLD: motorwaySLD}}
+	desired.Workspaces[0].LayerGroups[0].Styles = []string{"", "motorway"}
+	desired.Workspaces[0].LayerGroups = append(desired.Workspaces[0].LayerGroups, manifest.LayerGroup{Name: "terrain", Layers: []string{"dem"}, Styles: []string{"relief"}})
+
+	plan, err := manifest.Diff(src, desired)
+	require.NoError(t, err)
+	require.NoError(t, plan.Apply())
+
+	production := geoservertest.NewServer()
+	defer production.Close()
+	dst := production.Client()
+
+	migration, err := manifest.Migrate(src, dst, "roads", migrateOptions()...)
+	require.Error(t, err)
+
+	// the global style is missing on the target, which only fails the group using it
+	require.Len(t, migration.Report.Failed, 1)
+	assert.Equal(t, "+ layergroup roads_v2/terrain", migration.Report.Failed[0].Change.String())
+	assert.ErrorContains(t, migration.Report.Failed[0], "style relief of layer group roads_v2/terrain does not exist")
+
+	// while the style of the workspace is migrated along with the group using it
+	sld, err := dst.Workspace("roads_v2").Styles().SLD("motorway")
+	require.NoError(t, err)
+	assert.Equal(t, motorwaySLD, string(sld))
+
+	group, err := dst.Workspace("roads_v2").LayerGroups().Get("basemap")
+	require.NoError(t, err)
+	require.Len(t, group.Styles.Style, 2)
+	assert.Equal(t, "roads_v2:motorway", group.Styles.Style[1].Name)
+
+	require.NoError(t, dst.Styles().Create("relief", []byte(motorwaySLD)))
+
+	migration, err = manifest.Migrate(src, dst, "roads", migrateOptions()...)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"+ layergroup roads_v2/terrain"}, paths(migration.Plan))
+}
+
+func TestMigrate_Passwords(t *testing.T) {
+	src := staging(t).Client()
+
+	production := geoservertest.NewServer()
+	defer production.Close()
+	dst := production.Client()
+
+	// the exported connection parameters have no password, the hook is the only way to set it on the target
+	var exported map[string]string
+	_, err := manifest.Migrate(src, dst, "roads", options.Migrate.RewriteConnectionParameters(func(store string, params map[string]string) {
+		exported = maps.Clone(params)
+		params["passwd"] = "production-secret"
+	}))
+	require.NoError(t, err)
+	assert.NotContains(t, exported, "passwd")
+
+	store, err := dst.Workspace("roads").DataStores().Get("postgis")
+	require.NoError(t, err)
+	passwd, ok := store.ConnectionParameters.Get("passwd")
+	require.True(t, ok)
+	assert.Equal(t, "crypt1:"+base64.StdEncoding.EncodeToString([]byte("production-secret")), passwd)
+
+	// without the hook, the store is created without a password
+	_, err = manifest.Migrate(src, dst, "roads", options.Migrate.Rename("roads_v2"))
+	require.NoError(t, err)
+
+	store, err = dst.Workspace("roads_v2").DataStores().Get("postgis")
+	require.NoError(t, err)
+	_, ok = store.ConnectionParameters.Get("passwd")
+	assert.False(t, ok)
+}
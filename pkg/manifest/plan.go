@@ -0,0 +1,185 @@
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+type Kind string
+
+const (
+	KindWorkspace     Kind = "workspace"
+	KindStyle         Kind = "style"
+	KindDataStore     Kind = "datastore"
+	KindCoverageStore Kind = "coveragestore"
+	KindFeatureType   Kind = "featuretype"
+	KindCoverage      Kind = "coverage"
+	KindLayerGroup    Kind = "layergroup"
+)
+
+// Change is a single step of a plan.
+type Change struct {
+	Action Action
+	Kind   Kind
+	// Path identifies the resource as workspace[/store][/name], global styles and layer groups only by their name
+	Path string
+	// Fields lists the fields that differ, for updates
+	Fields []string
+
+	apply func() error
+}
+
+func (c Change) String() string {
+	var symbol string
+	switch c.Action {
+	case ActionCreate:
+		symbol = "+"
+	case ActionUpdate:
+		symbol = "~"
+	case ActionDelete:
+		symbol = "-"
+	}
+
+	s := fmt.Sprintf("%s %s %s", symbol, c.Kind, c.Path)
+	if len(c.Fields) > 0 {
+		s += " (" + strings.Join(c.Fields, ", ") + ")"
+	}
+
+	return s
+}
+
+// Plan holds the changes needed to reconcile the live catalog with a manifest, in the order they are applied:
+// creates and updates from workspaces and styles down to layer groups, then deletes from layer groups up to stores and
+// styles.
+type Plan struct {
+	Changes []Change
+}
+
+// Empty reports whether the live catalog already matches the manifest.
+func (p Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String renders the plan for a dry run, one change per line followed by a summary.
+func (p Plan) String() string {
+	var b strings.Builder
+	var created, updated, deleted int
+	for _, change := range p.Changes {
+		b.WriteString(change.String())
+		b.WriteByte('\n')
+
+		switch change.Action {
+		case ActionCreate:
+			created++
+		case ActionUpdate:
+			updated++
+		case ActionDelete:
+			deleted++
+		}
+	}
+
+	fmt.Fprintf(&b, "Plan: %d to create, %d to update, %d to delete.", created, updated, deleted)
+	return b.String()
+}
+
+// Apply runs the changes in order and stops at the first failure. Running Diff again afterwards
+// yields the remaining changes, so a failed apply can simply be retried.
+func (p Plan) Apply() error {
+	for i, change := range p.Changes {
+		if err := change.apply(); err != nil {
+			return &ApplyError{Change: change, Applied: i, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// ApplyError is returned when a change of the plan fails.
+type ApplyError struct {
+	Change Change
+	// Applied is the number of changes applied before the failure
+	Applied int
+	Err     error
+}
+
+func (ae *ApplyError) Error() string {
+	return fmt.Sprintf("%s %s %s: %s", ae.Change.Action, ae.Change.Kind, ae.Change.Path, ae.Err)
+}
+
+func (ae *ApplyError) Unwrap() error {
+	return ae.Err
+}
+
+// ApplyAll runs every change instead of stopping at the first failure. The changes below a failed one, i.e. the
+// feature types and coverages of a store that could not be created, are skipped rather than attempted.
+func (p Plan) ApplyAll() Report {
+	var report Report
+	var failed []string
+	for _, change := range p.Changes {
+		if below(change.Path, failed) {
+			report.Skipped = append(report.Skipped, change)
+			continue
+		}
+
+		if err := change.apply(); err != nil {
+			report.Failed = append(report.Failed, &ApplyError{Change: change, Applied: len(report.Applied), Err: err})
+
+			// only workspaces and stores hold other resources, the path of a global style or group could otherwise
+			// match a workspace
+			switch change.Kind {
+			case KindWorkspace, KindDataStore, KindCoverageStore:
+				failed = append(failed, change.Path)
+			}
+			continue
+		}
+
+		report.Applied = append(report.Applied, change)
+	}
+
+	return report
+}
+
+// Report lists the outcome of each change of a plan applied with ApplyAll.
+type Report struct {
+	Applied []Change
+	Failed  []*ApplyError
+	// Skipped lists the changes below a failed one
+	Skipped []Change
+}
+
+// Err joins the errors of the failed changes, it is nil when every change was applied.
+func (r Report) Err() error {
+	if len(r.Failed) == 0 && len(r.Skipped) == 0 {
+		return nil
+	}
+
+	errs := make([]error, 0, len(r.Failed)+len(r.Skipped))
+	for _, failed := range r.Failed {
+		errs = append(errs, failed)
+	}
+
+	for _, skipped := range r.Skipped {
+		errs = append(errs, fmt.Errorf("%s %s %s: skipped", skipped.Action, skipped.Kind, skipped.Path))
+	}
+
+	return errors.Join(errs...)
+}
+
+func below(path string, parents []string) bool {
+	for _, parent := range parents {
+		if strings.HasPrefix(path, parent+"/") {
+			return true
+		}
+	}
+
+	return false
+}
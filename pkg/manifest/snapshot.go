@@ -0,0 +1,329 @@
+package manifest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/canghel3/go-geoserver/pkg/customerrors"
+)
+
+// A snapshot stores a manifest as a directory with one file per resource, so that changes to the catalog show
+// up as small diffs when the directory is version controlled:
+//
+//	<dir>/<workspace>/workspace.json
+//	<dir>/<workspace>/styles/<style>.sld
+//	<dir>/<workspace>/datastores/<store>.json       (with its feature types)
+//	<dir>/<workspace>/coveragestores/<store>.json   (with its coverages)
+//	<dir>/<workspace>/layergroups/<group>.json
+//	<dir>/@global/styles/<style>.sld
+//	<dir>/@global/layergroups/<group>.json
+//
+// The global styles and layer groups go in the @global directory, which cannot clash with a workspace since '@' is
+// not allowed in workspace names.
+const (
+	workspaceFile      = "workspace.json"
+	globalDir          = "@global"
+	stylesDir          = "styles"
+	dataStoresDir      = "datastores"
+	coverageStoresDir  = "coveragestores"
+	layerGroupsDir     = "layergroups"
+	snapshotPermission = 0o755
+)
+
+type workspaceEntry struct {
+	Name     string `json:"name"`
+	Isolated bool   `json:"isolated,omitempty"`
+}
+
+// WriteDir writes the manifest as a snapshot into dir, which is created if needed. The files are indented and keys
+// are sorted, so that writing the same manifest twice produces identical files. Files of resources which are no
+// longer in the manifest are removed; anything else in dir, such as a .git directory, is left untouched.
+//
+// A manifest exported for some workspaces only replaces the snapshots of these workspaces, the other workspaces and
+// the global styles and layer groups are left as they are.
+func (m Manifest) WriteDir(dir string) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, snapshotPermission); err != nil {
+		return err
+	}
+
+	for _, ws := range m.Workspaces {
+		if err := writeWorkspace(filepath.Join(dir, ws.Name), ws); err != nil {
+			return err
+		}
+	}
+
+	if m.partial {
+		return nil
+	}
+
+	if err := writeGlobals(filepath.Join(dir, globalDir), m); err != nil {
+		return err
+	}
+
+	workspaces := make(map[string]bool)
+	for _, ws := range m.Workspaces {
+		workspaces[ws.Name] = true
+	}
+
+	// remove the workspaces of a previous snapshot which are gone
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || workspaces[entry.Name()] {
+			continue
+		}
+
+		if _, err = os.Stat(filepath.Join(dir, entry.Name(), workspaceFile)); err == nil {
+			if err = os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func writeWorkspace(dir string, ws Workspace) error {
+	for _, sub := range []string{dataStoresDir, coverageStoresDir, layerGroupsDir} {
+		if err := clearDir(filepath.Join(dir, sub), "*.json"); err != nil {
+			return err
+		}
+	}
+
+	if err := clearDir(filepath.Join(dir, stylesDir), "*.sld"); err != nil {
+		return err
+	}
+
+	if err := writeFile(filepath.Join(dir, workspaceFile), workspaceEntry{Name: ws.Name, Isolated: ws.Isolated}); err != nil {
+		return err
+	}
+
+	if err := writeStyles(filepath.Join(dir, stylesDir), ws.Styles); err != nil {
+		return err
+	}
+
+	for _, ds := range ws.DataStores {
+		if err := writeFile(filepath.Join(dir, dataStoresDir, ds.Name+".json"), ds); err != nil {
+			return err
+		}
+	}
+
+	for _, cs := range ws.CoverageStores {
+		if err := writeFile(filepath.Join(dir, coverageStoresDir, cs.Name+".json"), cs); err != nil {
+			return err
+		}
+	}
+
+	for _, lg := range ws.LayerGroups {
+		if err := writeFile(filepath.Join(dir, layerGroupsDir, lg.Name+".json"), lg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeGlobals writes the global styles and layer groups, removing the directory when there are none
+func writeGlobals(dir string, m Manifest) error {
+	if len(m.Styles) == 0 && len(m.LayerGroups) == 0 {
+		return os.RemoveAll(dir)
+	}
+
+	if err := clearDir(filepath.Join(dir, stylesDir), "*.sld"); err != nil {
+		return err
+	}
+
+	if err := clearDir(filepath.Join(dir, layerGroupsDir), "*.json"); err != nil {
+		return err
+	}
+
+	if err := writeStyles(filepath.Join(dir, stylesDir), m.Styles); err != nil {
+		return err
+	}
+
+	for _, lg := range m.LayerGroups {
+		if err := writeFile(filepath.Join(dir, layerGroupsDir, lg.Name+".json"), lg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeStyles writes every style as its SLD document, which stays readable and diffable as XML
+func writeStyles(dir string, styles []Style) error {
+	for _, style := range styles {
+		if err := os.WriteFile(filepath.Join(dir, style.Name+".sld"), []byte(style.SLD), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// clearDir creates dir or removes the files matching pattern it holds
+func clearDir(dir, pattern string) error {
+	if err := os.MkdirAll(dir, snapshotPermission); err != nil {
+		return err
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, pattern))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err = os.Remove(file); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeFile(name string, v any) error {
+	content, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(name, append(content, '\n'), 0o644)
+}
+
+// ReadDir reads a snapshot written by WriteDir back into a manifest. Every subdirectory of dir holding a
+// workspace.json file is read as a workspace, and the @global directory holds the global styles and layer groups.
+func ReadDir(dir string) (*Manifest, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// os.ReadDir sorts the entries by name, which keeps the manifest deterministic
+	m := &Manifest{Workspaces: []Workspace{}}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		ws, err := readWorkspace(filepath.Join(dir, entry.Name()))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		m.Workspaces = append(m.Workspaces, *ws)
+	}
+
+	if m.Styles, err = readStyles(filepath.Join(dir, globalDir, stylesDir)); err != nil {
+		return nil, err
+	}
+
+	if err = readFiles(filepath.Join(dir, globalDir, layerGroupsDir), &m.LayerGroups); err != nil {
+		return nil, err
+	}
+
+	if err = m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func readWorkspace(dir string) (*Workspace, error) {
+	var entry workspaceEntry
+	if err := readFile(filepath.Join(dir, workspaceFile), &entry); err != nil {
+		return nil, err
+	}
+
+	ws := &Workspace{Name: entry.Name, Isolated: entry.Isolated}
+
+	var err error
+	if ws.Styles, err = readStyles(filepath.Join(dir, stylesDir)); err != nil {
+		return nil, err
+	}
+
+	if err = readFiles(filepath.Join(dir, dataStoresDir), &ws.DataStores); err != nil {
+		return nil, err
+	}
+
+	if err = readFiles(filepath.Join(dir, coverageStoresDir), &ws.CoverageStores); err != nil {
+		return nil, err
+	}
+
+	if err = readFiles(filepath.Join(dir, layerGroupsDir), &ws.LayerGroups); err != nil {
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+// readStyles reads every SLD document of dir, in name order, naming the style after its file
+func readStyles(dir string) ([]Style, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.sld"))
+	if err != nil {
+		return nil, err
+	}
+
+	var styles []Style
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		styles = append(styles, Style{Name: strings.TrimSuffix(filepath.Base(file), ".sld"), SLD: string(content)})
+	}
+
+	return styles, nil
+}
+
+// readFiles decodes every JSON file of dir, in name order, and appends it to items
+func readFiles[T any](dir string, items *[]T) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		var item T
+		if err = readFile(file, &item); err != nil {
+			return err
+		}
+
+		*items = append(*items, item)
+	}
+
+	return nil
+}
+
+// readFile decodes the JSON file, rejecting unknown fields like Parse does
+func readFile(name string, v any) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	decoder := json.NewDecoder(f)
+	decoder.DisallowUnknownFields()
+
+	if err = decoder.Decode(v); err != nil {
+		return customerrors.WrapInputError(fmt.Errorf("invalid snapshot file %s: %w", name, err))
+	}
+
+	return nil
+}
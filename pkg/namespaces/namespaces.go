@@ -0,0 +1,44 @@
+package namespaces
+
+import "encoding/json"
+
+type NamespaceWrapper struct {
+	Namespace Namespace `json:"namespace"`
+}
+
+type Namespace struct {
+	Prefix   string `json:"prefix"`
+	URI      string `json:"uri"`
+	Isolated bool   `json:"isolated"`
+}
+
+type NamespacesWrapper struct {
+	Namespaces Namespaces `json:"namespaces"`
+}
+
+type Namespaces struct {
+	Entries []Entry `json:"namespace"`
+}
+
+// UnmarshalJSON handles the empty string returned by GeoServer when there are no namespaces
+func (n *Namespaces) UnmarshalJSON(data []byte) error {
+	var empty string
+	if err := json.Unmarshal(data, &empty); err == nil {
+		n.Entries = []Entry{}
+		return nil
+	}
+
+	type alias Namespaces
+	var a alias
+	if err := json.Unmarshal(data, &a); err != nil {
+		return err
+	}
+
+	*n = Namespaces(a)
+	return nil
+}
+
+type Entry struct {
+	Name string `json:"name"`
+	Href string `json:"href"`
+}
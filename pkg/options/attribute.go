@@ -0,0 +1,34 @@
+package options
+
+import (
+	"github.com/canghel3/go-geoserver/internal/models"
+)
+
+var Attribute AttributeOptionsGenerator
+
+type AttributeOptionsGenerator struct{}
+
+type AttributeOption func(attribute *models.Attribute)
+
+// Required marks the attribute as mandatory (not nillable and occurring at least once)
+func (aog AttributeOptionsGenerator) Required() AttributeOption {
+	return func(attribute *models.Attribute) {
+		attribute.Nillable = false
+		attribute.MinOccurs = 1
+	}
+}
+
+// Length sets the maximum length of the attribute values (e.g. the size of a varchar column)
+func (aog AttributeOptionsGenerator) Length(length int) AttributeOption {
+	return func(attribute *models.Attribute) {
+		attribute.Length = &length
+	}
+}
+
+// Occurs sets the minimum and maximum number of occurrences of the attribute
+func (aog AttributeOptionsGenerator) Occurs(min, max int) AttributeOption {
+	return func(attribute *models.Attribute) {
+		attribute.MinOccurs = min
+		attribute.MaxOccurs = max
+	}
+}
@@ -0,0 +1,146 @@
+package options
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/canghel3/go-geoserver/internal/models"
+)
+
+var Backup BackupOptionsGenerator
+
+type BackupOptionsGenerator struct{}
+
+// BackupOption is used when starting a backup.
+type BackupOption func(backup *models.BackupExecution)
+
+// Workspaces limits the backup to the given workspaces. Without any workspace the whole configuration is backed up.
+func (bog BackupOptionsGenerator) Workspaces(names ...string) BackupOption {
+	return func(backup *models.BackupExecution) {
+		backup.WorkspaceFilter = workspaceFilter(names)
+	}
+}
+
+// Overwrite replaces the archive file if it already exists
+func (bog BackupOptionsGenerator) Overwrite() BackupOption {
+	return func(backup *models.BackupExecution) {
+		backup.Overwrite = true
+	}
+}
+
+// SkipSecurity leaves the security configuration out of the archive
+func (bog BackupOptionsGenerator) SkipSecurity() BackupOption {
+	return func(backup *models.BackupExecution) {
+		addBackupFlag(backup, "BK_SKIP_SECURITY")
+	}
+}
+
+// SkipSettings leaves the global and service settings out of the archive
+func (bog BackupOptionsGenerator) SkipSettings() BackupOption {
+	return func(backup *models.BackupExecution) {
+		addBackupFlag(backup, "BK_SKIP_SETTINGS")
+	}
+}
+
+// Cleanup removes the temporary folders once the backup is done
+func (bog BackupOptionsGenerator) Cleanup() BackupOption {
+	return func(backup *models.BackupExecution) {
+		addBackupFlag(backup, "BK_CLEANUP_TEMP")
+	}
+}
+
+// BestEffort skips the resources that fail instead of failing the whole backup
+func (bog BackupOptionsGenerator) BestEffort() BackupOption {
+	return func(backup *models.BackupExecution) {
+		addBackupFlag(backup, "BK_BEST_EFFORT")
+	}
+}
+
+// ParameterizePasswords replaces the store passwords in the archive with tokens to be provided on restore
+func (bog BackupOptionsGenerator) ParameterizePasswords() BackupOption {
+	return func(backup *models.BackupExecution) {
+		addBackupFlag(backup, "BK_PARAM_PASSWORDS")
+	}
+}
+
+var Restore RestoreOptionsGenerator
+
+type RestoreOptionsGenerator struct{}
+
+// RestoreOption is used when starting a restore.
+type RestoreOption func(restore *models.BackupExecution)
+
+// DryRun checks the archive against the current configuration without changing anything
+func (rog RestoreOptionsGenerator) DryRun() RestoreOption {
+	return func(restore *models.BackupExecution) {
+		addBackupFlag(restore, "BK_DRY_RUN")
+	}
+}
+
+// Workspaces limits the restore to the given workspaces. Without any workspace the whole archive is restored.
+func (rog RestoreOptionsGenerator) Workspaces(names ...string) RestoreOption {
+	return func(restore *models.BackupExecution) {
+		restore.WorkspaceFilter = workspaceFilter(names)
+	}
+}
+
+// SkipSecurity keeps the current security configuration
+func (rog RestoreOptionsGenerator) SkipSecurity() RestoreOption {
+	return func(restore *models.BackupExecution) {
+		addBackupFlag(restore, "BK_SKIP_SECURITY")
+	}
+}
+
+// SkipSettings keeps the current global and service settings
+func (rog RestoreOptionsGenerator) SkipSettings() RestoreOption {
+	return func(restore *models.BackupExecution) {
+		addBackupFlag(restore, "BK_SKIP_SETTINGS")
+	}
+}
+
+// Cleanup removes the temporary folders once the restore is done
+func (rog RestoreOptionsGenerator) Cleanup() RestoreOption {
+	return func(restore *models.BackupExecution) {
+		addBackupFlag(restore, "BK_CLEANUP_TEMP")
+	}
+}
+
+// BestEffort skips the resources that fail instead of failing the whole restore
+func (rog RestoreOptionsGenerator) BestEffort() RestoreOption {
+	return func(restore *models.BackupExecution) {
+		addBackupFlag(restore, "BK_BEST_EFFORT")
+	}
+}
+
+// Passwords provides the store passwords that were parameterized on backup, keyed by their token
+// (e.g. ${workspace:store.passwd.encryptedValue})
+func (rog RestoreOptionsGenerator) Passwords(tokens map[string]string) RestoreOption {
+	return func(restore *models.BackupExecution) {
+		pairs := make([]string, 0, len(tokens))
+		for token, password := range tokens {
+			pairs = append(pairs, token+"="+password)
+		}
+		sort.Strings(pairs)
+
+		restore.Options.Option = append(restore.Options.Option, "BK_PASSWORD_TOKENS="+strings.Join(pairs, ","))
+	}
+}
+
+func addBackupFlag(execution *models.BackupExecution, flag string) {
+	execution.Options.Option = append(execution.Options.Option, flag+"=true")
+}
+
+// workspaceFilter builds the ECQL filter matching the names of the workspaces, no names meaning no filter
+func workspaceFilter(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("'%s'", strings.ReplaceAll(name, "'", "''"))
+	}
+
+	return fmt.Sprintf("name IN (%s)", strings.Join(quoted, ","))
+}
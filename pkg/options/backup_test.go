@@ -0,0 +1,32 @@
+package options_test
+
+import (
+	"testing"
+
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackup_Workspaces(t *testing.T) {
+	tests := []struct {
+		name   string
+		names  []string
+		filter string
+	}{
+		{name: "No Workspaces"},
+		{name: "Single Workspace", names: []string{"roads"}, filter: "name IN ('roads')"},
+		{name: "Quoted Workspaces", names: []string{"roads", "o'neil"}, filter: "name IN ('roads','o''neil')"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var backup, restore models.BackupExecution
+			options.Backup.Workspaces(test.names...)(&backup)
+			options.Restore.Workspaces(test.names...)(&restore)
+
+			assert.Equal(t, test.filter, backup.WorkspaceFilter)
+			assert.Equal(t, test.filter, restore.WorkspaceFilter)
+		})
+	}
+}
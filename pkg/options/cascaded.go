@@ -0,0 +1,92 @@
+package options
+
+import (
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/pkg/shared"
+)
+
+var CascadedStore CascadedStoreOptionsGenerator
+
+type CascadedStoreOptionsGenerator struct{}
+
+// CascadedStoreOption is used when creating WMS and WMTS stores. GeoServer keeps the credentials, connections and
+// timeouts of both kinds of stores in the same HTTP settings, so every option applies to either kind.
+type CascadedStoreOption func(store *models.CascadedStore)
+
+func (csog CascadedStoreOptionsGenerator) Description(description string) CascadedStoreOption {
+	return func(store *models.CascadedStore) {
+		store.Description = description
+	}
+}
+
+// Credentials sets the username and password used to authenticate against the remote server
+func (csog CascadedStoreOptionsGenerator) Credentials(username, password string) CascadedStoreOption {
+	return func(store *models.CascadedStore) {
+		store.User = &username
+		store.Password = &password
+	}
+}
+
+// MaxConnections sets the maximum number of concurrent connections to the remote server
+func (csog CascadedStoreOptionsGenerator) MaxConnections(max uint) CascadedStoreOption {
+	return func(store *models.CascadedStore) {
+		store.MaxConnections = &max
+	}
+}
+
+// ReadTimeout sets the number of seconds to wait for a response from the remote server
+func (csog CascadedStoreOptionsGenerator) ReadTimeout(seconds uint) CascadedStoreOption {
+	return func(store *models.CascadedStore) {
+		store.ReadTimeout = &seconds
+	}
+}
+
+// ConnectTimeout sets the number of seconds to wait when connecting to the remote server
+func (csog CascadedStoreOptionsGenerator) ConnectTimeout(seconds uint) CascadedStoreOption {
+	return func(store *models.CascadedStore) {
+		store.ConnectTimeout = &seconds
+	}
+}
+
+// Disabled creates the store in a disabled state
+func (csog CascadedStoreOptionsGenerator) Disabled() CascadedStoreOption {
+	return func(store *models.CascadedStore) {
+		store.Enabled = false
+	}
+}
+
+var CascadedLayer CascadedLayerOptionsGenerator
+
+type CascadedLayerOptionsGenerator struct{}
+
+// CascadedLayerOption is used when publishing layers from WMS and WMTS stores.
+type CascadedLayerOption func(layer *models.CascadedLayer)
+
+func (clog CascadedLayerOptionsGenerator) Title(title string) CascadedLayerOption {
+	return func(layer *models.CascadedLayer) {
+		layer.Title = &title
+	}
+}
+
+// SRS sets the declared SRS of the layer (e.g. EPSG:3857)
+func (clog CascadedLayerOptionsGenerator) SRS(srs string) CascadedLayerOption {
+	return func(layer *models.CascadedLayer) {
+		layer.Srs = &srs
+	}
+}
+
+// BBOX sets the native bounding box of the layer as [minx, miny, maxx, maxy]
+func (clog CascadedLayerOptionsGenerator) BBOX(bbox [4]float64, bboxSrs string) CascadedLayerOption {
+	return func(layer *models.CascadedLayer) {
+		layer.NativeBoundingBox = &shared.BoundingBox{
+			MinX: bbox[0],
+			MaxX: bbox[2],
+			MinY: bbox[1],
+			MaxY: bbox[3],
+			CRS: shared.CRSClass{
+				Class: "",
+				Value: bboxSrs,
+			},
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package options
+
+import (
+	"time"
+
+	"github.com/canghel3/go-geoserver/internal/models"
+)
+
+var Catalog CatalogOptionsGenerator
+
+type CatalogOptionsGenerator struct{}
+
+// CatalogOption is used when reloading and resetting the catalog.
+type CatalogOption func(options *models.CatalogOptions)
+
+// WaitUntilHealthy blocks until GeoServer answers the status request again, checking every interval, or fails after timeout
+func (cog CatalogOptionsGenerator) WaitUntilHealthy(timeout, interval time.Duration) CatalogOption {
+	return func(options *models.CatalogOptions) {
+		options.Wait = true
+		options.Timeout = timeout
+		options.Interval = interval
+	}
+}
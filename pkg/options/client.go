@@ -2,6 +2,7 @@ package options
 
 import (
 	"github.com/canghel3/go-geoserver/internal"
+	"github.com/canghel3/go-geoserver/pkg/auth"
 )
 
 type GeoserverClientOption func(*internal.GeoserverData)
@@ -21,3 +22,11 @@ func (gco GeoserverClientOptionsGenerator) HttpClient(client internal.HTTPClient
 		i.Client = client
 	}
 }
+
+// Authenticator replaces the basic authentication with the username and password passed to the client,
+// e.g. with auth.Bearer, auth.RefreshingBearer, auth.AuthKey or auth.Header
+func (gco GeoserverClientOptionsGenerator) Authenticator(authenticator auth.Authenticator) GeoserverClientOption {
+	return func(i *internal.GeoserverData) {
+		i.Connection.Authenticator = authenticator
+	}
+}
@@ -2,6 +2,8 @@ package options
 
 import (
 	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/pkg/shared"
+	"github.com/canghel3/go-geoserver/pkg/types"
 )
 
 var Coverage CoverageOptionsGenerator
@@ -10,8 +12,205 @@ type CoverageOptionsGenerator struct{}
 
 type CoverageOption func(csl *models.Coverage)
 
-//func (cog CoverageOptionsGenerator) Title(title string) CoverageOption {
-//	return func(csl *models.Coverage) {
-//		csl.Title = &title
-//	}
-//}
+// Title sets the human readable title of the coverage
+func (cog CoverageOptionsGenerator) Title(title string) CoverageOption {
+	return func(c *models.Coverage) {
+		c.Title = &title
+	}
+}
+
+// Abstract sets the description of the coverage advertised in the capabilities documents
+func (cog CoverageOptionsGenerator) Abstract(abstract string) CoverageOption {
+	return func(c *models.Coverage) {
+		c.Abstract = &abstract
+	}
+}
+
+func (cog CoverageOptionsGenerator) Keywords(keywords ...string) CoverageOption {
+	return func(c *models.Coverage) {
+		c.Keywords = &shared.Keywords{Keywords: keywords}
+	}
+}
+
+// SRS sets the declared SRS of the coverage (e.g. EPSG:32631)
+func (cog CoverageOptionsGenerator) SRS(srs string) CoverageOption {
+	return func(c *models.Coverage) {
+		c.Srs = &srs
+	}
+}
+
+func (cog CoverageOptionsGenerator) ProjectionPolicy(policy types.ProjectionPolicy) CoverageOption {
+	return func(c *models.Coverage) {
+		p := string(policy)
+		c.ProjectionPolicy = &p
+	}
+}
+
+// BBOX sets the native bounding box of the coverage as [minx, miny, maxx, maxy]
+func (cog CoverageOptionsGenerator) BBOX(bbox [4]float64, bboxSrs string) CoverageOption {
+	return func(c *models.Coverage) {
+		c.NativeBoundingBox = &shared.BoundingBox{
+			MinX: bbox[0],
+			MaxX: bbox[2],
+			MinY: bbox[1],
+			MaxY: bbox[3],
+			CRS: shared.CRSClass{
+				Class: "",
+				Value: bboxSrs,
+			},
+		}
+	}
+}
+
+// InterpolationMethods sets the interpolation methods clients can request and the one used by default.
+// See the types.Interpolation constants for the available methods.
+func (cog CoverageOptionsGenerator) InterpolationMethods(defaultMethod string, methods ...string) CoverageOption {
+	return func(c *models.Coverage) {
+		c.DefaultInterpolationMethod = &defaultMethod
+		c.InterpolationMethods = &models.InterpolationMethods{String: methods}
+	}
+}
+
+// SupportedFormats sets the formats the coverage can be retrieved in through WCS (e.g. GeoTIFF, PNG)
+func (cog CoverageOptionsGenerator) SupportedFormats(formats ...string) CoverageOption {
+	return func(c *models.Coverage) {
+		c.SupportedFormats = &models.SupportedFormats{String: formats}
+	}
+}
+
+// RequestSRS sets the SRS clients can use in WCS requests
+func (cog CoverageOptionsGenerator) RequestSRS(srs ...string) CoverageOption {
+	return func(c *models.Coverage) {
+		c.RequestSRS = &models.SRS{String: srs}
+	}
+}
+
+// ResponseSRS sets the SRS clients can request WCS responses in
+func (cog CoverageOptionsGenerator) ResponseSRS(srs ...string) CoverageOption {
+	return func(c *models.Coverage) {
+		c.ResponseSRS = &models.SRS{String: srs}
+	}
+}
+
+// Band describes the next band of the coverage. GeoServer expects every band to be described, in order,
+// so use this option once for each band of the coverage.
+func (cog CoverageOptionsGenerator) Band(name string, options ...BandOption) CoverageOption {
+	return func(c *models.Coverage) {
+		band := models.CoverageDimension{
+			Name: name,
+		}
+
+		for _, option := range options {
+			option(&band)
+		}
+
+		if c.Dimensions == nil {
+			c.Dimensions = &models.CoverageDimensions{}
+		}
+
+		c.Dimensions.CoverageDimension = append(c.Dimensions.CoverageDimension, band)
+	}
+}
+
+// Enabled makes the coverage available through the services or not. Coverages are published enabled unless
+// disabled with this option, and updated without changing whether they are enabled unless it is given.
+func (cog CoverageOptionsGenerator) Enabled(enabled bool) CoverageOption {
+	return func(c *models.Coverage) {
+		c.Enabled = &enabled
+	}
+}
+
+var Band BandOptionsGenerator
+
+type BandOptionsGenerator struct{}
+
+// BandOption is used to describe a band of a coverage with options.Coverage.Band.
+type BandOption func(band *models.CoverageDimension)
+
+func (bog BandOptionsGenerator) Description(description string) BandOption {
+	return func(band *models.CoverageDimension) {
+		band.Description = description
+	}
+}
+
+// NoData sets the values which represent missing data in the band (e.g. -9999 for a DEM)
+func (bog BandOptionsGenerator) NoData(values ...float64) BandOption {
+	return func(band *models.CoverageDimension) {
+		band.NullValues = &models.NullValues{Double: values}
+	}
+}
+
+// Range sets the minimum and maximum value of the band. Use math.Inf for unbounded ranges.
+func (bog BandOptionsGenerator) Range(min, max float64) BandOption {
+	return func(band *models.CoverageDimension) {
+		band.Range = &models.Range{Min: min, Max: max}
+	}
+}
+
+// Unit sets the unit of measure of the band values (e.g. m)
+func (bog BandOptionsGenerator) Unit(unit string) BandOption {
+	return func(band *models.CoverageDimension) {
+		band.Unit = unit
+	}
+}
+
+// DataType sets the data type of the band (e.g. REAL_32BITS, SIGNED_16BITS)
+func (bog BandOptionsGenerator) DataType(dataType string) BandOption {
+	return func(band *models.CoverageDimension) {
+		band.DataType = &models.DataType{Name: dataType}
+	}
+}
+
+// Dimension enables and configures the dimension stored under key.
+// Use shared.TimeDimension, shared.ElevationDimension or shared.CustomDimension for the key.
+func (cog CoverageOptionsGenerator) Dimension(key string, options ...DimensionOption) CoverageOption {
+	return func(c *models.Coverage) {
+		dimension := NewDimension(options...)
+
+		if c.Metadata == nil {
+			c.Metadata = &models.Metadata{}
+		}
+
+		for i := range c.Metadata.Entry {
+			if c.Metadata.Entry[i].Key == key {
+				c.Metadata.Entry[i].DimensionInfo = &dimension
+				return
+			}
+		}
+
+		c.Metadata.Entry = append(c.Metadata.Entry, models.MetadataEntry{
+			Key:           key,
+			DimensionInfo: &dimension,
+		})
+	}
+}
+
+// MetadataLink adds a link to an external metadata record describing the coverage.
+// The mime type is the format of the linked document (e.g. text/xml).
+func (cog CoverageOptionsGenerator) MetadataLink(mimeType string, metadataType types.MetadataType, url string) CoverageOption {
+	return func(c *models.Coverage) {
+		if c.MetadataLinks == nil {
+			c.MetadataLinks = &shared.MetadataLinks{}
+		}
+
+		c.MetadataLinks.MetadataLink = append(c.MetadataLinks.MetadataLink, shared.MetadataLink{
+			Type:         mimeType,
+			MetadataType: string(metadataType),
+			Content:      url,
+		})
+	}
+}
+
+// DataLink adds a link to the underlying data of the coverage (e.g. a download page)
+func (cog CoverageOptionsGenerator) DataLink(mimeType string, url string) CoverageOption {
+	return func(c *models.Coverage) {
+		if c.DataLinks == nil {
+			c.DataLinks = &shared.DataLinks{}
+		}
+
+		c.DataLinks.DataLink = append(c.DataLinks.DataLink, shared.DataLink{
+			Type:    mimeType,
+			Content: url,
+		})
+	}
+}
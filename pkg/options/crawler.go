@@ -0,0 +1,54 @@
+package options
+
+import "github.com/canghel3/go-geoserver/internal/models"
+
+var Crawler CrawlerOptionsGenerator
+
+type CrawlerOptionsGenerator struct{}
+
+// CrawlerOption is used when crawling the catalog.
+type CrawlerOption func(options *models.CrawlerOptions)
+
+// Concurrency sets the maximum number of requests in flight, which defaults to 4.
+func (cog CrawlerOptionsGenerator) Concurrency(requests int) CrawlerOption {
+	return func(options *models.CrawlerOptions) {
+		options.Concurrency = requests
+	}
+}
+
+// RateLimit sets the maximum number of requests sent per second, which is unlimited by default.
+func (cog CrawlerOptionsGenerator) RateLimit(perSecond int) CrawlerOption {
+	return func(options *models.CrawlerOptions) {
+		options.RateLimit = perSecond
+	}
+}
+
+// Workspaces limits the crawl to the given workspaces, which also leaves out the global layer groups.
+func (cog CrawlerOptionsGenerator) Workspaces(names ...string) CrawlerOption {
+	return func(options *models.CrawlerOptions) {
+		options.Workspaces = append(options.Workspaces, names...)
+	}
+}
+
+// StoreTypes limits the crawl to the stores of the given types, such as PostGIS or GeoTIFF, compared case-insensitively.
+// Layer groups are not crawled when store types are set.
+func (cog CrawlerOptionsGenerator) StoreTypes(types ...string) CrawlerOption {
+	return func(options *models.CrawlerOptions) {
+		options.StoreTypes = append(options.StoreTypes, types...)
+	}
+}
+
+// Pattern only yields the resources whose name matches the shell pattern, with the syntax of path.Match.
+// The stores are still crawled for their feature types and coverages when their own name does not match.
+func (cog CrawlerOptionsGenerator) Pattern(pattern string) CrawlerOption {
+	return func(options *models.CrawlerOptions) {
+		options.Pattern = pattern
+	}
+}
+
+// Resume skips the resources listed in a checkpoint of a previous crawl, see Crawler.Checkpoint.
+func (cog CrawlerOptionsGenerator) Resume(checkpoint []string) CrawlerOption {
+	return func(options *models.CrawlerOptions) {
+		options.Checkpoint = append(options.Checkpoint, checkpoint...)
+	}
+}
@@ -0,0 +1,102 @@
+package options
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/canghel3/go-geoserver/pkg/shared"
+	"github.com/canghel3/go-geoserver/pkg/types"
+)
+
+var Dimension DimensionOptionsGenerator
+
+type DimensionOptionsGenerator struct{}
+
+// DimensionOption configures a time, elevation or custom dimension of a feature type or coverage.
+type DimensionOption func(dimension *shared.DimensionInfo)
+
+// Attribute sets the attribute holding the dimension values. Only used by feature types.
+func (dog DimensionOptionsGenerator) Attribute(attribute string) DimensionOption {
+	return func(dimension *shared.DimensionInfo) {
+		dimension.Attribute = attribute
+	}
+}
+
+// EndAttribute sets the attribute holding the end of the range when features span an interval. Only used by feature types.
+func (dog DimensionOptionsGenerator) EndAttribute(attribute string) DimensionOption {
+	return func(dimension *shared.DimensionInfo) {
+		dimension.EndAttribute = attribute
+	}
+}
+
+// Presentation sets how the dimension values are advertised. Defaults to LIST.
+func (dog DimensionOptionsGenerator) Presentation(presentation types.DimensionPresentation) DimensionOption {
+	return func(dimension *shared.DimensionInfo) {
+		dimension.Presentation = presentation
+	}
+}
+
+// Resolution sets the interval between values when the presentation is DISCRETE_INTERVAL.
+// For time dimensions the resolution is expressed in milliseconds, see TimeResolution.
+func (dog DimensionOptionsGenerator) Resolution(resolution float64) DimensionOption {
+	return func(dimension *shared.DimensionInfo) {
+		dimension.Resolution = json.Number(strconv.FormatFloat(resolution, 'f', -1, 64))
+	}
+}
+
+// TimeResolution sets the interval between values of a time dimension.
+func (dog DimensionOptionsGenerator) TimeResolution(resolution time.Duration) DimensionOption {
+	return func(dimension *shared.DimensionInfo) {
+		dimension.Resolution = json.Number(strconv.FormatInt(resolution.Milliseconds(), 10))
+	}
+}
+
+// Units sets the units and the unit symbol of the dimension (e.g. EPSG:5030 and m for elevation)
+func (dog DimensionOptionsGenerator) Units(units, symbol string) DimensionOption {
+	return func(dimension *shared.DimensionInfo) {
+		dimension.Units = units
+		dimension.UnitSymbol = symbol
+	}
+}
+
+// NearestMatch enables matching the nearest available value when the requested one does not exist.
+// The acceptable interval limits how far the match can be (e.g. PT1H or 10), leave empty for no limit.
+func (dog DimensionOptionsGenerator) NearestMatch(acceptableInterval string) DimensionOption {
+	return func(dimension *shared.DimensionInfo) {
+		dimension.NearestMatchEnabled = true
+		dimension.AcceptableInterval = acceptableInterval
+	}
+}
+
+// DefaultValue sets the strategy used to pick a value when the request does not specify one.
+// The reference value is required by the FIXED and NEAREST strategies and ignored otherwise.
+func (dog DimensionOptionsGenerator) DefaultValue(strategy types.DefaultValueStrategy, referenceValue string) DimensionOption {
+	return func(dimension *shared.DimensionInfo) {
+		dimension.DefaultValue = &shared.DimensionDefaultValue{
+			Strategy:       strategy,
+			ReferenceValue: referenceValue,
+		}
+	}
+}
+
+// Disabled keeps the dimension configuration but does not expose it.
+func (dog DimensionOptionsGenerator) Disabled() DimensionOption {
+	return func(dimension *shared.DimensionInfo) {
+		dimension.Enabled = false
+	}
+}
+
+// NewDimension builds the dimension configuration. The dimension is enabled and presented as a LIST unless configured otherwise.
+func NewDimension(options ...DimensionOption) shared.DimensionInfo {
+	dimension := shared.DimensionInfo{
+		Enabled:      true,
+		Presentation: types.PresentationList,
+	}
+
+	for _, option := range options {
+		option(&dimension)
+	}
+
+	return dimension
+}
@@ -3,6 +3,7 @@ package options
 import (
 	"github.com/canghel3/go-geoserver/internal/models"
 	"github.com/canghel3/go-geoserver/pkg/shared"
+	"github.com/canghel3/go-geoserver/pkg/types"
 )
 
 var FeatureType FeatureTypeOptionsGenerator
@@ -25,3 +26,90 @@ func (ftog FeatureTypeOptionsGenerator) BBOX(bbox [4]float64, bboxSrs string) Fe
 		}
 	}
 }
+
+// SQLView publishes the feature type as a SQL view. Build the view with featuretypes.NewSQLView.
+// The native name of the feature type defaults to the name of the view.
+func (ftog FeatureTypeOptionsGenerator) SQLView(view shared.VirtualTable) FeatureTypeOption {
+	return func(ft *models.FeatureType) {
+		if ft.NativeName == "" {
+			ft.NativeName = view.Name
+		}
+
+		metadataEntry(ft, models.VirtualTableMetadataKey).VirtualTable = &view
+	}
+}
+
+// Dimension enables and configures the dimension stored under key.
+// Use shared.TimeDimension, shared.ElevationDimension or shared.CustomDimension for the key.
+func (ftog FeatureTypeOptionsGenerator) Dimension(key string, options ...DimensionOption) FeatureTypeOption {
+	return func(ft *models.FeatureType) {
+		dimension := NewDimension(options...)
+		metadataEntry(ft, key).DimensionInfo = &dimension
+	}
+}
+
+// metadataEntry returns the metadata entry stored under key, adding it if it does not exist.
+func metadataEntry(ft *models.FeatureType, key string) *models.FeatureTypeMetadataEntry {
+	if ft.Metadata == nil {
+		ft.Metadata = &models.FeatureTypeMetadata{}
+	}
+
+	for i := range ft.Metadata.Entry {
+		if ft.Metadata.Entry[i].Key == key {
+			return &ft.Metadata.Entry[i]
+		}
+	}
+
+	ft.Metadata.Entry = append(ft.Metadata.Entry, models.FeatureTypeMetadataEntry{Key: key})
+	return &ft.Metadata.Entry[len(ft.Metadata.Entry)-1]
+}
+
+// Attributes sets the schema of the feature type. If the native name does not match an existing table,
+// GeoServer creates a new empty table in the store with these attributes.
+// Build the attributes with featuretypes.NewAttribute or featuretypes.AttributesFromStruct.
+func (ftog FeatureTypeOptionsGenerator) Attributes(attributes ...models.Attribute) FeatureTypeOption {
+	return func(ft *models.FeatureType) {
+		if ft.Attributes == nil {
+			ft.Attributes = &models.Attributes{}
+		}
+
+		ft.Attributes.Attribute = append(ft.Attributes.Attribute, attributes...)
+	}
+}
+
+// MetadataLink adds a link to an external metadata record describing the feature type.
+// The mime type is the format of the linked document (e.g. text/xml).
+func (ftog FeatureTypeOptionsGenerator) MetadataLink(mimeType string, metadataType types.MetadataType, url string) FeatureTypeOption {
+	return func(ft *models.FeatureType) {
+		if ft.MetadataLinks == nil {
+			ft.MetadataLinks = &shared.MetadataLinks{}
+		}
+
+		ft.MetadataLinks.MetadataLink = append(ft.MetadataLinks.MetadataLink, shared.MetadataLink{
+			Type:         mimeType,
+			MetadataType: string(metadataType),
+			Content:      url,
+		})
+	}
+}
+
+// DataLink adds a link to the underlying data of the feature type (e.g. a download page)
+func (ftog FeatureTypeOptionsGenerator) DataLink(mimeType string, url string) FeatureTypeOption {
+	return func(ft *models.FeatureType) {
+		if ft.DataLinks == nil {
+			ft.DataLinks = &shared.DataLinks{}
+		}
+
+		ft.DataLinks.DataLink = append(ft.DataLinks.DataLink, shared.DataLink{
+			Type:    mimeType,
+			Content: url,
+		})
+	}
+}
+
+// ResponseSRS sets the SRS advertised for WFS responses (e.g. EPSG:4326 or 4326)
+func (ftog FeatureTypeOptionsGenerator) ResponseSRS(srs ...string) FeatureTypeOption {
+	return func(ft *models.FeatureType) {
+		ft.ResponseSRS = &shared.SRSList{SRS: srs}
+	}
+}
@@ -0,0 +1,34 @@
+package options
+
+import (
+	"net/url"
+	"strconv"
+)
+
+var GetFeature GetFeatureOptionGenerator
+
+type GetFeatureOptionGenerator struct{}
+
+type GetFeatureOption func(values *url.Values)
+
+// ViewParams sets the values of the SQL view parameters.
+// The same parameters are applied to every requested feature type.
+func (gfog GetFeatureOptionGenerator) ViewParams(params map[string]string) GetFeatureOption {
+	return func(values *url.Values) {
+		values.Set("viewparams", viewParams(params))
+	}
+}
+
+// CQLFilter only returns the features matching the CQL filter
+func (gfog GetFeatureOptionGenerator) CQLFilter(filter string) GetFeatureOption {
+	return func(values *url.Values) {
+		values.Set("cql_filter", filter)
+	}
+}
+
+// Count limits the number of returned features
+func (gfog GetFeatureOptionGenerator) Count(count uint) GetFeatureOption {
+	return func(values *url.Values) {
+		values.Set("count", strconv.FormatUint(uint64(count), 10))
+	}
+}
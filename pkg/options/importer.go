@@ -0,0 +1,98 @@
+package options
+
+import (
+	"github.com/canghel3/go-geoserver/internal/models"
+	"github.com/canghel3/go-geoserver/pkg/importer"
+)
+
+var Import ImportOptionsGenerator
+
+type ImportOptionsGenerator struct{}
+
+// ImportOption is used when creating an import context.
+type ImportOption func(i *models.Import)
+
+// Workspace imports the data into the workspace instead of the default one
+func (iog ImportOptionsGenerator) Workspace(name string) ImportOption {
+	return func(i *models.Import) {
+		i.TargetWorkspace = &importer.TargetWorkspace{Workspace: importer.Reference{Name: name}}
+	}
+}
+
+// DataStore imports the vector data into an existing data store, e.g. a PostGIS database,
+// instead of creating a new store for each file
+func (iog ImportOptionsGenerator) DataStore(name string) ImportOption {
+	return func(i *models.Import) {
+		i.TargetStore = &importer.TargetStore{DataStore: &importer.Reference{Name: name}}
+	}
+}
+
+// Directory creates the import context with a task for each file of a directory on the GeoServer host
+func (iog ImportOptionsGenerator) Directory(path string) ImportOption {
+	return func(i *models.Import) {
+		i.Data = &importer.Data{Type: "directory", Location: path}
+	}
+}
+
+// File creates the import context with a task for a file on the GeoServer host
+func (iog ImportOptionsGenerator) File(path string) ImportOption {
+	return func(i *models.Import) {
+		i.Data = &importer.Data{Type: "file", File: path}
+	}
+}
+
+var ImportTask ImportTaskOptionsGenerator
+
+type ImportTaskOptionsGenerator struct{}
+
+// ImportTaskOption is used when updating a task of an import.
+type ImportTaskOption func(task *models.ImportTask)
+
+// UpdateMode sets what happens when the target layer already exists
+func (itog ImportTaskOptionsGenerator) UpdateMode(mode importer.UpdateMode) ImportTaskOption {
+	return func(task *models.ImportTask) {
+		task.UpdateMode = mode
+	}
+}
+
+// DataStore imports the data of the task into an existing data store
+func (itog ImportTaskOptionsGenerator) DataStore(name string) ImportTaskOption {
+	return func(task *models.ImportTask) {
+		task.Target = &importer.TargetStore{DataStore: &importer.Reference{Name: name}}
+	}
+}
+
+var ImportLayer ImportLayerOptionsGenerator
+
+type ImportLayerOptionsGenerator struct{}
+
+// ImportLayerOption is used when updating the layer published by a task.
+type ImportLayerOption func(layer *models.ImportLayer)
+
+// Name sets the name of the published layer
+func (ilog ImportLayerOptionsGenerator) Name(name string) ImportLayerOption {
+	return func(layer *models.ImportLayer) {
+		layer.Name = name
+	}
+}
+
+// Title sets the title of the published layer
+func (ilog ImportLayerOptionsGenerator) Title(title string) ImportLayerOption {
+	return func(layer *models.ImportLayer) {
+		layer.Title = title
+	}
+}
+
+// Abstract sets the abstract of the published layer
+func (ilog ImportLayerOptionsGenerator) Abstract(abstract string) ImportLayerOption {
+	return func(layer *models.ImportLayer) {
+		layer.Abstract = abstract
+	}
+}
+
+// SRS declares the srs of data that has none, such as a shapefile without a .prj file (task state NO_CRS)
+func (ilog ImportLayerOptionsGenerator) SRS(srs string) ImportLayerOption {
+	return func(layer *models.ImportLayer) {
+		layer.SRS = srs
+	}
+}
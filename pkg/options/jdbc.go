@@ -0,0 +1,93 @@
+package options
+
+import (
+	"strconv"
+
+	"github.com/canghel3/go-geoserver/pkg/datastores"
+)
+
+var JDBC JDBCOptionGenerator
+
+type JDBCOptionGenerator struct{}
+
+// JDBCOption holds the options shared by the Oracle, MySQL, SQL Server and H2 stores.
+type JDBCOption func(params *datastores.ConnectionParams)
+
+func (jog JDBCOptionGenerator) ValidateConnections() JDBCOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["validate connections"] = "true"
+	}
+}
+
+// MinConnections sets the minimum number of pooled connections
+func (jog JDBCOptionGenerator) MinConnections(min uint) JDBCOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["min connections"] = strconv.FormatUint(uint64(min), 10)
+	}
+}
+
+// MaxConnections sets the maximum number of open connections
+func (jog JDBCOptionGenerator) MaxConnections(max uint) JDBCOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["max connections"] = strconv.FormatUint(uint64(max), 10)
+	}
+}
+
+// FetchSize sets the number of records read with each interaction with the database
+func (jog JDBCOptionGenerator) FetchSize(size uint) JDBCOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["fetch size"] = strconv.FormatUint(uint64(size), 10)
+	}
+}
+
+// ConnectionTimeout sets the number of seconds the connection pool will wait before timing out
+func (jog JDBCOptionGenerator) ConnectionTimeout(seconds uint) JDBCOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["Connection timeout"] = strconv.FormatUint(uint64(seconds), 10)
+	}
+}
+
+// MaxOpenPreparedStatements sets the maximum number of prepared statements kept open for each connection
+func (jog JDBCOptionGenerator) MaxOpenPreparedStatements(max uint) JDBCOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["Max open prepared statements"] = strconv.FormatUint(uint64(max), 10)
+	}
+}
+
+// ExposePrimaryKeys enables or disables exposing the primary key columns as feature attributes
+func (jog JDBCOptionGenerator) ExposePrimaryKeys(expose bool) JDBCOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["Expose primary keys"] = strconv.FormatBool(expose)
+	}
+}
+
+// LooseBBOX enables or disables the use of the feature bounding box instead of the geometry for bbox filters.
+// Only supported by Oracle.
+func (jog JDBCOptionGenerator) LooseBBOX(loose bool) JDBCOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["Loose bbox"] = strconv.FormatBool(loose)
+	}
+}
+
+// EstimatedExtents enables or disables the use of the spatial index to compute the layer extents.
+// Only supported by Oracle.
+func (jog JDBCOptionGenerator) EstimatedExtents(estimated bool) JDBCOption {
+	return func(params *datastores.ConnectionParams) {
+		// the key is misspelled in GeoServer
+		(*params)["Estimated extends"] = strconv.FormatBool(estimated)
+	}
+}
+
+// StorageEngine sets the storage engine used when creating new tables. Only supported by MySQL.
+func (jog JDBCOptionGenerator) StorageEngine(engine string) JDBCOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["storage engine"] = engine
+	}
+}
+
+// IntegratedSecurity enables windows authentication instead of user and password. Only supported by SQL Server.
+func (jog JDBCOptionGenerator) IntegratedSecurity() JDBCOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["Integrated Security"] = "true"
+	}
+}
@@ -3,6 +3,7 @@ package options
 import (
 	"github.com/canghel3/go-geoserver/internal/models"
 	"github.com/canghel3/go-geoserver/pkg/shared"
+	"github.com/canghel3/go-geoserver/pkg/types"
 	"github.com/canghel3/go-geoserver/pkg/workspace"
 )
 
@@ -29,3 +30,19 @@ func (lgog LayerGroupOptionsGenerator) Title(title string) LayerGroupOption {
 		group.Title = &title
 	}
 }
+
+// MetadataLink adds a link to an external metadata record describing the layer group.
+// The mime type is the format of the linked document (e.g. text/xml).
+func (lgog LayerGroupOptionsGenerator) MetadataLink(mimeType string, metadataType types.MetadataType, url string) LayerGroupOption {
+	return func(group *models.Group) {
+		if group.MetadataLinks == nil {
+			group.MetadataLinks = &shared.MetadataLinks{}
+		}
+
+		group.MetadataLinks.MetadataLink = append(group.MetadataLinks.MetadataLink, shared.MetadataLink{
+			Type:         mimeType,
+			MetadataType: string(metadataType),
+			Content:      url,
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package options
+
+import "github.com/canghel3/go-geoserver/internal/models"
+
+var Manifest ManifestOptionsGenerator
+
+type ManifestOptionsGenerator struct{}
+
+// ManifestOption is used when comparing a manifest with the live catalog.
+type ManifestOption func(options *models.ManifestOptions)
+
+// Prune deletes the styles, stores, feature types, coverages and layer groups of the declared workspaces which are not
+// in the manifest. Workspaces missing from the manifest, and the global styles and layer groups, are never touched.
+func (mog ManifestOptionsGenerator) Prune() ManifestOption {
+	return func(options *models.ManifestOptions) {
+		options.Prune = true
+	}
+}
+
+var Export ExportOptionsGenerator
+
+type ExportOptionsGenerator struct{}
+
+// ExportOption is used when exporting the live catalog to a manifest.
+type ExportOption func(options *models.ExportOptions)
+
+// Workspaces limits the export to the given workspaces, all the workspaces are exported by default.
+func (eog ExportOptionsGenerator) Workspaces(names ...string) ExportOption {
+	return func(options *models.ExportOptions) {
+		options.Workspaces = append(options.Workspaces, names...)
+	}
+}
+
+var Migrate MigrateOptionsGenerator
+
+type MigrateOptionsGenerator struct{}
+
+// MigrateOption is used when migrating a workspace between two GeoServer instances.
+type MigrateOption func(options *models.MigrateOptions)
+
+// Rename creates the workspace under another name on the target.
+func (mog MigrateOptionsGenerator) Rename(name string) MigrateOption {
+	return func(options *models.MigrateOptions) {
+		options.Name = name
+	}
+}
+
+// RewriteConnectionParameters lets hook modify the connection parameters of each data store before it is created
+// or compared on the target, e.g. to point to another database host. Passwords are not exported by the source,
+// so the hook must also add them for the stores that need one.
+func (mog MigrateOptionsGenerator) RewriteConnectionParameters(hook func(store string, params map[string]string)) MigrateOption {
+	return func(options *models.MigrateOptions) {
+		options.ConnectionParameters = hook
+	}
+}
+
+// RewriteURL lets hook change the file url of each coverage store, for when the rasters live in another directory
+// on the target host.
+func (mog MigrateOptionsGenerator) RewriteURL(hook func(store, url string) string) MigrateOption {
+	return func(options *models.MigrateOptions) {
+		options.URL = hook
+	}
+}
+
+// DryRun computes the plan without applying it.
+func (mog MigrateOptionsGenerator) DryRun() MigrateOption {
+	return func(options *models.MigrateOptions) {
+		options.DryRun = true
+	}
+}
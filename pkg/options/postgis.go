@@ -1,13 +1,19 @@
 package options
 
 import (
+	"strconv"
+
 	"github.com/canghel3/go-geoserver/pkg/datastores"
+	"github.com/canghel3/go-geoserver/pkg/datastores/postgis"
 )
 
 var PostGIS PostGISOptionGenerator
 
 type PostGISOptionGenerator struct{}
 
+// PostGISOption is used for both PostGIS and PostGIS (JNDI) stores.
+// Connection pool options (MinConnections, MaxConnections, FetchSize, ConnectionTimeout, ValidateConnections)
+// are ignored by GeoServer for JNDI stores because the pool is managed by the container.
 type PostGISOption func(params *datastores.ConnectionParams)
 
 func (pgo PostGISOptionGenerator) ValidateConnections() PostGISOption {
@@ -15,3 +21,88 @@ func (pgo PostGISOptionGenerator) ValidateConnections() PostGISOption {
 		(*params)["validate connections"] = "true"
 	}
 }
+
+// Schema sets the database schema to read the tables from (default is public)
+func (pgo PostGISOptionGenerator) Schema(schema string) PostGISOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["schema"] = schema
+	}
+}
+
+// SSLMode sets the SSL mode of the connection
+func (pgo PostGISOptionGenerator) SSLMode(mode postgis.SSLMode) PostGISOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["SSL mode"] = string(mode)
+	}
+}
+
+// MinConnections sets the minimum number of pooled connections
+func (pgo PostGISOptionGenerator) MinConnections(min uint) PostGISOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["min connections"] = strconv.FormatUint(uint64(min), 10)
+	}
+}
+
+// MaxConnections sets the maximum number of open connections
+func (pgo PostGISOptionGenerator) MaxConnections(max uint) PostGISOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["max connections"] = strconv.FormatUint(uint64(max), 10)
+	}
+}
+
+// FetchSize sets the number of records read with each interaction with the database
+func (pgo PostGISOptionGenerator) FetchSize(size uint) PostGISOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["fetch size"] = strconv.FormatUint(uint64(size), 10)
+	}
+}
+
+// ConnectionTimeout sets the number of seconds the connection pool will wait before timing out
+func (pgo PostGISOptionGenerator) ConnectionTimeout(seconds uint) PostGISOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["Connection timeout"] = strconv.FormatUint(uint64(seconds), 10)
+	}
+}
+
+// MaxOpenPreparedStatements sets the maximum number of prepared statements kept open for each connection
+func (pgo PostGISOptionGenerator) MaxOpenPreparedStatements(max uint) PostGISOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["Max open prepared statements"] = strconv.FormatUint(uint64(max), 10)
+	}
+}
+
+// LooseBBOX enables or disables the use of the feature bounding box instead of the geometry for bbox filters
+func (pgo PostGISOptionGenerator) LooseBBOX(loose bool) PostGISOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["Loose bbox"] = strconv.FormatBool(loose)
+	}
+}
+
+// EstimatedExtents enables or disables the use of the spatial index to compute the layer extents
+func (pgo PostGISOptionGenerator) EstimatedExtents(estimated bool) PostGISOption {
+	return func(params *datastores.ConnectionParams) {
+		// the key is misspelled in GeoServer
+		(*params)["Estimated extends"] = strconv.FormatBool(estimated)
+	}
+}
+
+// EncodeFunctions enables or disables encoding filter functions into SQL
+func (pgo PostGISOptionGenerator) EncodeFunctions(encode bool) PostGISOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["encode functions"] = strconv.FormatBool(encode)
+	}
+}
+
+// ExposePrimaryKeys enables or disables exposing the primary key columns as feature attributes
+func (pgo PostGISOptionGenerator) ExposePrimaryKeys(expose bool) PostGISOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["Expose primary keys"] = strconv.FormatBool(expose)
+	}
+}
+
+// PreparedStatements enables or disables the use of prepared statements
+func (pgo PostGISOptionGenerator) PreparedStatements(prepared bool) PostGISOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["preparedStatements"] = strconv.FormatBool(prepared)
+	}
+}
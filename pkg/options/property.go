@@ -0,0 +1,18 @@
+package options
+
+import (
+	"github.com/canghel3/go-geoserver/pkg/datastores"
+)
+
+var Property PropertyOptionGenerator
+
+type PropertyOptionGenerator struct{}
+
+type PropertyOption func(params *datastores.ConnectionParams)
+
+// Namespace sets the namespace URI of the features in the store
+func (pog PropertyOptionGenerator) Namespace(namespace string) PropertyOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["namespace"] = namespace
+	}
+}
@@ -0,0 +1,24 @@
+package options
+
+import "github.com/canghel3/go-geoserver/internal/models"
+
+var User UserOptionsGenerator
+
+type UserOptionsGenerator struct{}
+
+// UserOption is used when creating and updating users.
+type UserOption func(user *models.User)
+
+// Password sets a new password for the user
+func (uog UserOptionsGenerator) Password(password string) UserOption {
+	return func(user *models.User) {
+		user.Password = &password
+	}
+}
+
+// Enabled enables or disables the user
+func (uog UserOptionsGenerator) Enabled(enabled bool) UserOption {
+	return func(user *models.User) {
+		user.Enabled = &enabled
+	}
+}
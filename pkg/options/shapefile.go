@@ -1,6 +1,8 @@
 package options
 
 import (
+	"strconv"
+
 	"github.com/canghel3/go-geoserver/pkg/datastores"
 )
 
@@ -10,53 +12,61 @@ type ShapefileOptionsGenerator struct{}
 
 type ShapefileOption func(params *datastores.ConnectionParams)
 
-//// Charset sets the character set for the shapefile
-//func (sog ShapefileOptionsGenerator) Charset(charset string) ShapefileOption {
-//	return func(params *datastores.ConnectionParams) {
-//		(*params)["charset"] = charset
-//	}
-//}
-//
-//// CreateSpatialIndex enables or disables the creation of a spatial index
-//func (sog ShapefileOptionsGenerator) CreateSpatialIndex(create bool) ShapefileOption {
-//	return func(params *datastores.ConnectionParams) {
-//		(*params)["create spatial index"] = strconv.FormatBool(create)
-//	}
-//}
-//
-//// Memory enables or disables loading the shapefile into memory
-//func (sog ShapefileOptionsGenerator) Memory(inMemory bool) ShapefileOption {
-//	return func(params *datastores.ConnectionParams) {
-//		(*params)["memory mapped buffer"] = strconv.FormatBool(inMemory)
-//	}
-//}
-//
-//// CacheAndReuse enables or disables caching and reusing the shapefile
-//func (sog ShapefileOptionsGenerator) CacheAndReuse(cache bool) ShapefileOption {
-//	return func(params *datastores.ConnectionParams) {
-//		(*params)["cache and reuse memory maps"] = strconv.FormatBool(cache)
-//	}
-//}
-//
-//// Directory specifies additional options for a directory of shapefiles
-//func (sog ShapefileOptionsGenerator) Directory(options map[string]string) ShapefileOption {
-//	return func(params *datastores.ConnectionParams) {
-//		for key, value := range options {
-//			(*params)[key] = value
-//		}
-//	}
-//}
-//
-//// FilePattern sets the pattern for matching shapefile names in a directory
-//func (sog ShapefileOptionsGenerator) FilePattern(pattern string) ShapefileOption {
-//	return func(params *datastores.ConnectionParams) {
-//		(*params)["file_pattern"] = pattern
-//	}
-//}
-//
-//// Namespace sets the namespace for the shapefiles in a directory
-//func (sog ShapefileOptionsGenerator) Namespace(namespace string) ShapefileOption {
-//	return func(params *datastores.ConnectionParams) {
-//		(*params)["namespace"] = namespace
-//	}
-//}
+// Charset sets the character set used to decode the DBF file (e.g. ISO-8859-5, windows-1253, UTF-8)
+func (sog ShapefileOptionsGenerator) Charset(charset string) ShapefileOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["charset"] = charset
+	}
+}
+
+// CreateSpatialIndex enables or disables the creation of a spatial index when one is missing or outdated
+func (sog ShapefileOptionsGenerator) CreateSpatialIndex(create bool) ShapefileOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["create spatial index"] = strconv.FormatBool(create)
+	}
+}
+
+// EnableSpatialIndex enables or disables the use of the spatial index when reading
+func (sog ShapefileOptionsGenerator) EnableSpatialIndex(enable bool) ShapefileOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["enable spatial index"] = strconv.FormatBool(enable)
+	}
+}
+
+// Memory enables or disables the use of memory mapped buffers for reading the shapefile
+func (sog ShapefileOptionsGenerator) Memory(inMemory bool) ShapefileOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["memory mapped buffer"] = strconv.FormatBool(inMemory)
+	}
+}
+
+// CacheAndReuse enables or disables caching and reusing the memory maps
+func (sog ShapefileOptionsGenerator) CacheAndReuse(cache bool) ShapefileOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["cache and reuse memory maps"] = strconv.FormatBool(cache)
+	}
+}
+
+// Timezone sets the timezone used to parse dates in the DBF file
+func (sog ShapefileOptionsGenerator) Timezone(timezone string) ShapefileOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["timezone"] = timezone
+	}
+}
+
+// SkipScan skips the initial scan of the shapefile looking for bounds and feature count
+func (sog ShapefileOptionsGenerator) SkipScan(skip bool) ShapefileOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["skipScan"] = strconv.FormatBool(skip)
+	}
+}
+
+// Namespace sets the namespace URI of the features in the store
+func (sog ShapefileOptionsGenerator) Namespace(namespace string) ShapefileOption {
+	return func(params *datastores.ConnectionParams) {
+		(*params)["namespace"] = namespace
+	}
+}
+
+// NOTE: there is no file pattern option because the shapefile datastore factory does not support one.
+// A directory of shapefiles always publishes every .shp file found inside the directory.
@@ -0,0 +1,51 @@
+package options
+
+import (
+	"github.com/canghel3/go-geoserver/pkg/shared"
+	"github.com/canghel3/go-geoserver/pkg/types"
+)
+
+var SQLView SQLViewOptionsGenerator
+
+type SQLViewOptionsGenerator struct{}
+
+type SQLViewOption func(vt *shared.VirtualTable)
+
+// Geometry declares a geometry column of the view together with its type and SRID.
+// Can be used multiple times for views with more than one geometry column.
+func (svog SQLViewOptionsGenerator) Geometry(column string, type_ types.GeometryType, srid int) SQLViewOption {
+	return func(vt *shared.VirtualTable) {
+		vt.Geometry = append(vt.Geometry, shared.VirtualTableGeometry{
+			Name: column,
+			Type: string(type_),
+			SRID: srid,
+		})
+	}
+}
+
+// KeyColumns sets the columns that uniquely identify each feature of the view
+func (svog SQLViewOptionsGenerator) KeyColumns(columns ...string) SQLViewOption {
+	return func(vt *shared.VirtualTable) {
+		vt.KeyColumn = append(vt.KeyColumn, columns...)
+	}
+}
+
+// Parameter declares a view parameter referenced in the SQL as %name%.
+// The default value is used when the parameter is missing from the viewparams of a request.
+// Values that do not match the regexpValidator are rejected by GeoServer. An empty regexpValidator disables the validation.
+func (svog SQLViewOptionsGenerator) Parameter(name, defaultValue, regexpValidator string) SQLViewOption {
+	return func(vt *shared.VirtualTable) {
+		vt.Parameter = append(vt.Parameter, shared.VirtualTableParameter{
+			Name:            name,
+			DefaultValue:    defaultValue,
+			RegexpValidator: regexpValidator,
+		})
+	}
+}
+
+// EscapeSQL escapes special SQL characters in the parameter values
+func (svog SQLViewOptionsGenerator) EscapeSQL() SQLViewOption {
+	return func(vt *shared.VirtualTable) {
+		vt.EscapeSQL = true
+	}
+}
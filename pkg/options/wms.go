@@ -1,7 +1,9 @@
 package options
 
 import (
+	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 )
 
@@ -16,3 +18,28 @@ func (wog GetMapOptionGenerator) Styles(styles []string) GetMapOption {
 		values.Set("styles", strings.Join(styles, ","))
 	}
 }
+
+// ViewParams sets the values of the SQL view parameters.
+// The same parameters are applied to every requested layer.
+func (wog GetMapOptionGenerator) ViewParams(params map[string]string) GetMapOption {
+	return func(values *url.Values) {
+		values.Set("viewparams", viewParams(params))
+	}
+}
+
+// viewParams encodes the parameters as key:value pairs separated by semicolons, escaping the separators inside the values
+func viewParams(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	escaper := strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s:%s", key, escaper.Replace(params[key])))
+	}
+
+	return strings.Join(pairs, ";")
+}
@@ -0,0 +1,37 @@
+package options
+
+import "github.com/canghel3/go-geoserver/internal/models"
+
+var Workspace WorkspaceOptionsGenerator
+
+type WorkspaceOptionsGenerator struct{}
+
+// WorkspaceOption is used when creating and updating workspaces.
+type WorkspaceOption func(workspace *models.Workspace)
+
+// URI sets the uri of the namespace associated with the workspace
+func (wog WorkspaceOptionsGenerator) URI(uri string) WorkspaceOption {
+	return func(workspace *models.Workspace) {
+		workspace.URI = uri
+	}
+}
+
+// Isolated marks the workspace as isolated, so its contents are only visible through its virtual services
+func (wog WorkspaceOptionsGenerator) Isolated(isolated bool) WorkspaceOption {
+	return func(workspace *models.Workspace) {
+		workspace.Isolated = &isolated
+	}
+}
+
+var Namespace NamespaceOptionsGenerator
+
+type NamespaceOptionsGenerator struct{}
+
+type NamespaceOption func(namespace *models.NamespaceDefinition)
+
+// Isolated marks the namespace as isolated, allowing several namespaces to share the same uri
+func (nog NamespaceOptionsGenerator) Isolated(isolated bool) NamespaceOption {
+	return func(namespace *models.NamespaceDefinition) {
+		namespace.Isolated = &isolated
+	}
+}
@@ -0,0 +1,101 @@
+package security
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// AccessRule grants access to the resource identified by Key to the listed roles.
+// The key syntax depends on the rule category:
+//   - data rules: workspace.layer.permission (e.g. topp.states.r, *.*.w)
+//   - service rules: service.method (e.g. wfs.GetFeature, wms.*)
+//   - REST rules: pattern:methods (e.g. /**:GET or /rest/workspaces/**:POST,DELETE)
+//
+// A Roles value of * grants access to everyone.
+type AccessRule struct {
+	Key   string
+	Roles []string
+}
+
+// Permission of a data access rule.
+type Permission string
+
+const (
+	Read       Permission = "r"
+	Write      Permission = "w"
+	Administer Permission = "a"
+)
+
+// Any matches every workspace, layer, service or method in a rule key.
+const Any = "*"
+
+// LayerRule builds a data access rule for a layer, or for every layer of the workspace when layer is Any
+func LayerRule(workspace, layer string, permission Permission, roles ...string) AccessRule {
+	return AccessRule{
+		Key:   fmt.Sprintf("%s.%s.%s", workspace, layer, permission),
+		Roles: roles,
+	}
+}
+
+// ServiceRule builds a service access rule for a service operation, or for every operation when method is Any
+func ServiceRule(service, method string, roles ...string) AccessRule {
+	return AccessRule{
+		Key:   fmt.Sprintf("%s.%s", service, method),
+		Roles: roles,
+	}
+}
+
+// RESTRule builds a REST access rule for the ant pattern and http methods
+func RESTRule(pattern string, methods []string, roles ...string) AccessRule {
+	return AccessRule{
+		Key:   fmt.Sprintf("%s:%s", pattern, strings.Join(methods, ",")),
+		Roles: roles,
+	}
+}
+
+// Rules converts the rules to the format used by GeoServer, a map of keys to comma separated roles.
+func Rules(rules ...AccessRule) map[string]string {
+	m := make(map[string]string, len(rules))
+	for _, rule := range rules {
+		m[rule.Key] = strings.Join(rule.Roles, ",")
+	}
+
+	return m
+}
+
+// FromRules converts the rules returned by GeoServer to a list of rules sorted by key.
+func FromRules(m map[string]string) []AccessRule {
+	rules := make([]AccessRule, 0, len(m))
+	for key, roles := range m {
+		rule := AccessRule{Key: key, Roles: []string{}}
+		for _, role := range strings.Split(roles, ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				rule.Roles = append(rule.Roles, role)
+			}
+		}
+		rules = append(rules, rule)
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Key < rules[j].Key
+	})
+
+	return rules
+}
+
+// CatalogMode controls how GeoServer handles layers the user has no access to.
+type CatalogMode string
+
+const (
+	// Hide removes the layers from the capabilities documents and treats them as missing
+	Hide CatalogMode = "HIDE"
+	// Middle lists the layers in the capabilities documents but denies access to their data
+	Middle CatalogMode = "MIDDLE"
+	// Challenge lists the layers and asks for authentication when their data is requested
+	Challenge CatalogMode = "CHALLENGE"
+)
+
+type CatalogModeWrapper struct {
+	Mode CatalogMode `json:"mode"`
+}
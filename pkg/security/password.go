@@ -0,0 +1,12 @@
+package security
+
+// SelfPassword is used to change the password of the authenticated user.
+type SelfPassword struct {
+	NewPassword string `json:"newPassword"`
+}
+
+// MasterPassword is returned when retrieving the master password and sent when changing it.
+type MasterPassword struct {
+	OldMasterPassword string `json:"oldMasterPassword"`
+	NewMasterPassword string `json:"newMasterPassword,omitempty"`
+}
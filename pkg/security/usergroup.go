@@ -0,0 +1,72 @@
+package security
+
+import (
+	"encoding/json"
+	"github.com/canghel3/go-geoserver/internal/jsonutil"
+)
+
+// UsersWrapper is the response of the users listing of a user/group service.
+type UsersWrapper struct {
+	Users []User `json:"-"`
+}
+
+func (uw *UsersWrapper) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Users json.RawMessage `json:"users"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return unmarshalList(raw.Users, &uw.Users)
+}
+
+type User struct {
+	UserName string `json:"userName"`
+	Enabled  bool   `json:"enabled"`
+}
+
+// GroupsWrapper is the response of the groups listing of a user/group service.
+type GroupsWrapper struct {
+	Groups []string `json:"-"`
+}
+
+func (gw *GroupsWrapper) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Groups json.RawMessage `json:"groups"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return unmarshalList(raw.Groups, &gw.Groups)
+}
+
+// RolesWrapper is the response of the roles listing of a role service.
+type RolesWrapper struct {
+	Roles []string `json:"-"`
+}
+
+func (rw *RolesWrapper) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Roles json.RawMessage `json:"roles"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	return unmarshalList(raw.Roles, &rw.Roles)
+}
+
+// unmarshalList returns an empty list rather than nil when GeoServer has nothing to list
+func unmarshalList[T any](data json.RawMessage, target *[]T) error {
+	if err := jsonutil.OneOrMany(data, target); err != nil {
+		return err
+	}
+
+	if *target == nil {
+		*target = []T{}
+	}
+
+	return nil
+}
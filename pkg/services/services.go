@@ -0,0 +1,107 @@
+package services
+
+import (
+	"github.com/canghel3/go-geoserver/pkg/shared"
+)
+
+// Names of the services, as used in the REST paths and as the root element of the settings.
+const (
+	NameWMS  = "wms"
+	NameWFS  = "wfs"
+	NameWCS  = "wcs"
+	NameWMTS = "wmts"
+)
+
+// Service contains the settings shared by every OGC service.
+// This structure contains many fields with pointer values because GeoServer only updates the settings which are sent,
+// so fields left nil keep their current value.
+type Service struct {
+	Workspace         *Workspace       `json:"workspace,omitempty"`
+	Enabled           *bool            `json:"enabled,omitempty"`
+	Name              *string          `json:"name,omitempty"`
+	Title             *string          `json:"title,omitempty"`
+	Abstract          *string          `json:"abstrct,omitempty"`
+	MaintainerURL     *string          `json:"maintainer,omitempty"`
+	AccessConstraints *string          `json:"accessConstraints,omitempty"`
+	Fees              *string          `json:"fees,omitempty"`
+	Keywords          *shared.Keywords `json:"keywords,omitempty"`
+	CiteCompliant     *bool            `json:"citeCompliant,omitempty"`
+	OnlineResource    *string          `json:"onlineResource,omitempty"`
+	SchemaBaseURL     *string          `json:"schemaBaseURL,omitempty"`
+	Verbose           *bool            `json:"verbose,omitempty"`
+}
+
+// Workspace is set on the settings of a workspace specific service.
+type Workspace struct {
+	Name string `json:"name"`
+}
+
+type WMS struct {
+	Service
+	Watermark                   *Watermark      `json:"watermark,omitempty"`
+	Interpolation               *string         `json:"interpolation,omitempty"`
+	SRS                         *shared.SRSList `json:"srs,omitempty"`
+	BBOXForEachCRS              *bool           `json:"bboxForEachCRS,omitempty"`
+	MaxBuffer                   *int            `json:"maxBuffer,omitempty"`
+	MaxRequestMemory            *int            `json:"maxRequestMemory,omitempty"`
+	MaxRenderingTime            *int            `json:"maxRenderingTime,omitempty"`
+	MaxRenderingErrors          *int            `json:"maxRenderingErrors,omitempty"`
+	MaxRequestedDimensionValues *int            `json:"maxRequestedDimensionValues,omitempty"`
+	DynamicStylingDisabled      *bool           `json:"dynamicStylingDisabled,omitempty"`
+}
+
+// Watermark is drawn by the WMS over every rendered map.
+type Watermark struct {
+	Enabled      *bool   `json:"enabled,omitempty"`
+	Position     *string `json:"position,omitempty"`
+	Transparency *int    `json:"transparency,omitempty"`
+	URL          *string `json:"URL,omitempty"`
+}
+
+// Watermark positions.
+const (
+	TopLeft      = "TOP_LEFT"
+	TopCenter    = "TOP_CENTER"
+	TopRight     = "TOP_RIGHT"
+	MiddleLeft   = "MID_LEFT"
+	MiddleCenter = "MID_CENTER"
+	MiddleRight  = "MID_RIGHT"
+	BottomLeft   = "BOT_LEFT"
+	BottomCenter = "BOT_CENTER"
+	BottomRight  = "BOT_RIGHT"
+)
+
+type WFS struct {
+	Service
+	ServiceLevel                  *ServiceLevel   `json:"serviceLevel,omitempty"`
+	MaxFeatures                   *int            `json:"maxFeatures,omitempty"`
+	FeatureBounding               *bool           `json:"featureBounding,omitempty"`
+	CanonicalSchemaLocation       *bool           `json:"canonicalSchemaLocation,omitempty"`
+	EncodeFeatureMember           *bool           `json:"encodeFeatureMember,omitempty"`
+	HitsIgnoreMaxFeatures         *bool           `json:"hitsIgnoreMaxFeatures,omitempty"`
+	MaxNumberOfFeaturesForPreview *int            `json:"maxNumberOfFeaturesForPreview,omitempty"`
+	SRS                           *shared.SRSList `json:"srs,omitempty"`
+}
+
+// ServiceLevel controls which WFS operations are available.
+type ServiceLevel string
+
+const (
+	ServiceLevelBasic         ServiceLevel = "BASIC"
+	ServiceLevelTransactional ServiceLevel = "TRANSACTIONAL"
+	ServiceLevelComplete      ServiceLevel = "COMPLETE"
+)
+
+type WCS struct {
+	Service
+	GMLPrefixing       *bool           `json:"gmlPrefixing,omitempty"`
+	LatLon             *bool           `json:"latLon,omitempty"`
+	MaxInputMemory     *int            `json:"maxInputMemory,omitempty"`
+	MaxOutputMemory    *int            `json:"maxOutputMemory,omitempty"`
+	SubsamplingEnabled *bool           `json:"subsamplingEnabled,omitempty"`
+	SRS                *shared.SRSList `json:"srs,omitempty"`
+}
+
+type WMTS struct {
+	Service
+}
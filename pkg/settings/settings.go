@@ -0,0 +1,100 @@
+package settings
+
+// GlobalWrapper is the root element of the global settings.
+type GlobalWrapper struct {
+	Global Global `json:"global"`
+}
+
+// Global contains the settings of the whole GeoServer instance.
+// This structure contains many fields with pointer values because GeoServer only copies the values which are sent,
+// so fields left nil keep their current value.
+// Settings is replaced as a whole when sent, so it should be read, modified and sent back.
+type Global struct {
+	Settings                    *Settings       `json:"settings,omitempty"`
+	JAI                         *JAI            `json:"jai,omitempty"`
+	CoverageAccess              *CoverageAccess `json:"coverageAccess,omitempty"`
+	UpdateSequence              *int            `json:"updateSequence,omitempty"`
+	FeatureTypeCacheSize        *int            `json:"featureTypeCacheSize,omitempty"`
+	GlobalServices              *bool           `json:"globalServices,omitempty"`
+	XMLPostRequestLogBufferSize *int            `json:"xmlPostRequestLogBufferSize,omitempty"`
+}
+
+// SettingsWrapper is the root element of the workspace settings.
+type SettingsWrapper struct {
+	Settings Settings `json:"settings"`
+}
+
+// Settings are shared by the global settings and the workspace specific settings.
+type Settings struct {
+	ID                                 *string    `json:"id,omitempty"`
+	Workspace                          *Workspace `json:"workspace,omitempty"`
+	Contact                            *Contact   `json:"contact,omitempty"`
+	Charset                            *string    `json:"charset,omitempty"`
+	NumDecimals                        *int       `json:"numDecimals,omitempty"`
+	OnlineResource                     *string    `json:"onlineResource,omitempty"`
+	ProxyBaseURL                       *string    `json:"proxyBaseUrl,omitempty"`
+	SchemaBaseURL                      *string    `json:"schemaBaseUrl,omitempty"`
+	Verbose                            *bool      `json:"verbose,omitempty"`
+	VerboseExceptions                  *bool      `json:"verboseExceptions,omitempty"`
+	LocalWorkspaceIncludesPrefix       *bool      `json:"localWorkspaceIncludesPrefix,omitempty"`
+	ShowCreatedTimeColumnsInAdminList  *bool      `json:"showCreatedTimeColumnsInAdminList,omitempty"`
+	ShowModifiedTimeColumnsInAdminList *bool      `json:"showModifiedTimeColumnsInAdminList,omitempty"`
+}
+
+// Workspace is set on the workspace specific settings.
+type Workspace struct {
+	Name string `json:"name"`
+}
+
+// ContactWrapper is the root element of the contact information.
+type ContactWrapper struct {
+	Contact Contact `json:"contact"`
+}
+
+// Contact information published in the capabilities documents.
+type Contact struct {
+	Person            *string `json:"contactPerson,omitempty"`
+	Position          *string `json:"contactPosition,omitempty"`
+	Organization      *string `json:"contactOrganization,omitempty"`
+	Email             *string `json:"contactEmail,omitempty"`
+	Voice             *string `json:"contactVoice,omitempty"`
+	Facsimile         *string `json:"contactFacsimile,omitempty"`
+	AddressType       *string `json:"addressType,omitempty"`
+	Address           *string `json:"address,omitempty"`
+	AddressCity       *string `json:"addressCity,omitempty"`
+	AddressState      *string `json:"addressState,omitempty"`
+	AddressPostalCode *string `json:"addressPostalCode,omitempty"`
+	AddressCountry    *string `json:"addressCountry,omitempty"`
+	OnlineResource    *string `json:"onlineResource,omitempty"`
+}
+
+// JAI tunes the Java Advanced Imaging engine used for raster processing.
+type JAI struct {
+	AllowInterpolation *bool    `json:"allowInterpolation,omitempty"`
+	Recycling          *bool    `json:"recycling,omitempty"`
+	TilePriority       *int     `json:"tilePriority,omitempty"`
+	TileThreads        *int     `json:"tileThreads,omitempty"`
+	MemoryCapacity     *float64 `json:"memoryCapacity,omitempty"`
+	MemoryThreshold    *float64 `json:"memoryThreshold,omitempty"`
+	ImageIOCache       *bool    `json:"imageIOCache,omitempty"`
+	PNGAcceleration    *bool    `json:"pngAcceleration,omitempty"`
+	JPEGAcceleration   *bool    `json:"jpegAcceleration,omitempty"`
+	AllowNativeMosaic  *bool    `json:"allowNativeMosaic,omitempty"`
+	AllowNativeWarp    *bool    `json:"allowNativeWarp,omitempty"`
+}
+
+// CoverageAccess tunes the thread pool used to read coverages.
+type CoverageAccess struct {
+	MaxPoolSize           *int       `json:"maxPoolSize,omitempty"`
+	CorePoolSize          *int       `json:"corePoolSize,omitempty"`
+	KeepAliveTime         *int       `json:"keepAliveTime,omitempty"`
+	QueueType             *QueueType `json:"queueType,omitempty"`
+	ImageIOCacheThreshold *int       `json:"imageIOCacheThreshold,omitempty"`
+}
+
+type QueueType string
+
+const (
+	QueueUnbounded QueueType = "UNBOUNDED"
+	QueueDirect    QueueType = "DIRECT"
+)
@@ -0,0 +1,56 @@
+package shared
+
+import (
+	"encoding/json"
+	"github.com/canghel3/go-geoserver/pkg/types"
+)
+
+// Metadata keys under which GeoServer stores the dimensions of feature types and coverages.
+const (
+	TimeDimension      = "time"
+	ElevationDimension = "elevation"
+)
+
+// CustomDimension returns the metadata key of a custom dimension with the given name.
+func CustomDimension(name string) string {
+	return "custom_dimension_" + name
+}
+
+type DimensionInfo struct {
+	Enabled                bool                        `json:"enabled"`
+	Attribute              string                      `json:"attribute,omitempty"`
+	EndAttribute           string                      `json:"endAttribute,omitempty"`
+	Presentation           types.DimensionPresentation `json:"presentation,omitempty"`
+	Resolution             json.Number                 `json:"resolution,omitempty"`
+	Units                  string                      `json:"units,omitempty"`
+	UnitSymbol             string                      `json:"unitSymbol,omitempty"`
+	NearestMatchEnabled    bool                        `json:"nearestMatchEnabled"`
+	RawNearestMatchEnabled bool                        `json:"rawNearestMatchEnabled"`
+	AcceptableInterval     string                      `json:"acceptableInterval,omitempty"`
+	DefaultValue           *DimensionDefaultValue      `json:"defaultValue,omitempty"`
+	StartValue             string                      `json:"startValue,omitempty"`
+	EndValue               string                      `json:"endValue,omitempty"`
+}
+
+type DimensionDefaultValue struct {
+	Strategy       types.DefaultValueStrategy `json:"strategy,omitempty"`
+	ReferenceValue string                     `json:"referenceValue,omitempty"`
+}
+
+// UnmarshalJSON handles GeoServer responding with an empty string when no default value strategy is configured.
+func (d *DimensionDefaultValue) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*d = DimensionDefaultValue{}
+		return nil
+	}
+
+	type alias DimensionDefaultValue
+	var temp alias
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+
+	*d = DimensionDefaultValue(temp)
+	return nil
+}
@@ -0,0 +1,107 @@
+package shared
+
+import (
+	"encoding/json"
+	"github.com/canghel3/go-geoserver/internal/jsonutil"
+	"strconv"
+)
+
+type MetadataLinks struct {
+	MetadataLink []MetadataLink `json:"metadataLink,omitempty"`
+}
+
+// MetadataLink points to an external metadata record describing the resource (e.g. an ISO 19115 document).
+type MetadataLink struct {
+	Type         string `json:"type"`
+	About        string `json:"about,omitempty"`
+	MetadataType string `json:"metadataType"`
+	Content      string `json:"content"`
+}
+
+// UnmarshalJSON handles GeoServer responding with an empty string when there are no links.
+func (ml *MetadataLinks) UnmarshalJSON(data []byte) error {
+	ml.MetadataLink = nil
+
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return nil
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	return jsonutil.OneOrMany(m["metadataLink"], &ml.MetadataLink)
+}
+
+type DataLinks struct {
+	DataLink []DataLink `json:"org.geoserver.catalog.impl.DataLinkInfoImpl,omitempty"`
+}
+
+// DataLink points to the underlying data of the resource (e.g. a download page).
+type DataLink struct {
+	Type    string `json:"type"`
+	About   string `json:"about,omitempty"`
+	Content string `json:"content"`
+}
+
+// UnmarshalJSON handles GeoServer responding with an empty string when there are no links.
+func (dl *DataLinks) UnmarshalJSON(data []byte) error {
+	dl.DataLink = nil
+
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return nil
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	return jsonutil.OneOrMany(m["org.geoserver.catalog.impl.DataLinkInfoImpl"], &dl.DataLink)
+}
+
+// SRSList is a list of SRS codes, such as the response SRS of a resource.
+type SRSList struct {
+	SRS []string `json:"string,omitempty"`
+}
+
+// UnmarshalJSON handles GeoServer responding with an empty string, a single value or a list,
+// where the values can be either strings (EPSG:4326) or plain numbers (4326).
+func (sl *SRSList) UnmarshalJSON(data []byte) error {
+	sl.SRS = nil
+
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return nil
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	var values []json.RawMessage
+	if err := jsonutil.OneOrMany(m["string"], &values); err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		var code string
+		if err := json.Unmarshal(value, &code); err == nil {
+			sl.SRS = append(sl.SRS, code)
+			continue
+		}
+
+		var number int64
+		if err := json.Unmarshal(value, &number); err != nil {
+			return err
+		}
+
+		sl.SRS = append(sl.SRS, strconv.FormatInt(number, 10))
+	}
+
+	return nil
+}
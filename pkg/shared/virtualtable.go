@@ -0,0 +1,58 @@
+package shared
+
+import (
+	"encoding/json"
+	"github.com/canghel3/go-geoserver/internal/jsonutil"
+)
+
+// VirtualTable holds the definition of a SQL view. It is stored in the JDBC_VIRTUAL_TABLE metadata entry of a feature type.
+type VirtualTable struct {
+	Name      string                  `json:"name"`
+	SQL       string                  `json:"sql"`
+	EscapeSQL bool                    `json:"escapeSql"`
+	KeyColumn []string                `json:"keyColumn,omitempty"`
+	Geometry  []VirtualTableGeometry  `json:"geometry,omitempty"`
+	Parameter []VirtualTableParameter `json:"parameter,omitempty"`
+}
+
+// UnmarshalJSON handles keyColumn, geometry and parameter being returned
+// as a single value instead of a list when the view declares only one of them.
+func (vt *VirtualTable) UnmarshalJSON(data []byte) error {
+	var temp struct {
+		Name      string          `json:"name"`
+		SQL       string          `json:"sql"`
+		EscapeSQL bool            `json:"escapeSql"`
+		KeyColumn json.RawMessage `json:"keyColumn"`
+		Geometry  json.RawMessage `json:"geometry"`
+		Parameter json.RawMessage `json:"parameter"`
+	}
+
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+
+	vt.Name = temp.Name
+	vt.SQL = temp.SQL
+	vt.EscapeSQL = temp.EscapeSQL
+	if err := jsonutil.OneOrMany(temp.KeyColumn, &vt.KeyColumn); err != nil {
+		return err
+	}
+
+	if err := jsonutil.OneOrMany(temp.Geometry, &vt.Geometry); err != nil {
+		return err
+	}
+
+	return jsonutil.OneOrMany(temp.Parameter, &vt.Parameter)
+}
+
+type VirtualTableGeometry struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	SRID int    `json:"srid"`
+}
+
+type VirtualTableParameter struct {
+	Name            string `json:"name"`
+	DefaultValue    string `json:"defaultValue"`
+	RegexpValidator string `json:"regexpValidator,omitempty"`
+}
@@ -0,0 +1,55 @@
+package styles
+
+import (
+	"encoding/json"
+
+	"github.com/canghel3/go-geoserver/pkg/workspace"
+)
+
+type StylesWrapper struct {
+	Styles Styles `json:"styles"`
+}
+
+type Styles struct {
+	Entries []Entry `json:"style"`
+}
+
+// UnmarshalJSON handles the empty string returned by GeoServer when there are no styles
+func (s *Styles) UnmarshalJSON(data []byte) error {
+	var empty string
+	if err := json.Unmarshal(data, &empty); err == nil {
+		s.Entries = []Entry{}
+		return nil
+	}
+
+	type alias Styles
+	var a alias
+	if err := json.Unmarshal(data, &a); err != nil {
+		return err
+	}
+
+	*s = Styles(a)
+	return nil
+}
+
+type Entry struct {
+	Name string `json:"name"`
+	Href string `json:"href"`
+}
+
+type StyleWrapper struct {
+	Style Style `json:"style"`
+}
+
+// Style describes the style file, not its content, which is served as SLD or CSS by GeoServer.
+type Style struct {
+	Name            string                    `json:"name"`
+	Workspace       *workspace.MultiWorkspace `json:"workspace,omitempty"`
+	Format          string                    `json:"format"`
+	LanguageVersion struct {
+		Version string `json:"version"`
+	} `json:"languageVersion"`
+	Filename     string `json:"filename"`
+	DateCreated  string `json:"dateCreated,omitempty"`
+	DateModified string `json:"dateModified,omitempty"`
+}
@@ -0,0 +1,24 @@
+package types
+
+// Binding is the java class GeoServer uses for the values of a feature type attribute.
+type Binding string
+
+const (
+	String     Binding = "java.lang.String"
+	Boolean    Binding = "java.lang.Boolean"
+	Short      Binding = "java.lang.Short"
+	Integer    Binding = "java.lang.Integer"
+	Long       Binding = "java.lang.Long"
+	Float      Binding = "java.lang.Float"
+	Double     Binding = "java.lang.Double"
+	BigDecimal Binding = "java.math.BigDecimal"
+	Date       Binding = "java.sql.Date"
+	Time       Binding = "java.sql.Time"
+	Timestamp  Binding = "java.sql.Timestamp"
+	UUID       Binding = "java.util.UUID"
+)
+
+// Binding returns the JTS class of the geometry type (e.g. org.locationtech.jts.geom.Point).
+func (gt GeometryType) Binding() Binding {
+	return Binding("org.locationtech.jts.geom." + string(gt))
+}
@@ -0,0 +1,20 @@
+package types
+
+// DimensionPresentation controls how the values of a dimension are advertised in the capabilities documents.
+type DimensionPresentation string
+
+const (
+	PresentationList               DimensionPresentation = "LIST"
+	PresentationContinuousInterval DimensionPresentation = "CONTINUOUS_INTERVAL"
+	PresentationDiscreteInterval   DimensionPresentation = "DISCRETE_INTERVAL"
+)
+
+// DefaultValueStrategy controls which value of a dimension is used when a request does not specify one.
+type DefaultValueStrategy string
+
+const (
+	StrategyMinimum DefaultValueStrategy = "MINIMUM"
+	StrategyMaximum DefaultValueStrategy = "MAXIMUM"
+	StrategyNearest DefaultValueStrategy = "NEAREST"
+	StrategyFixed   DefaultValueStrategy = "FIXED"
+)
@@ -0,0 +1,14 @@
+package types
+
+type GeometryType string
+
+const (
+	Geometry           GeometryType = "Geometry"
+	GeometryCollection GeometryType = "GeometryCollection"
+	Point              GeometryType = "Point"
+	MultiPoint         GeometryType = "MultiPoint"
+	LineString         GeometryType = "LineString"
+	MultiLineString    GeometryType = "MultiLineString"
+	Polygon            GeometryType = "Polygon"
+	MultiPolygon       GeometryType = "MultiPolygon"
+)
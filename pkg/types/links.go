@@ -0,0 +1,12 @@
+package types
+
+// MetadataType is the standard of the metadata record referenced by a metadata link.
+type MetadataType string
+
+const (
+	MetadataISO19115 MetadataType = "ISO19115:2003"
+	MetadataFGDC     MetadataType = "FGDC"
+	MetadataTC211    MetadataType = "TC211"
+	Metadata19139    MetadataType = "19139"
+	MetadataOther    MetadataType = "other"
+)
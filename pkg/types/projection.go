@@ -0,0 +1,17 @@
+package types
+
+// ProjectionPolicy controls how GeoServer handles the native and declared SRS of a resource.
+type ProjectionPolicy string
+
+const (
+	ForceDeclared       ProjectionPolicy = "FORCE_DECLARED"
+	ReprojectToDeclared ProjectionPolicy = "REPROJECT_TO_DECLARED"
+	KeepNative          ProjectionPolicy = "NONE"
+)
+
+// Interpolation methods supported by coverages.
+const (
+	InterpolationNearestNeighbor = "nearest neighbor"
+	InterpolationBilinear        = "bilinear"
+	InterpolationBicubic         = "bicubic"
+)
@@ -23,6 +23,7 @@ type Workspace struct {
 	DataStores     string `json:"dataStores,omitempty" xml:"dataStores"`
 	CoverageStores string `json:"coverageStores,omitempty" xml:"coverageStores"`
 	WMSStores      string `json:"wmsStores,omitempty" xml:"wmsStores"`
+	Isolated       bool   `json:"isolated,omitempty" xml:"isolated"`
 }
 
 type Creation struct {